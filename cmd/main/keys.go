@@ -0,0 +1,95 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/totorialman/go-task-avito/internal/pkg/auth/keys"
+)
+
+const keygenUsage = "usage: keygen [-alg EdDSA|RS256] [-kid id] [-public] dir"
+
+// loadKeySet читает ключи подписи токенов из JWT_KEYS_DIR. Без каталога создается
+// временный ключ: так проект поднимается локально, но токены не переживают рестарт.
+func loadKeySet(logger *slog.Logger) (*keys.KeySet, error) {
+	dir := os.Getenv("JWT_KEYS_DIR")
+	if dir == "" {
+		logger.Warn("JWT_KEYS_DIR не задан, токены подписываются временным ключом")
+		key, err := keys.Generate(newKeyID(), keys.AlgEdDSA)
+		if err != nil {
+			return nil, err
+		}
+		return keys.NewKeySet("", key)
+	}
+
+	ks, err := keys.LoadDir(dir, os.Getenv("JWT_SIGNING_KEY_ID"))
+	if err != nil {
+		return nil, fmt.Errorf("ошибка загрузки ключей из %s: %w", dir, err)
+	}
+	logger.Info("Ключи подписи загружены", slog.String("dir", dir), slog.String("kid", ks.SigningKeyID()))
+	return ks, nil
+}
+
+func newKeyID() string {
+	return time.Now().UTC().Format("20060102-150405")
+}
+
+// runKeygen создает приватный ключ <kid>.pem в каталоге, а с -public — еще и
+// <kid>.pub.pem для сервисов, которым нужна только проверка.
+func runKeygen(logger *slog.Logger, args []string) error {
+	fs := flag.NewFlagSet("keygen", flag.ContinueOnError)
+	alg := fs.String("alg", keys.AlgEdDSA, "EdDSA или RS256")
+	kid := fs.String("kid", newKeyID(), "идентификатор ключа")
+	public := fs.Bool("public", false, "дополнительно записать публичный ключ")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %s", err, keygenUsage)
+	}
+	if fs.NArg() != 1 {
+		return fmt.Errorf(keygenUsage)
+	}
+	dir := fs.Arg(0)
+
+	key, err := keys.Generate(*kid, *alg)
+	if err != nil {
+		return err
+	}
+
+	private, err := keys.MarshalPrivatePEM(key)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(dir, *kid+".pem")
+	if err := writeNewFile(path, private, 0600); err != nil {
+		return err
+	}
+	logger.Info("Ключ создан", slog.String("kid", *kid), slog.String("alg", *alg), slog.String("path", path))
+
+	if *public {
+		pub, err := keys.MarshalPublicPEM(key)
+		if err != nil {
+			return err
+		}
+		if err := writeNewFile(filepath.Join(dir, *kid+".pub.pem"), pub, 0644); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeNewFile не перезаписывает существующие ключи.
+func writeNewFile(path string, data []byte, perm os.FileMode) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth/mail"
+)
+
+// newMailer настраивает отправку писем из окружения. MAIL_TRANSPORT=smtp шлет
+// письма через SMTP_ADDR, MAIL_TRANSPORT=outbox складывает их в MAIL_OUTBOX_DIR
+// для локальной проверки. Без MAIL_TRANSPORT восстановление пароля выключено.
+func newMailer(logger *slog.Logger) (auth.Mailer, string, error) {
+	transport := os.Getenv("MAIL_TRANSPORT")
+	if transport == "" {
+		return nil, "", nil
+	}
+
+	resetURL := os.Getenv("PASSWORD_RESET_URL")
+	if resetURL == "" {
+		return nil, "", errors.New("для восстановления пароля нужен PASSWORD_RESET_URL")
+	}
+	from := os.Getenv("MAIL_FROM")
+	if from == "" {
+		from = "noreply@localhost"
+	}
+
+	var mailer auth.Mailer
+	var err error
+	switch transport {
+	case "smtp":
+		mailer, err = mail.NewSMTPMailer(mail.SMTPConfig{
+			Addr:     os.Getenv("SMTP_ADDR"),
+			Username: os.Getenv("SMTP_USERNAME"),
+			Password: os.Getenv("SMTP_PASSWORD"),
+			From:     from,
+		})
+	case "outbox":
+		dir := os.Getenv("MAIL_OUTBOX_DIR")
+		if dir == "" {
+			dir = "outbox"
+		}
+		mailer, err = mail.NewOutboxMailer(dir, from)
+	default:
+		return nil, "", fmt.Errorf("неизвестный MAIL_TRANSPORT %q, ожидается smtp или outbox", transport)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	logger.Info("Восстановление пароля по email включено", slog.String("transport", transport))
+	return mailer, resetURL, nil
+}
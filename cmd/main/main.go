@@ -12,6 +12,9 @@ import (
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/loads"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/runtime/security"
 	"github.com/gorilla/mux"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"github.com/totorialman/go-task-avito/restapi"
@@ -19,15 +22,22 @@ import (
 
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/totorialman/go-task-avito/internal/middleware/acl"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
 	metricsmw "github.com/totorialman/go-task-avito/internal/middleware/metrics"
 	authHandler "github.com/totorialman/go-task-avito/internal/pkg/auth/delivery/http"
 	authRepo "github.com/totorialman/go-task-avito/internal/pkg/auth/repo"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth/throttle"
 	authUsecase "github.com/totorialman/go-task-avito/internal/pkg/auth/usecase"
 
 	pvzHandler "github.com/totorialman/go-task-avito/internal/pkg/pvz/delivery/http"
 	pvzRepo "github.com/totorialman/go-task-avito/internal/pkg/pvz/repo"
 	pvzUsecase "github.com/totorialman/go-task-avito/internal/pkg/pvz/usecase"
+
+	policyHandler "github.com/totorialman/go-task-avito/internal/pkg/policy/delivery/http"
+	policyRepo "github.com/totorialman/go-task-avito/internal/pkg/policy/repo"
+	policyUsecase "github.com/totorialman/go-task-avito/internal/pkg/policy/usecase"
 	"github.com/totorialman/go-task-avito/internal/pkg/metrics"
+	"github.com/totorialman/go-task-avito/internal/pkg/migrate"
 )
 
 func main() {
@@ -41,6 +51,30 @@ func main() {
 	logger := slog.New(slog.NewJSONHandler(io.MultiWriter(logFile, os.Stdout), &slog.HandlerOptions{Level: slog.LevelInfo}))
 	slog.SetDefault(logger)
 
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(logger, os.Args[2:]); err != nil {
+			logger.Error("Ошибка выполнения миграций", slog.String("err", err.Error()))
+			os.Exit(1)
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "keygen" {
+		if err := runKeygen(logger, os.Args[2:]); err != nil {
+			logger.Error("Ошибка создания ключа", slog.String("err", err.Error()))
+			os.Exit(1)
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "bootstrap" {
+		if err := runBootstrap(logger, os.Args[2:]); err != nil {
+			logger.Error("Ошибка создания модератора", slog.String("err", err.Error()))
+			os.Exit(1)
+		}
+		return
+	}
+
 	db, err := initDB(logger)
 	if err != nil {
 		logger.Error("Ошибка при подключении к PostgreSQL", slog.String("err", err.Error()))
@@ -48,9 +82,9 @@ func main() {
 	}
 	defer db.Close()
 
-	jwtSecret := os.Getenv("JWT_SECRET")
-	if jwtSecret == "" {
-		logger.Error("JWT_SECRET не задан")
+	keySet, err := loadKeySet(logger)
+	if err != nil {
+		logger.Error("Ошибка загрузки ключей подписи", slog.String("err", err.Error()))
 		return
 	}
 	mt0, err := metrics.NewProductMetrics()
@@ -58,13 +92,66 @@ func main() {
 		log.Fatal(err)
 	}
 
+	authMetrics, err := metrics.NewAuthMetrics()
+	if err != nil {
+		log.Fatal(err)
+	}
+	throttleStore, err := newThrottleStore(logger, db)
+	if err != nil {
+		logger.Error("Ошибка настройки защиты входа", slog.String("err", err.Error()))
+		return
+	}
+	mailer, resetURL, err := newMailer(logger)
+	if err != nil {
+		logger.Error("Ошибка настройки почты", slog.String("err", err.Error()))
+		return
+	}
+
+	passwordParams, passwordPolicy, err := newPasswordSettings(logger)
+	if err != nil {
+		logger.Error("Ошибка настройки паролей", slog.String("err", err.Error()))
+		return
+	}
+
 	authRepo := authRepo.NewAuthRepo(db)
-	authUsecase := authUsecase.NewAuthUsecase(authRepo)
-	authHandler := authHandler.NewAuthHandler(authUsecase)
+	authUsecase := authUsecase.NewAuthUsecase(authRepo, keySet).
+		WithRegistrationPolicy(newRegistrationPolicy(logger)).
+		WithLoginThrottler(throttle.NewLimiter(throttleStore, throttle.DefaultAccountPolicy, throttle.DefaultIPPolicy)).
+		WithPasswordHashing(passwordParams).
+		WithPasswordPolicy(passwordPolicy)
+	if mailer != nil {
+		authUsecase.WithPasswordReset(mailer, resetURL,
+			throttle.NewRequestLimiter(throttleStore, "reset", throttle.DefaultResetPolicy))
+		// Без EMAIL_VERIFY_URL email в профиле поменять нельзя
+		if verifyURL := os.Getenv("EMAIL_VERIFY_URL"); verifyURL != "" {
+			authUsecase.WithEmailVerification(mailer, verifyURL,
+				throttle.NewRequestLimiter(throttleStore, "email", throttle.DefaultResetPolicy))
+		}
+	}
+	if idp, roles := newIdentityProvider(logger); idp != nil {
+		authUsecase.WithIdentityProvider(idp, roles)
+	}
+	authHandler := authHandler.NewAuthHandler(authUsecase, authMetrics)
 
 	pvzRepo := pvzRepo.NewPVZRepo(db)
 	pvzUsecase := pvzUsecase.NewPVZUsecase(pvzRepo)
-	pvzHandler := pvzHandler.NewPVZHandler(pvzUsecase, mt0)
+	pvzHandler := pvzHandler.NewPVZHandler(pvzUsecase, mt0, authUsecase)
+
+	policyRepo := policyRepo.NewPolicyRepo(db)
+	enforcer, err := acl.NewEnforcer(policyRepo)
+	if err != nil {
+		logger.Error("Ошибка загрузки правил доступа", slog.String("err", err.Error()))
+		return
+	}
+	// Правила, измененные другими экземплярами или прямо в базе, применяются без перезапуска
+	listenCtx, stopListen := context.WithCancel(context.Background())
+	defer stopListen()
+	go policyRepo.Listen(listenCtx, func() {
+		if err := enforcer.Reload(); err != nil {
+			logger.Error("Ошибка перезагрузки правил доступа", slog.String("err", err.Error()))
+		}
+	})
+	policyHandler := policyHandler.NewPolicyHandler(policyUsecase.NewPolicyUsecase(policyRepo, enforcer))
 
 	mt, err := metrics.NewHttpMetrics()
 	if err != nil {
@@ -78,7 +165,7 @@ func main() {
 	}
 
 	api := operations.NewBackendServiceAPI(swaggerSpec)
-	configureAPI(api, authHandler, pvzHandler)
+	configureAPI(api, authHandler, pvzHandler, policyHandler)
 
 	server := restapi.NewServer(api)
 	defer server.Shutdown()
@@ -86,7 +173,13 @@ func main() {
 	server.ConfigureAPI()
 
 	handler := server.GetHandler()
-	wrapped := middl(acl.NewAclMiddleware(handler))
+	// Ключ интеграции проверяется раньше токена, кука — раньше заголовка Authorization
+	authenticator := acl.Chain{
+		acl.APIKeyAuth(authUsecase),
+		acl.CookieAuth(keySet, authRepo),
+		acl.BearerAuth(keySet, authRepo),
+	}
+	wrapped := middl(acl.NewAclMiddleware(handler, enforcer, acl.RoutesFromSpec(swaggerSpec), authenticator))
 	server.SetHandler(wrapped)
 
 	r := mux.NewRouter()
@@ -115,7 +208,21 @@ func errorAsJSON(err error) []byte {
 	return b
 }
 
-func configureAPI(api *operations.BackendServiceAPI, handlerAuth *authHandler.AuthHandler, handlerPVZ *pvzHandler.PVZHandler) {
+// withPrincipal подключает обработчик к операции со схемой безопасности. Principal
+// обработчики читают из контекста, поэтому аргумент go-swagger не нужен.
+func withPrincipal[P any](handle func(P) middleware.Responder) func(P, interface{}) middleware.Responder {
+	return func(params P, _ interface{}) middleware.Responder {
+		return handle(params)
+	}
+}
+
+// rejectToken — проверка токена схемами go-swagger. Она не вызывается, так как
+// аутентификатор runtime заменен в configureAPI, и на всякий случай отклоняет все.
+func rejectToken(string) (interface{}, error) {
+	return nil, errors.Unauthenticated("acl")
+}
+
+func configureAPI(api *operations.BackendServiceAPI, handlerAuth *authHandler.AuthHandler, handlerPVZ *pvzHandler.PVZHandler, handlerPolicy *policyHandler.PolicyHandler) {
 	api.ServeError = func(rw http.ResponseWriter, r *http.Request, err error) {
 		rw.Header().Set("Content-Type", "application/json")
 		switch e := err.(type) {
@@ -126,20 +233,88 @@ func configureAPI(api *operations.BackendServiceAPI, handlerAuth *authHandler.Au
 			rw.WriteHeader(http.StatusInternalServerError)
 		}
 	}
+	// Учетные данные проверяет ACL-middleware еще до роутера go-swagger: схемы Bearer и
+	// APIKey из спецификации только отмечают, каким операциям нужен вход. Runtime берет
+	// пользователя, найденного middleware, из контекста запроса.
+	api.APIKeyAuthenticator = func(string, string, security.TokenAuthentication) runtime.Authenticator {
+		return security.HttpAuthenticator(func(r *http.Request) (bool, interface{}, error) {
+			principal, ok := auth.PrincipalFromContext(r.Context())
+			return ok, principal, nil
+		})
+	}
+	api.BearerAuth = rejectToken
+	api.APIKeyAuth = rejectToken
+
 	log.Println("Регистрируем хендлер POST /pvz...")
 	api.PostDummyLoginHandler = operations.PostDummyLoginHandlerFunc(handlerAuth.HandleDummyLogin)
 	api.PostLoginHandler = operations.PostLoginHandlerFunc(handlerAuth.HandleLogin)
-	api.PostRegisterHandler = operations.PostRegisterHandlerFunc(handlerAuth.HandleSignUp)
-	api.PostPvzHandler = operations.PostPvzHandlerFunc(handlerPVZ.HandleCreatePVZ)
-	api.PostReceptionsHandler = operations.PostReceptionsHandlerFunc(handlerPVZ.HandleCreateReception)
-	api.PostProductsHandler = operations.PostProductsHandlerFunc(handlerPVZ.HandleAddProductToReception)
-	api.PostPvzPvzIDDeleteLastProductHandler = operations.PostPvzPvzIDDeleteLastProductHandlerFunc(handlerPVZ.HandleDeleteLastProduct)
-	api.PostPvzPvzIDCloseLastReceptionHandler = operations.PostPvzPvzIDCloseLastReceptionHandlerFunc(handlerPVZ.HandleCloseLastReception)
+	api.PostRegisterHandler = operations.PostRegisterHandlerFunc(withPrincipal(handlerAuth.HandleSignUp))
+	api.PostRefreshHandler = operations.PostRefreshHandlerFunc(handlerAuth.HandleRefresh)
+	api.PostLogoutHandler = operations.PostLogoutHandlerFunc(handlerAuth.HandleLogout)
+	api.GetMeHandler = operations.GetMeHandlerFunc(withPrincipal(handlerAuth.HandleGetMe))
+	api.PatchMeHandler = operations.PatchMeHandlerFunc(withPrincipal(handlerAuth.HandleUpdateMe))
+	api.PostEmailConfirmHandler = operations.PostEmailConfirmHandlerFunc(handlerAuth.HandleConfirmEmail)
+	api.PostMePasswordHandler = operations.PostMePasswordHandlerFunc(withPrincipal(handlerAuth.HandleChangePassword))
+	api.PostPasswordForgotHandler = operations.PostPasswordForgotHandlerFunc(handlerAuth.HandleForgotPassword)
+	api.PostPasswordResetHandler = operations.PostPasswordResetHandlerFunc(handlerAuth.HandleResetPassword)
+	api.GetOauthLoginHandler = operations.GetOauthLoginHandlerFunc(handlerAuth.HandleOAuthLogin)
+	api.GetOauthCallbackHandler = operations.GetOauthCallbackHandlerFunc(handlerAuth.HandleOAuthCallback)
+	api.GetUsersHandler = operations.GetUsersHandlerFunc(withPrincipal(handlerAuth.HandleListUsers))
+	api.GetUsersUserIDHandler = operations.GetUsersUserIDHandlerFunc(withPrincipal(handlerAuth.HandleGetUser))
+	api.PatchUsersUserIDHandler = operations.PatchUsersUserIDHandlerFunc(withPrincipal(handlerAuth.HandleUpdateUser))
+	api.DeleteUsersUserIDHandler = operations.DeleteUsersUserIDHandlerFunc(withPrincipal(handlerAuth.HandleDeleteUser))
+	api.PostUsersUserIDUnlockHandler = operations.PostUsersUserIDUnlockHandlerFunc(withPrincipal(handlerAuth.HandleUnlockUser))
+	api.GetUsersUserIDPvzHandler = operations.GetUsersUserIDPvzHandlerFunc(withPrincipal(handlerAuth.HandleListPVZAssignments))
+	api.PutUsersUserIDPvzPvzIDHandler = operations.PutUsersUserIDPvzPvzIDHandlerFunc(withPrincipal(handlerAuth.HandleAssignPVZ))
+	api.DeleteUsersUserIDPvzPvzIDHandler = operations.DeleteUsersUserIDPvzPvzIDHandlerFunc(withPrincipal(handlerAuth.HandleUnassignPVZ))
+	api.GetMeSessionsHandler = operations.GetMeSessionsHandlerFunc(withPrincipal(handlerAuth.HandleListSessions))
+	api.DeleteMeSessionsSessionIDHandler = operations.DeleteMeSessionsSessionIDHandlerFunc(withPrincipal(handlerAuth.HandleRevokeSession))
+	api.DeleteUsersUserIDSessionsHandler = operations.DeleteUsersUserIDSessionsHandlerFunc(withPrincipal(handlerAuth.HandleRevokeUserSessions))
+	api.PostInvitesHandler = operations.PostInvitesHandlerFunc(withPrincipal(handlerAuth.HandleCreateInvite))
+	api.PostAPIKeysHandler = operations.PostAPIKeysHandlerFunc(withPrincipal(handlerAuth.HandleCreateAPIKey))
+	api.GetAPIKeysHandler = operations.GetAPIKeysHandlerFunc(withPrincipal(handlerAuth.HandleListAPIKeys))
+	api.DeleteAPIKeysKeyIDHandler = operations.DeleteAPIKeysKeyIDHandlerFunc(withPrincipal(handlerAuth.HandleRevokeAPIKey))
+	api.GetACLPoliciesHandler = operations.GetACLPoliciesHandlerFunc(withPrincipal(handlerPolicy.HandleListPolicies))
+	api.PostACLPoliciesHandler = operations.PostACLPoliciesHandlerFunc(withPrincipal(handlerPolicy.HandleAddPolicy))
+	api.DeleteACLPoliciesHandler = operations.DeleteACLPoliciesHandlerFunc(withPrincipal(handlerPolicy.HandleRemovePolicy))
+	api.GetACLRolesHandler = operations.GetACLRolesHandlerFunc(withPrincipal(handlerPolicy.HandleListRoles))
+	api.PostACLRolesHandler = operations.PostACLRolesHandlerFunc(withPrincipal(handlerPolicy.HandleAddRole))
+	api.DeleteACLRolesHandler = operations.DeleteACLRolesHandlerFunc(withPrincipal(handlerPolicy.HandleRemoveRole))
+	api.GetWellKnownJwksJSONHandler = operations.GetWellKnownJwksJSONHandlerFunc(handlerAuth.HandleJWKS)
+	api.PostPvzHandler = operations.PostPvzHandlerFunc(withPrincipal(handlerPVZ.HandleCreatePVZ))
+	api.PostReceptionsHandler = operations.PostReceptionsHandlerFunc(withPrincipal(handlerPVZ.HandleCreateReception))
+	api.PostProductsHandler = operations.PostProductsHandlerFunc(withPrincipal(handlerPVZ.HandleAddProductToReception))
+	api.PostPvzPvzIDDeleteLastProductHandler = operations.PostPvzPvzIDDeleteLastProductHandlerFunc(withPrincipal(handlerPVZ.HandleDeleteLastProduct))
+	api.PostPvzPvzIDCloseLastReceptionHandler = operations.PostPvzPvzIDCloseLastReceptionHandlerFunc(withPrincipal(handlerPVZ.HandleCloseLastReception))
 	api.GetPvzHandler = operations.GetPvzHandlerFunc(handlerPVZ.HandleGetPVZs)
+	api.GetPvzPvzIDHandler = operations.GetPvzPvzIDHandlerFunc(withPrincipal(handlerPVZ.HandleGetPVZ))
+	api.GetReceptionsReceptionIDHandler = operations.GetReceptionsReceptionIDHandlerFunc(withPrincipal(handlerPVZ.HandleGetReception))
+	api.GetReceptionsReceptionIDProductsHandler = operations.GetReceptionsReceptionIDProductsHandlerFunc(withPrincipal(handlerPVZ.HandleGetReceptionProducts))
 
 }
 
 func initDB(logger *slog.Logger) (*pgxpool.Pool, error) {
+	db, err := connectDB(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	migrator, err := migrate.NewMigrator(db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	applied, err := migrator.Up(context.Background())
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("ошибка применения миграций: %w", err)
+	}
+	logger.Info("Миграции применены", slog.Int("count", applied))
+
+	return db, nil
+}
+
+func connectDB(logger *slog.Logger) (*pgxpool.Pool, error) {
 	connStr := os.Getenv("POSTGRES_CONN")
 	if connStr == "" {
 		return nil, fmt.Errorf("POSTGRES_CONN не задан")
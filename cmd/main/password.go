@@ -0,0 +1,48 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+
+	"github.com/totorialman/go-task-avito/internal/pkg/auth/password"
+)
+
+// newPasswordSettings читает параметры argon2id и требования к паролям из окружения.
+// Незаданные переменные оставляют значения по умолчанию.
+func newPasswordSettings(logger *slog.Logger) (password.Params, password.Policy, error) {
+	params := password.DefaultParams
+	policy := password.DefaultPolicy
+
+	vars := []struct {
+		name string
+		set  func(v uint64)
+		bits int
+	}{
+		{"ARGON2_TIME", func(v uint64) { params.Time = uint32(v) }, 32},
+		{"ARGON2_MEMORY_KIB", func(v uint64) { params.Memory = uint32(v) }, 32},
+		{"ARGON2_THREADS", func(v uint64) { params.Threads = uint8(v) }, 8},
+		{"PASSWORD_MIN_LENGTH", func(v uint64) { policy.MinLength = int(v) }, 16},
+		{"PASSWORD_MIN_CLASSES", func(v uint64) { policy.MinClasses = int(v) }, 8},
+	}
+	for _, v := range vars {
+		raw := os.Getenv(v.name)
+		if raw == "" {
+			continue
+		}
+		n, err := strconv.ParseUint(raw, 10, v.bits)
+		if err != nil || n == 0 {
+			return params, policy, fmt.Errorf("некорректное %s=%q", v.name, raw)
+		}
+		v.set(n)
+	}
+
+	logger.Info("Параметры паролей",
+		slog.Uint64("argon2_time", uint64(params.Time)),
+		slog.Uint64("argon2_memory_kib", uint64(params.Memory)),
+		slog.Int("argon2_threads", int(params.Threads)),
+		slog.Int("min_length", policy.MinLength),
+		slog.Int("min_classes", policy.MinClasses))
+	return params, policy, nil
+}
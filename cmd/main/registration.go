@@ -0,0 +1,74 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	authRepo "github.com/totorialman/go-task-avito/internal/pkg/auth/repo"
+	authUsecase "github.com/totorialman/go-task-avito/internal/pkg/auth/usecase"
+)
+
+const bootstrapUsage = "usage: bootstrap -email email (пароль из BOOTSTRAP_PASSWORD или stdin)"
+
+// newRegistrationPolicy читает из окружения домены, с которых сотрудники могут
+// регистрироваться сами. Без REGISTRATION_ALLOWED_DOMAINS разрешен любой email.
+func newRegistrationPolicy(logger *slog.Logger) auth.RegistrationPolicy {
+	policy := auth.RegistrationPolicy{AllowedDomains: splitList(os.Getenv("REGISTRATION_ALLOWED_DOMAINS"))}
+	if len(policy.AllowedDomains) > 0 {
+		logger.Info("Регистрация сотрудников ограничена доменами", slog.String("domains", strings.Join(policy.AllowedDomains, ",")))
+	}
+	return policy
+}
+
+// runBootstrap создает первого модератора, пока в базе нет ни одного.
+// Пароль не передается аргументом, чтобы не попасть в историю shell и список процессов.
+func runBootstrap(logger *slog.Logger, args []string) error {
+	fs := flag.NewFlagSet("bootstrap", flag.ContinueOnError)
+	email := fs.String("email", "", "email модератора")
+	if err := fs.Parse(args); err != nil {
+		return fmt.Errorf("%w: %s", err, bootstrapUsage)
+	}
+	if *email == "" || fs.NArg() != 0 {
+		return errors.New(bootstrapUsage)
+	}
+
+	password := os.Getenv("BOOTSTRAP_PASSWORD")
+	if password == "" {
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && line == "" {
+			return fmt.Errorf("не удалось прочитать пароль: %w", err)
+		}
+		password = strings.TrimRight(line, "\r\n")
+	}
+	if password == "" {
+		return errors.New("пустой пароль")
+	}
+
+	db, err := initDB(logger)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	params, policy, err := newPasswordSettings(logger)
+	if err != nil {
+		return err
+	}
+	uc := authUsecase.NewAuthUsecase(authRepo.NewAuthRepo(db), nil).
+		WithPasswordHashing(params).
+		WithPasswordPolicy(policy)
+	user, err := uc.Bootstrap(context.Background(), *email, password)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("Первый модератор создан", slog.String("id", user.ID.String()), slog.String("email", *email))
+	return nil
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth/oidc"
+)
+
+// newIdentityProvider настраивает вход через OIDC из окружения. Без OIDC_ISSUER
+// вход через SSO выключен, остается только вход по паролю.
+func newIdentityProvider(logger *slog.Logger) (auth.IdentityProvider, auth.RoleMapping) {
+	issuer := os.Getenv("OIDC_ISSUER")
+	if issuer == "" {
+		return nil, auth.RoleMapping{}
+	}
+
+	roles := auth.RoleMapping{
+		ModeratorGroups: splitList(os.Getenv("OIDC_MODERATOR_GROUPS")),
+		EmployeeGroups:  splitList(os.Getenv("OIDC_EMPLOYEE_GROUPS")),
+	}
+	if len(roles.ModeratorGroups) == 0 && len(roles.EmployeeGroups) == 0 {
+		logger.Warn("OIDC_MODERATOR_GROUPS и OIDC_EMPLOYEE_GROUPS пусты, через SSO никто не сможет войти")
+	}
+
+	provider := oidc.NewProvider(oidc.Config{
+		Name:         os.Getenv("OIDC_PROVIDER_NAME"),
+		Issuer:       issuer,
+		ClientID:     os.Getenv("OIDC_CLIENT_ID"),
+		ClientSecret: os.Getenv("OIDC_CLIENT_SECRET"),
+		RedirectURL:  os.Getenv("OIDC_REDIRECT_URL"),
+		Scopes:       splitList(os.Getenv("OIDC_SCOPES")),
+		GroupsClaim:  os.Getenv("OIDC_GROUPS_CLAIM"),
+	}, nil)
+	logger.Info("Вход через SSO включен", slog.String("issuer", issuer), slog.String("provider", provider.Name()))
+
+	return provider, roles
+}
+
+func splitList(value string) []string {
+	var out []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/jackc/pgtype/pgxtype"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth/throttle"
+)
+
+// newThrottleStore выбирает хранилище счетчиков неудачных входов и запросов сброса
+// пароля. По умолчанию счетчики в PostgreSQL и общие для всех экземпляров;
+// LOGIN_THROTTLE_STORE=memory держит их в памяти процесса.
+func newThrottleStore(logger *slog.Logger, db pgxtype.Querier) (throttle.Store, error) {
+	var store throttle.Store
+	switch kind := os.Getenv("LOGIN_THROTTLE_STORE"); kind {
+	case "", "postgres":
+		store = throttle.NewPgStore(db)
+	case "memory":
+		store = throttle.NewMemoryStore()
+	default:
+		return nil, fmt.Errorf("неизвестное LOGIN_THROTTLE_STORE %q, ожидается postgres или memory", kind)
+	}
+
+	logger.Info("Защита входа от перебора включена", slog.String("store", fmt.Sprintf("%T", store)))
+	return store, nil
+}
@@ -0,0 +1,145 @@
+package acl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/golang-jwt/jwt"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+)
+
+// SessionChecker сообщает, не отозвана ли сессия (семейство refresh-токенов),
+// к которой привязан access-токен, и не заблокирован ли ее владелец.
+type SessionChecker interface {
+	IsSessionActive(ctx context.Context, sessionID string) (bool, error)
+}
+
+// TokenVerifier проверяет подпись access-токена и заполняет claims.
+type TokenVerifier interface {
+	Parse(tokenString string, claims jwt.Claims) (*jwt.Token, error)
+}
+
+// APIKeyAuthenticator проверяет API-ключ интеграции и возвращает principal с его
+// ролью и ПВЗ.
+type APIKeyAuthenticator interface {
+	AuthenticateAPIKey(ctx context.Context, key string) (*auth.Principal, error)
+}
+
+const (
+	// JWTCookie — кука с access-токеном, которую выставляет вход из браузера.
+	JWTCookie = "JWT"
+	// APIKeyHeader — заголовок с API-ключом, которым интеграции входят вместо JWT.
+	APIKeyHeader = "X-API-Key"
+)
+
+var (
+	ErrUnsupportedScheme = errors.New("unsupported authorization scheme")
+	ErrSessionRevoked    = errors.New("session revoked")
+)
+
+// Authenticator узнает пользователя по одному способу входа. Если учетных данных
+// этого вида в запросе нет, возвращает (nil, nil); недействительные учетные данные —
+// ошибка.
+type Authenticator interface {
+	Authenticate(r *http.Request) (*auth.Principal, error)
+}
+
+type AuthenticatorFunc func(r *http.Request) (*auth.Principal, error)
+
+func (f AuthenticatorFunc) Authenticate(r *http.Request) (*auth.Principal, error) {
+	return f(r)
+}
+
+// Chain пробует способы входа по порядку. Решает первый, нашедший в запросе свои
+// учетные данные: недействительный ключ не заменяется токеном из куки.
+type Chain []Authenticator
+
+func (c Chain) Authenticate(r *http.Request) (*auth.Principal, error) {
+	for _, authenticator := range c {
+		principal, err := authenticator.Authenticate(r)
+		if err != nil || principal != nil {
+			return principal, err
+		}
+	}
+	return nil, nil
+}
+
+// CookieAuth принимает access-токен из куки JWT.
+func CookieAuth(verifier TokenVerifier, sessions SessionChecker) Authenticator {
+	return AuthenticatorFunc(func(r *http.Request) (*auth.Principal, error) {
+		cookie, err := r.Cookie(JWTCookie)
+		if err != nil || cookie.Value == "" {
+			return nil, nil
+		}
+		return principalFromToken(r.Context(), verifier, sessions, cookie.Value)
+	})
+}
+
+// BearerAuth принимает access-токен из заголовка Authorization: Bearer.
+func BearerAuth(verifier TokenVerifier, sessions SessionChecker) Authenticator {
+	return AuthenticatorFunc(func(r *http.Request) (*auth.Principal, error) {
+		header := r.Header.Get("Authorization")
+		if header == "" {
+			return nil, nil
+		}
+		token, ok := strings.CutPrefix(header, "Bearer ")
+		if !ok || token == "" {
+			return nil, ErrUnsupportedScheme
+		}
+		return principalFromToken(r.Context(), verifier, sessions, token)
+	})
+}
+
+// APIKeyAuth принимает API-ключ интеграции из заголовка X-API-Key.
+func APIKeyAuth(keys APIKeyAuthenticator) Authenticator {
+	return AuthenticatorFunc(func(r *http.Request) (*auth.Principal, error) {
+		key := r.Header.Get(APIKeyHeader)
+		if key == "" {
+			return nil, nil
+		}
+		principal, err := keys.AuthenticateAPIKey(r.Context(), key)
+		if err != nil {
+			return nil, fmt.Errorf("api key: %w", err)
+		}
+		return principal, nil
+	})
+}
+
+// principalFromToken проверяет подпись и срок действия токена, собирает из claims
+// пользователя и убеждается, что его сессия не отозвана. У тестовых токенов сессии нет.
+func principalFromToken(ctx context.Context, verifier TokenVerifier, sessions SessionChecker, tokenString string) (*auth.Principal, error) {
+	token, err := verifier.Parse(tokenString, jwt.MapClaims{})
+	if err != nil {
+		return nil, fmt.Errorf("error parsing token: %w", err)
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+
+	role, ok := claims["role"].(string)
+	if !ok || role == "" {
+		return nil, errors.New("role not found or invalid in token")
+	}
+	principal := &auth.Principal{Role: role}
+	sub, _ := claims["sub"].(string)
+	principal.UserID = strfmt.UUID(sub)
+	principal.Email, _ = claims["email"].(string)
+	principal.TokenID, _ = claims["jti"].(string)
+	principal.SessionID, _ = claims["sid"].(string)
+
+	if principal.SessionID != "" {
+		active, err := sessions.IsSessionActive(ctx, principal.SessionID)
+		if err != nil {
+			return nil, fmt.Errorf("error checking session: %w", err)
+		}
+		if !active {
+			return nil, fmt.Errorf("session %s: %w", principal.SessionID, ErrSessionRevoked)
+		}
+	}
+	return principal, nil
+}
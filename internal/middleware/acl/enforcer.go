@@ -0,0 +1,58 @@
+package acl
+
+import (
+	_ "embed"
+	"fmt"
+	"sync"
+
+	"github.com/casbin/casbin"
+	"github.com/casbin/casbin/persist"
+)
+
+//go:embed model.conf
+var modelConf string
+
+// Enforcer проверяет доступ по правилам, которые загружает адаптер. Reload
+// собирает новый casbin-энфорсер и только потом подменяет текущий, поэтому
+// при ошибке загрузки продолжают действовать прежние правила.
+type Enforcer struct {
+	adapter persist.Adapter
+
+	mu       sync.RWMutex
+	enforcer *casbin.Enforcer
+}
+
+func NewEnforcer(adapter persist.Adapter) (*Enforcer, error) {
+	e := &Enforcer{adapter: adapter}
+	if err := e.Reload(); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+func (e *Enforcer) Reload() error {
+	m := casbin.NewModel(modelConf)
+	if err := e.adapter.LoadPolicy(m); err != nil {
+		return fmt.Errorf("failed to load policy: %w", err)
+	}
+
+	enforcer, err := casbin.NewEnforcerSafe(m, false)
+	if err != nil {
+		return fmt.Errorf("failed to create enforcer: %w", err)
+	}
+	enforcer.BuildRoleLinks()
+
+	e.mu.Lock()
+	e.enforcer = enforcer
+	e.mu.Unlock()
+	return nil
+}
+
+// Enforce сообщает, может ли роль sub выполнить act над путем obj.
+func (e *Enforcer) Enforce(sub, obj, act string) (bool, error) {
+	e.mu.RLock()
+	enforcer := e.enforcer
+	e.mu.RUnlock()
+
+	return enforcer.EnforceSafe(sub, obj, act)
+}
@@ -0,0 +1,94 @@
+package acl
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/casbin/casbin/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// DummyAdapter отдает правила из памяти в формате строк casbin_rule.
+type DummyAdapter struct {
+	Rules [][]string
+	Err   error
+}
+
+func (a *DummyAdapter) LoadPolicy(m model.Model) error {
+	if a.Err != nil {
+		return a.Err
+	}
+	for _, rule := range a.Rules {
+		m[rule[0][:1]][rule[0]].Policy = append(m[rule[0][:1]][rule[0]].Policy, rule[1:])
+	}
+	return nil
+}
+
+func (a *DummyAdapter) SavePolicy(m model.Model) error { return errors.New("not implemented") }
+
+func (a *DummyAdapter) AddPolicy(sec, ptype string, rule []string) error {
+	return errors.New("not implemented")
+}
+
+func (a *DummyAdapter) RemovePolicy(sec, ptype string, rule []string) error {
+	return errors.New("not implemented")
+}
+
+func (a *DummyAdapter) RemoveFilteredPolicy(sec, ptype string, fieldIndex int, fieldValues ...string) error {
+	return errors.New("not implemented")
+}
+
+func TestEnforcer_Enforce(t *testing.T) {
+	adapter := &DummyAdapter{Rules: [][]string{
+		{"p", "employee", "/receptions", "POST"},
+		{"p", "employee", "/pvz/:pvzId/close_last_reception", "POST"},
+		{"p", "moderator", "/users", "GET"},
+		{"g", "senior", "employee"},
+	}}
+	e, err := NewEnforcer(adapter)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		role     string
+		path     string
+		method   string
+		expected bool
+	}{
+		{"Direct rule", "employee", "/receptions", "POST", true},
+		{"Wrong method", "employee", "/receptions", "GET", false},
+		{"Path parameter", "employee", "/pvz/33333333-3333-3333-3333-333333333333/close_last_reception", "POST", true},
+		{"Inherited rule", "senior", "/receptions", "POST", true},
+		{"Not inherited upwards", "employee", "/users", "GET", false},
+		{"Unknown role", "robot", "/receptions", "POST", false},
+		{"No role", "", "/receptions", "POST", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			allowed, err := e.Enforce(tt.role, tt.path, tt.method)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, allowed)
+		})
+	}
+}
+
+func TestEnforcer_Reload(t *testing.T) {
+	adapter := &DummyAdapter{Rules: [][]string{{"p", "employee", "/receptions", "POST"}}}
+	e, err := NewEnforcer(adapter)
+	require.NoError(t, err)
+
+	adapter.Rules = append(adapter.Rules, []string{"p", "employee", "/products", "POST"})
+	require.NoError(t, e.Reload())
+	allowed, _ := e.Enforce("employee", "/products", "POST")
+	assert.True(t, allowed, "new rule applies after reload")
+
+	adapter.Err = errors.New("connection refused")
+	assert.Error(t, e.Reload())
+	allowed, _ = e.Enforce("employee", "/products", "POST")
+	assert.True(t, allowed, "failed reload keeps previous rules")
+
+	_, err = NewEnforcer(adapter)
+	assert.Error(t, err)
+}
@@ -2,142 +2,83 @@ package acl
 
 import (
 	"encoding/json"
-	"errors"
-	"fmt"
 	"log"
 	"net/http"
-	"os"
-	"strings"
 
-	"github.com/casbin/casbin"
-	"github.com/go-openapi/runtime/middleware"
-	"github.com/golang-jwt/jwt"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
 )
 
-func NewAclMiddleware(next http.Handler) http.Handler {
-
-	e, err := casbin.NewEnforcerSafe("internal/middleware/acl/model.conf", "internal/middleware/acl/policy.csv")
-	if err != nil {
-		log.Fatalf("failed to load enforcer: %v", err)
-	}
-
-	skipPaths := map[string]bool{
-		"/dummyLogin": true,
-		"/login":      true,
-		"/register":   true,
-	}
-
+// NewAclMiddleware проверяет вход и права доступа до роутера go-swagger. Публичные
+// операции пропускаются без проверки; на остальных authenticator узнает пользователя,
+// а casbin решает, доступна ли ему операция. Нет учетных данных или они
+// недействительны — 401, правила не разрешают операцию — 403. Тестовым токенам
+// /dummyLogin доступно только чтение: изменения от их имени не к кому привязать.
+func NewAclMiddleware(next http.Handler, e *Enforcer, routes *Routes, authenticator Authenticator) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		if r.Method == "GET" && r.URL.Path == "/pvz" {
-			log.Printf("Skipping %v %v %v", r.Method, r.URL.Path, r)
+		access := routes.Access(r.Method, r.URL.Path)
+		if access == AccessPublic {
 			next.ServeHTTP(w, r)
 			return
 		}
-		// Пропускаем указанные пути
-		if skipPaths[r.URL.Path] {
-			log.Printf("%v %v %v", next, w, r)
+
+		principal, err := authenticator.Authenticate(r)
+		if access == AccessOptional {
+			// Вход здесь не обязателен, поэтому недействительные учетные данные не мешают запросу
+			if err == nil && principal != nil {
+				r = r.WithContext(auth.WithPrincipal(r.Context(), principal))
+			}
 			next.ServeHTTP(w, r)
 			return
 		}
-
-		var token string
-		// Получаем токен из куки или заголовка Authorization
-		cookie, err := r.Cookie("JWT")
-		if err == nil {
-			token = cookie.Value
-		} else {
-			authHeader := r.Header.Get("Authorization")
-			if strings.HasPrefix(authHeader, "Bearer ") {
-				token = strings.TrimPrefix(authHeader, "Bearer ")
-			}
+		if err != nil {
+			log.Printf("path=%s method=%s credentials rejected: %v", r.URL.Path, r.Method, err)
+			unauthorized(w, "Недействительные учетные данные")
+			return
 		}
-
-		// Если токен не найден, отправляем ошибку Unauthorized
-		if token == "" {
-			http.Error(w, "unauthorized", http.StatusUnauthorized)
+		if principal == nil {
+			unauthorized(w, "Требуется вход")
 			return
 		}
 
-		// Получаем роль пользователя из токена
-		role, _ := getRoleFromToken(cookie.Value)
-
-		// Проверяем права доступа через Casbin
-		res, _ := e.EnforceSafe(role, r.URL.Path, r.Method)
-		log.Printf("path=%s method=%s role=%s access=%v", r.URL.Path, r.Method, role, res)
-
-		if res {
-			// Если доступ разрешен, передаем запрос дальше
-			log.Printf("Passing to next handler: %s", r.URL.Path)
-			next.ServeHTTP(w, r)
-		} else {
-			// Если доступ запрещен, отправляем кастомное сообщение в ответе
-			w.WriteHeader(http.StatusForbidden)
-			jsonResponse := struct {
-				Message string `json:"message"`
-			}{
-				Message: "Доступ запрещен",
-			}
-			responseBody, _ := json.Marshal(jsonResponse)
-			w.Header().Set("Content-Type", "application/json")
-			w.Write(responseBody)
+		allowed, err := e.Enforce(principal.Role, r.URL.Path, r.Method)
+		if err != nil {
+			log.Printf("path=%s method=%s role=%s enforce error: %v", r.URL.Path, r.Method, principal.Role, err)
+			sendMessage(w, http.StatusInternalServerError, "Ошибка проверки прав доступа")
 			return
 		}
-	})
-}
-
-func getRoleFromToken(tokenString string) (string, error) {
-	secret := os.Getenv("JWT_SECRET")
-	if secret == "" {
-		return "", errors.New("missing JWT_SECRET")
-	}
-
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		log.Printf("path=%s method=%s role=%s user=%s key=%s access=%v",
+			r.URL.Path, r.Method, principal.Role, principal.UserID, principal.APIKeyID, allowed)
+		if !allowed {
+			sendMessage(w, http.StatusForbidden, "Доступ запрещен")
+			return
 		}
-		return []byte(secret), nil
-	})
-
-	if err != nil {
-		return "", fmt.Errorf("error parsing token: %w", err)
-	}
-
-	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-		role, ok := claims["role"].(string)
-		if !ok {
-			return "", errors.New("role not found or invalid in token")
+		if principal.IsAnonymous() && !readOnly(r.Method) {
+			sendMessage(w, http.StatusForbidden, "Тестовому токену доступно только чтение")
+			return
 		}
-		return role, nil
-	}
 
-	return "", errors.New("invalid token")
+		next.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), principal)))
+	})
 }
 
-func GetEntMw() middleware.Builder {
-	e, _ := casbin.NewEnforcerSafe("./model.conf", "./policy.csv")
-
-	skipPaths := map[string]bool{
-		"/dummyLogin": true,
+func readOnly(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
 	}
+	return false
+}
 
-	return func(next http.Handler) http.Handler {
-		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-
-			if skipPaths[r.URL.Path] {
-				log.Printf("%v %v %v", next, w, r)
-				next.ServeHTTP(w, r)
-				return
-			}
+func unauthorized(w http.ResponseWriter, message string) {
+	w.Header().Set("WWW-Authenticate", `Bearer realm="api"`)
+	sendMessage(w, http.StatusUnauthorized, message)
+}
 
-			role := ""
-			res, _ := e.EnforceSafe(role, r.URL.Path, r.Method)
-			log.Printf("path=%s role=%s access=%v", r.URL.Path, role, res)
-			if res {
-				next.ServeHTTP(w, r)
-			} else {
-				http.Error(w, "forbidden", http.StatusForbidden)
-				return
-			}
-		})
-	}
+func sendMessage(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	//nolint:errchkjson
+	json.NewEncoder(w).Encode(struct {
+		Message string `json:"message"`
+	}{message})
 }
@@ -0,0 +1,209 @@
+package acl
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/loads"
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/restapi"
+)
+
+// DummyVerifier проверяет токены, подписанные HS256 общим секретом.
+type DummyVerifier struct {
+	Secret []byte
+}
+
+func (v *DummyVerifier) Parse(tokenString string, claims jwt.Claims) (*jwt.Token, error) {
+	return jwt.ParseWithClaims(tokenString, claims, func(*jwt.Token) (interface{}, error) {
+		return v.Secret, nil
+	})
+}
+
+func (v *DummyVerifier) Sign(t *testing.T, claims jwt.MapClaims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString(v.Secret)
+	require.NoError(t, err)
+	return token
+}
+
+type DummySessions struct {
+	Revoked map[string]bool
+}
+
+func (s *DummySessions) IsSessionActive(ctx context.Context, sessionID string) (bool, error) {
+	return !s.Revoked[sessionID], nil
+}
+
+type DummyAPIKeys struct {
+	Keys map[string]*auth.Principal
+}
+
+func (k *DummyAPIKeys) AuthenticateAPIKey(ctx context.Context, key string) (*auth.Principal, error) {
+	principal, ok := k.Keys[key]
+	if !ok {
+		return nil, auth.ErrInvalidAPIKey
+	}
+	return principal, nil
+}
+
+func loadRoutes(t *testing.T) *Routes {
+	t.Helper()
+	doc, err := loads.Embedded(restapi.SwaggerJSON, restapi.FlatSwaggerJSON)
+	require.NoError(t, err)
+	return RoutesFromSpec(doc)
+}
+
+func TestRoutesFromSpec(t *testing.T) {
+	routes := loadRoutes(t)
+
+	tests := []struct {
+		method   string
+		path     string
+		expected Access
+	}{
+		{"POST", "/login", AccessPublic},
+		{"POST", "/dummyLogin", AccessPublic},
+		{"GET", "/.well-known/jwks.json", AccessPublic},
+		{"GET", "/pvz", AccessPublic},
+		{"POST", "/pvz", AccessRequired},
+		{"POST", "/register", AccessOptional},
+		{"GET", "/users", AccessRequired},
+		{"PATCH", "/me", AccessRequired},
+		{"POST", "/email/confirm", AccessPublic},
+		{"get", "/pvz/3fa85f64-5717-4562-b3fc-2c963f66afa6", AccessRequired},
+		{"POST", "/pvz/3fa85f64-5717-4562-b3fc-2c963f66afa6/close_last_reception", AccessRequired},
+		{"GET", "/unknown", AccessRequired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method+" "+tt.path, func(t *testing.T) {
+			assert.Equal(t, tt.expected, routes.Access(tt.method, tt.path))
+		})
+	}
+}
+
+func TestAclMiddleware(t *testing.T) {
+	enforcer, err := NewEnforcer(&DummyAdapter{Rules: [][]string{
+		{"p", "employee", "/receptions", "POST"},
+		{"p", "employee", "/pvz/:pvzId/close_last_reception", "POST"},
+		{"p", "moderator", "/users", "GET"},
+		{"p", "moderator", "/users/:userId", "DELETE"},
+	}})
+	require.NoError(t, err)
+
+	verifier := &DummyVerifier{Secret: []byte("secret")}
+	sessions := &DummySessions{Revoked: map[string]bool{"revoked-session": true}}
+	apiKeys := &DummyAPIKeys{Keys: map[string]*auth.Principal{
+		"valid-key": {Role: "employee", APIKeyID: "key-1"},
+	}}
+
+	exp := time.Now().Add(time.Hour).Unix()
+	employee := verifier.Sign(t, jwt.MapClaims{"sub": "user-1", "role": "employee", "sid": "session", "exp": exp})
+	moderator := verifier.Sign(t, jwt.MapClaims{"sub": "user-2", "role": "moderator", "exp": exp})
+	expired := verifier.Sign(t, jwt.MapClaims{"sub": "user-1", "role": "employee", "exp": time.Now().Add(-time.Minute).Unix()})
+	revoked := verifier.Sign(t, jwt.MapClaims{"sub": "user-1", "role": "employee", "sid": "revoked-session", "exp": exp})
+	dummyEmployee := verifier.Sign(t, jwt.MapClaims{"role": "employee", "exp": exp})
+	dummyModerator := verifier.Sign(t, jwt.MapClaims{"role": "moderator", "exp": exp})
+	noRole := verifier.Sign(t, jwt.MapClaims{"sub": "user-1", "exp": exp})
+	forged := (&DummyVerifier{Secret: []byte("other")}).Sign(t, jwt.MapClaims{"sub": "user-2", "role": "moderator", "exp": exp})
+
+	handler := NewAclMiddleware(
+		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if principal, ok := auth.PrincipalFromContext(r.Context()); ok {
+				w.Header().Set("X-Role", principal.Role)
+			}
+			w.WriteHeader(http.StatusOK)
+		}),
+		enforcer,
+		loadRoutes(t),
+		Chain{APIKeyAuth(apiKeys), CookieAuth(verifier, sessions), BearerAuth(verifier, sessions)},
+	)
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		cookie         string
+		authorization  string
+		apiKey         string
+		expectedStatus int
+		expectedRole   string
+	}{
+		{name: "Public route without credentials", method: "GET", path: "/pvz", expectedStatus: http.StatusOK},
+		{name: "Public route ignores expired token", method: "POST", path: "/login", authorization: "Bearer " + expired, expectedStatus: http.StatusOK},
+		{name: "Optional route without credentials", method: "POST", path: "/register", expectedStatus: http.StatusOK},
+		{name: "Optional route recognizes token", method: "POST", path: "/register", authorization: "Bearer " + moderator, expectedStatus: http.StatusOK, expectedRole: "moderator"},
+		{name: "Optional route ignores expired token", method: "POST", path: "/register", authorization: "Bearer " + expired, expectedStatus: http.StatusOK},
+		{name: "No credentials", method: "POST", path: "/receptions", expectedStatus: http.StatusUnauthorized},
+		{name: "Bearer token", method: "POST", path: "/receptions", authorization: "Bearer " + employee, expectedStatus: http.StatusOK, expectedRole: "employee"},
+		{name: "Cookie token", method: "POST", path: "/receptions", cookie: employee, expectedStatus: http.StatusOK, expectedRole: "employee"},
+		{name: "Path parameter", method: "POST", path: "/pvz/3fa85f64-5717-4562-b3fc-2c963f66afa6/close_last_reception", cookie: employee, expectedStatus: http.StatusOK, expectedRole: "employee"},
+		{name: "Expired token", method: "POST", path: "/receptions", authorization: "Bearer " + expired, expectedStatus: http.StatusUnauthorized},
+		{name: "Forged token", method: "GET", path: "/users", authorization: "Bearer " + forged, expectedStatus: http.StatusUnauthorized},
+		{name: "Malformed token", method: "POST", path: "/receptions", cookie: "not-a-jwt", expectedStatus: http.StatusUnauthorized},
+		{name: "Token without role", method: "POST", path: "/receptions", authorization: "Bearer " + noRole, expectedStatus: http.StatusUnauthorized},
+		{name: "Revoked session", method: "POST", path: "/receptions", authorization: "Bearer " + revoked, expectedStatus: http.StatusUnauthorized},
+		{name: "Unsupported scheme", method: "POST", path: "/receptions", authorization: "Basic dXNlcjpwYXNz", expectedStatus: http.StatusUnauthorized},
+		{name: "Invalid cookie is not replaced by header", method: "POST", path: "/receptions", cookie: expired, authorization: "Bearer " + employee, expectedStatus: http.StatusUnauthorized},
+		{name: "Role without permission", method: "GET", path: "/users", authorization: "Bearer " + employee, expectedStatus: http.StatusForbidden},
+		{name: "Moderator permission", method: "GET", path: "/users", authorization: "Bearer " + moderator, expectedStatus: http.StatusOK, expectedRole: "moderator"},
+		{name: "Unknown route denied by policy", method: "GET", path: "/unknown", authorization: "Bearer " + moderator, expectedStatus: http.StatusForbidden},
+		{name: "API key", method: "POST", path: "/receptions", apiKey: "valid-key", expectedStatus: http.StatusOK, expectedRole: "employee"},
+		{name: "Dummy employee cannot write", method: "POST", path: "/receptions", authorization: "Bearer " + dummyEmployee, expectedStatus: http.StatusForbidden},
+		{name: "Dummy moderator cannot write", method: "DELETE", path: "/users/3fa85f64-5717-4562-b3fc-2c963f66afa6", authorization: "Bearer " + dummyModerator, expectedStatus: http.StatusForbidden},
+		{name: "Dummy moderator can read", method: "GET", path: "/users", authorization: "Bearer " + dummyModerator, expectedStatus: http.StatusOK, expectedRole: "moderator"},
+		{name: "Real moderator can write", method: "DELETE", path: "/users/3fa85f64-5717-4562-b3fc-2c963f66afa6", authorization: "Bearer " + moderator, expectedStatus: http.StatusOK, expectedRole: "moderator"},
+		{name: "Invalid API key", method: "POST", path: "/receptions", apiKey: "wrong-key", cookie: employee, expectedStatus: http.StatusUnauthorized},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: JWTCookie, Value: tt.cookie})
+			}
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			if tt.apiKey != "" {
+				req.Header.Set(APIKeyHeader, tt.apiKey)
+			}
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Equal(t, tt.expectedRole, rr.Header().Get("X-Role"))
+			if tt.expectedStatus == http.StatusUnauthorized {
+				assert.NotEmpty(t, rr.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
+
+func TestChain_FirstCredentialsDecide(t *testing.T) {
+	errRejected := errors.New("rejected")
+	called := false
+	chain := Chain{
+		AuthenticatorFunc(func(*http.Request) (*auth.Principal, error) { return nil, nil }),
+		AuthenticatorFunc(func(*http.Request) (*auth.Principal, error) { return nil, errRejected }),
+		AuthenticatorFunc(func(*http.Request) (*auth.Principal, error) {
+			called = true
+			return &auth.Principal{Role: "moderator"}, nil
+		}),
+	}
+
+	principal, err := chain.Authenticate(httptest.NewRequest("GET", "/", nil))
+
+	assert.ErrorIs(t, err, errRejected)
+	assert.Nil(t, principal)
+	assert.False(t, called)
+}
@@ -0,0 +1,110 @@
+package acl
+
+import (
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/casbin/casbin/util"
+	"github.com/go-openapi/loads"
+	"github.com/go-openapi/spec"
+)
+
+// Access — что операция требует от запроса.
+type Access int
+
+const (
+	// AccessRequired — нужен вход; запрос без учетных данных получает 401.
+	AccessRequired Access = iota
+	// AccessOptional — вход не обязателен, но действующие учетные данные распознаются:
+	// так модератор регистрирует других модераторов.
+	AccessOptional
+	// AccessPublic — учетные данные не проверяются.
+	AccessPublic
+)
+
+type route struct {
+	method string
+	// pattern в формате keyMatch2, как пути в правилах casbin: /pvz/:pvzId
+	pattern string
+	access  Access
+}
+
+// Routes — требования к входу для операций swagger-спецификации.
+type Routes struct {
+	routes []route
+}
+
+var pathParam = regexp.MustCompile(`\{([^}/]+)\}`)
+
+// RoutesFromSpec читает требования к входу из секций security спецификации. Операция
+// без своей секции наследует глобальную, `security: []` делает ее публичной, а пустое
+// требование `{}` среди прочих разрешает обращаться к ней без входа.
+func RoutesFromSpec(doc *loads.Document) *Routes {
+	sw := doc.Spec()
+	routes := &Routes{}
+	if sw.Paths == nil {
+		return routes
+	}
+
+	basePath := strings.TrimSuffix(sw.BasePath, "/")
+	for path, item := range sw.Paths.Paths {
+		pattern := basePath + pathParam.ReplaceAllString(path, ":$1")
+		for method, op := range operations(item) {
+			requirements := op.Security
+			if requirements == nil {
+				requirements = sw.Security
+			}
+			routes.routes = append(routes.routes, route{method: method, pattern: pattern, access: accessFor(requirements)})
+		}
+	}
+	return routes
+}
+
+// Access возвращает требование операции. Пути, которых нет в спецификации, считаются
+// закрытыми.
+func (rs *Routes) Access(method, path string) Access {
+	method = strings.ToUpper(method)
+	// Точное совпадение важнее шаблона с параметрами
+	for _, rt := range rs.routes {
+		if rt.method == method && rt.pattern == path {
+			return rt.access
+		}
+	}
+	for _, rt := range rs.routes {
+		if rt.method == method && strings.Contains(rt.pattern, "/:") && util.KeyMatch2(path, rt.pattern) {
+			return rt.access
+		}
+	}
+	return AccessRequired
+}
+
+func accessFor(requirements []map[string][]string) Access {
+	if len(requirements) == 0 {
+		return AccessPublic
+	}
+	for _, requirement := range requirements {
+		if len(requirement) == 0 {
+			return AccessOptional
+		}
+	}
+	return AccessRequired
+}
+
+func operations(item spec.PathItem) map[string]*spec.Operation {
+	ops := map[string]*spec.Operation{}
+	for method, op := range map[string]*spec.Operation{
+		http.MethodGet:     item.Get,
+		http.MethodPost:    item.Post,
+		http.MethodPut:     item.Put,
+		http.MethodPatch:   item.Patch,
+		http.MethodDelete:  item.Delete,
+		http.MethodHead:    item.Head,
+		http.MethodOptions: item.Options,
+	} {
+		if op != nil {
+			ops[method] = op
+		}
+	}
+	return ops
+}
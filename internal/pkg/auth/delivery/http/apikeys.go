@@ -0,0 +1,79 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/internal/pkg/utils/log"
+	"github.com/totorialman/go-task-avito/models"
+	"github.com/totorialman/go-task-avito/restapi/operations"
+)
+
+func (h *AuthHandler) HandleCreateAPIKey(params operations.PostAPIKeysParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+	ctx := params.HTTPRequest.Context()
+
+	// Ключ не может выпускать другие ключи, иначе утекший ключ переживет отзыв. Тестовый
+	// токен /dummyLogin тоже не может: ключ остался бы без автора.
+	if principal, ok := auth.PrincipalFromContext(ctx); ok && principal.APIKeyID != "" {
+		log.LogHandlerError(logger, errors.New("api key cannot create api keys"), http.StatusForbidden)
+		return operations.NewPostAPIKeysForbidden().WithPayload(&models.Error{
+			Message: swag.String("API-ключ нельзя выпустить по API-ключу"),
+		})
+	}
+	actorID := auth.UserIDFromContext(ctx)
+	if err := auth.RequireActor(actorID); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("CreateAPIKey error: %w", err), http.StatusForbidden)
+		return operations.NewPostAPIKeysForbidden().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	}
+	if params.Body.Name == nil || params.Body.Role == nil {
+		log.LogHandlerError(logger, errors.New("name and role are required"), http.StatusBadRequest)
+		return operations.NewPostAPIKeysBadRequest().WithPayload(&models.Error{
+			Message: swag.String("name and role are required"),
+		})
+	}
+
+	ttl := time.Duration(params.Body.TTLHours) * time.Hour
+	key, err := h.authUsecase.CreateAPIKey(ctx, actorID, *params.Body.Name, *params.Body.Role,
+		params.Body.PvzIds, ttl)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("CreateAPIKey error: %w", err), http.StatusBadRequest)
+		return operations.NewPostAPIKeysBadRequest().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewPostAPIKeysCreated().WithPayload(key)
+}
+
+func (h *AuthHandler) HandleListAPIKeys(params operations.GetAPIKeysParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	keys, err := h.authUsecase.ListAPIKeys(params.HTTPRequest.Context())
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("ListAPIKeys error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewGetAPIKeysOK().WithPayload(keys)
+}
+
+func (h *AuthHandler) HandleRevokeAPIKey(params operations.DeleteAPIKeysKeyIDParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	err := h.authUsecase.RevokeAPIKey(params.HTTPRequest.Context(), params.KeyID)
+	if errors.Is(err, auth.ErrAPIKeyNotFound) {
+		log.LogHandlerError(logger, fmt.Errorf("RevokeAPIKey error: %w", err), http.StatusNotFound)
+		return operations.NewDeleteAPIKeysKeyIDNotFound().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("RevokeAPIKey error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewDeleteAPIKeysKeyIDNoContent()
+}
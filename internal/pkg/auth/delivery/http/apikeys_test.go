@@ -0,0 +1,114 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/models"
+	"github.com/totorialman/go-task-avito/restapi/operations"
+)
+
+const apiKeyID = strfmt.UUID("55555555-5555-5555-5555-555555555555")
+
+func TestAuthHandler_HandleCreateAPIKey(t *testing.T) {
+	pvzID := strfmt.UUID("33333333-3333-3333-3333-333333333333")
+
+	tests := []struct {
+		name           string
+		principal      *auth.Principal
+		body           operations.PostAPIKeysBody
+		mockError      error
+		expectedStatus int
+		expectedTTL    time.Duration
+	}{
+		{
+			"Success", &auth.Principal{UserID: moderatorID, Role: models.UserRoleModerator},
+			operations.PostAPIKeysBody{Name: swag.String("scanner"), Role: swag.String(models.UserRoleEmployee),
+				PvzIds: []strfmt.UUID{pvzID}, TTLHours: 24},
+			nil, http.StatusCreated, 24 * time.Hour,
+		},
+		{
+			"Missing role", &auth.Principal{UserID: moderatorID, Role: models.UserRoleModerator},
+			operations.PostAPIKeysBody{Name: swag.String("scanner")},
+			nil, http.StatusBadRequest, 0,
+		},
+		{
+			"Called with API key", &auth.Principal{APIKeyID: apiKeyID, Role: models.UserRoleModerator},
+			operations.PostAPIKeysBody{Name: swag.String("scanner"), Role: swag.String(models.UserRoleEmployee)},
+			nil, http.StatusForbidden, 0,
+		},
+		{
+			"Dummy moderator", &auth.Principal{Role: models.UserRoleModerator},
+			operations.PostAPIKeysBody{Name: swag.String("scanner"), Role: swag.String(models.UserRoleEmployee)},
+			nil, http.StatusForbidden, 0,
+		},
+		{
+			"Usecase error", &auth.Principal{UserID: moderatorID, Role: models.UserRoleModerator},
+			operations.PostAPIKeysBody{Name: swag.String("scanner"), Role: swag.String(models.UserRoleEmployee)},
+			auth.ErrDBError, http.StatusBadRequest, 0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.CreateAPIKeyResult.Key = &models.APIKey{ID: apiKeyID, Key: "pvz_0011223344556677_secret"}
+			mock.CreateAPIKeyResult.Err = tt.mockError
+			handler := NewAuthHandler(mock, nil)
+
+			req := httptest.NewRequest(http.MethodPost, "/api-keys", nil)
+			req = req.WithContext(auth.WithPrincipal(req.Context(), tt.principal))
+			resp := handler.HandleCreateAPIKey(operations.PostAPIKeysParams{HTTPRequest: req, Body: tt.body})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusCreated {
+				assert.Equal(t, moderatorID, mock.CreateAPIKeyResult.ActorID)
+				assert.Equal(t, tt.body.PvzIds, mock.CreateAPIKeyResult.PVZIDs)
+				assert.Equal(t, tt.expectedTTL, mock.CreateAPIKeyResult.TTL)
+				assert.Contains(t, rr.Body.String(), "pvz_0011223344556677_secret")
+			}
+			if tt.expectedStatus == http.StatusForbidden {
+				assert.False(t, mock.CreateAPIKeyResult.Called)
+			}
+		})
+	}
+}
+
+func TestAuthHandler_HandleRevokeAPIKey(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockError      error
+		expectedStatus int
+	}{
+		{"Success", nil, http.StatusNoContent},
+		{"Not found", auth.ErrAPIKeyNotFound, http.StatusNotFound},
+		{"DB error", auth.ErrDBError, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.RevokeAPIKeyResult.Err = tt.mockError
+			handler := NewAuthHandler(mock, nil)
+
+			resp := handler.HandleRevokeAPIKey(operations.DeleteAPIKeysKeyIDParams{
+				HTTPRequest: moderatorRequest(http.MethodDelete, "/api-keys/"+apiKeyID.String()),
+				KeyID:       apiKeyID,
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Equal(t, apiKeyID, mock.RevokeAPIKeyResult.KeyID)
+		})
+	}
+}
@@ -1,11 +1,15 @@
 package http
 
 import (
+	"context"
+	"crypto/subtle"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"net"
 	"net/http"
-	"os"
+	"strings"
 	"time"
 
 	"log/slog"
@@ -14,6 +18,7 @@ import (
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/swag"
 	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/internal/pkg/metrics"
 	"github.com/totorialman/go-task-avito/internal/pkg/utils/log"
 	utils "github.com/totorialman/go-task-avito/internal/pkg/utils/sendError"
 	"github.com/totorialman/go-task-avito/models"
@@ -22,11 +27,63 @@ import (
 
 type AuthHandler struct {
 	authUsecase auth.AuthUsecase
-	secret      string
+	mt          *metrics.AuthMetrics
 }
 
-func NewAuthHandler(authUsecase auth.AuthUsecase) *AuthHandler {
-	return &AuthHandler{authUsecase: authUsecase, secret: os.Getenv("JWT_SECRET")}
+const (
+	refreshCookieName = "refresh_token"
+	// oauthCookieName хранит state, nonce и PKCE verifier между /oauth/login и /oauth/callback.
+	oauthCookieName = "oauth_flow"
+	oauthCookiePath = "/oauth"
+	oauthFlowTTL    = 10 * time.Minute
+)
+
+func NewAuthHandler(authUsecase auth.AuthUsecase, mt *metrics.AuthMetrics) *AuthHandler {
+	return &AuthHandler{authUsecase: authUsecase, mt: mt}
+}
+
+func setSessionCookies(w http.ResponseWriter, tokens *auth.Tokens, secure bool) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     "JWT",
+		Value:    tokens.Access,
+		HttpOnly: true,
+		Secure:   secure,
+		Expires:  tokens.AccessExpiresAt,
+		Path:     "/",
+		SameSite: http.SameSiteLaxMode,
+	})
+	http.SetCookie(w, &http.Cookie{
+		Name:     refreshCookieName,
+		Value:    tokens.Refresh,
+		HttpOnly: true,
+		Secure:   secure,
+		Expires:  tokens.RefreshExpiresAt,
+		Path:     "/",
+		SameSite: http.SameSiteStrictMode,
+	})
+}
+
+func clearSessionCookies(w http.ResponseWriter) {
+	for _, name := range []string{"JWT", refreshCookieName} {
+		http.SetCookie(w, &http.Cookie{
+			Name:     name,
+			Value:    "",
+			HttpOnly: true,
+			MaxAge:   -1,
+			Path:     "/",
+		})
+	}
+}
+
+// refreshTokenFrom берет refresh-токен из тела запроса, а если его там нет — из куки.
+func refreshTokenFrom(r *http.Request, fromBody string) string {
+	if fromBody != "" {
+		return fromBody
+	}
+	if cookie, err := r.Cookie(refreshCookieName); err == nil {
+		return cookie.Value
+	}
+	return ""
 }
 
 func (h *AuthHandler) HandleDummyLogin(params operations.PostDummyLoginParams) middleware.Responder {
@@ -75,7 +132,18 @@ func (h *AuthHandler) HandleLogin(params operations.PostLoginParams) middleware.
 	}
 
 	email := string(*params.Body.Email)
-	_, token, err := h.authUsecase.Login(params.HTTPRequest.Context(), email, *params.Body.Password)
+	ctx := withDevice(params.HTTPRequest, params.Body.DeviceLabel)
+	_, tokens, err := h.authUsecase.Login(ctx, email, *params.Body.Password, clientIP(params.HTTPRequest))
+	if err != nil {
+		h.countFailedLogin(err)
+	}
+	var lockout *auth.LockoutError
+	if errors.As(err, &lockout) {
+		log.LogHandlerError(logger, fmt.Errorf("login locked: %w", err), http.StatusTooManyRequests)
+		return operations.NewPostLoginTooManyRequests().
+			WithRetryAfter(int64(math.Ceil(lockout.RetryAfter.Seconds()))).
+			WithPayload(&models.Error{Message: swag.String(err.Error())})
+	}
 	if err != nil {
 		log.LogHandlerError(logger, fmt.Errorf("login failed: %w", err), http.StatusUnauthorized)
 		return operations.NewPostLoginUnauthorized().WithPayload(
@@ -85,52 +153,85 @@ func (h *AuthHandler) HandleLogin(params operations.PostLoginParams) middleware.
 
 	logger.Info("User logged in successfully", slog.String("email", email))
 	return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
-		http.SetCookie(w, &http.Cookie{
-			Name:     "JWT",
-			Value:    token,
-			HttpOnly: true,
-			Secure:   false,
-			Expires:  time.Now().Add(24 * time.Hour),
-			Path:     "/",
-			SameSite: http.SameSiteLaxMode,
-		})
+		setSessionCookies(w, tokens, false)
 		w.WriteHeader(http.StatusOK)
-		_ = p.Produce(w, models.Token(token))
+		_ = p.Produce(w, models.Token(tokens.Access))
+	})
+}
+
+// countFailedLogin считает неудачные входы по причинам для алертов на перебор паролей.
+func (h *AuthHandler) countFailedLogin(err error) {
+	if h.mt == nil {
+		return
+	}
+	switch {
+	case errors.Is(err, auth.ErrTooManyAttempts):
+		h.mt.IncreaseFailedLogins("locked")
+	case errors.Is(err, auth.ErrInvalidLogin):
+		h.mt.IncreaseFailedLogins("unknown_user")
+	case errors.Is(err, auth.ErrInvalidPassword):
+		h.mt.IncreaseFailedLogins("invalid_password")
+	case errors.Is(err, auth.ErrUserDisabled):
+		h.mt.IncreaseFailedLogins("disabled")
+	default:
+		h.mt.IncreaseFailedLogins("error")
+	}
+}
+
+// clientIP — адрес, с которого пришел запрос. X-Forwarded-For не учитывается:
+// его может подставить сам клиент и обойти блокировку по IP.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// withDevice добавляет в контекст устройство, с которого входит пользователь:
+// оно будет видно в списке его сессий.
+func withDevice(r *http.Request, label string) context.Context {
+	return auth.WithDevice(r.Context(), auth.Device{
+		Label:     strings.TrimSpace(label),
+		UserAgent: r.UserAgent(),
+		IP:        clientIP(r),
 	})
 }
 
 func (h *AuthHandler) HandleSignUp(params operations.PostRegisterParams) middleware.Responder {
 	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
 
-	if params.Body.Email == nil || params.Body.Password == nil || params.Body.Role == nil {
-		log.LogHandlerError(logger, errors.New("email, password and role are required"), http.StatusBadRequest)
+	if params.Body.Email == nil || params.Body.Password == nil || (params.Body.Role == "" && params.Body.InviteCode == "") {
+		log.LogHandlerError(logger, errors.New("email, password and role or inviteCode are required"), http.StatusBadRequest)
 		return operations.NewPostRegisterBadRequest().WithPayload(
-			&models.Error{Message: swag.String("email, password and role are required")},
+			&models.Error{Message: swag.String("email, password and role or inviteCode are required")},
 		)
 	}
 
 	email := string(*params.Body.Email)
 	password := string(*params.Body.Password)
-	role := string(*params.Body.Role)
 
-	user, token, err := h.authUsecase.SignUp(params.HTTPRequest.Context(), email, password, role)
+	user, tokens, err := h.authUsecase.SignUp(withDevice(params.HTTPRequest, ""), email, password, params.Body.Role, params.Body.InviteCode)
+	if errors.Is(err, auth.ErrModeratorSignUp) || errors.Is(err, auth.ErrEmailDomainNotAllowed) {
+		log.LogHandlerError(logger, fmt.Errorf("signup forbidden: %w", err), http.StatusForbidden)
+		return operations.NewPostRegisterForbidden().WithPayload(
+			&models.Error{Message: swag.String(err.Error())},
+		)
+	}
 	if err != nil {
 		log.LogHandlerError(logger, fmt.Errorf("signup failed: %w", err), http.StatusBadRequest)
 		return operations.NewPostRegisterBadRequest().WithPayload(
 			&models.Error{Message: swag.String(err.Error())},
 		)
 	}
+	role := *user.Role
 
 	logger.Info("User signed up successfully", slog.String("email", email), slog.String("role", role))
 	return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
-		http.SetCookie(w, &http.Cookie{
-			Name:     "JWT",
-			Value:    token,
-			HttpOnly: true,
-			Secure:   true,
-			Expires:  time.Now().Add(24 * time.Hour),
-			Path:     "/",
-		})
+		// Пользователю, созданному модератором, сессия не открывается.
+		if tokens != nil {
+			setSessionCookies(w, tokens, true)
+		}
 		w.Header().Set("Content-Type", "application/json")
 
 		if err := json.NewEncoder(w).Encode(user); err != nil {
@@ -139,3 +240,229 @@ func (h *AuthHandler) HandleSignUp(params operations.PostRegisterParams) middlew
 		}
 	})
 }
+
+func (h *AuthHandler) HandleRefresh(params operations.PostRefreshParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	refreshToken := refreshTokenFrom(params.HTTPRequest, params.Body.RefreshToken)
+	tokens, err := h.authUsecase.Refresh(params.HTTPRequest.Context(), refreshToken)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("refresh failed: %w", err), http.StatusUnauthorized)
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			if errors.Is(err, auth.ErrRefreshTokenReuse) {
+				clearSessionCookies(w)
+			}
+			w.WriteHeader(http.StatusUnauthorized)
+			_ = p.Produce(w, &models.Error{Message: swag.String(err.Error())})
+		})
+	}
+
+	return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+		setSessionCookies(w, tokens, false)
+		w.WriteHeader(http.StatusOK)
+		_ = p.Produce(w, models.Token(tokens.Access))
+	})
+}
+
+func (h *AuthHandler) HandleLogout(params operations.PostLogoutParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	refreshToken := refreshTokenFrom(params.HTTPRequest, params.Body.RefreshToken)
+	if err := h.authUsecase.Logout(params.HTTPRequest.Context(), refreshToken); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("logout failed: %w", err), http.StatusInternalServerError)
+	}
+
+	return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+		clearSessionCookies(w)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (h *AuthHandler) HandleChangePassword(params operations.PostMePasswordParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	if params.Body.OldPassword == nil || params.Body.NewPassword == nil {
+		log.LogHandlerError(logger, errors.New("oldPassword and newPassword are required"), http.StatusBadRequest)
+		return operations.NewPostMePasswordBadRequest().WithPayload(
+			&models.Error{Message: swag.String("oldPassword and newPassword are required")},
+		)
+	}
+
+	err := h.authUsecase.ChangePassword(params.HTTPRequest.Context(), *params.Body.OldPassword, *params.Body.NewPassword)
+	switch {
+	case errors.Is(err, auth.ErrInvalidPassword), errors.Is(err, auth.ErrUserNotFound):
+		log.LogHandlerError(logger, fmt.Errorf("change password failed: %w", err), http.StatusUnauthorized)
+		return operations.NewPostMePasswordUnauthorized().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, auth.ErrWeakPassword), errors.Is(err, auth.ErrSamePassword):
+		log.LogHandlerError(logger, fmt.Errorf("change password failed: %w", err), http.StatusBadRequest)
+		return operations.NewPostMePasswordBadRequest().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case err != nil:
+		log.LogHandlerError(logger, fmt.Errorf("change password failed: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewPostMePasswordNoContent()
+}
+
+func (h *AuthHandler) HandleForgotPassword(params operations.PostPasswordForgotParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	if params.Body.Email == nil {
+		log.LogHandlerError(logger, errors.New("email is required"), http.StatusBadRequest)
+		return operations.NewPostPasswordForgotBadRequest().WithPayload(
+			&models.Error{Message: swag.String("email is required")},
+		)
+	}
+
+	err := h.authUsecase.ForgotPassword(params.HTTPRequest.Context(), string(*params.Body.Email))
+	var lockout *auth.LockoutError
+	switch {
+	case errors.As(err, &lockout):
+		log.LogHandlerError(logger, fmt.Errorf("forgot password throttled: %w", err), http.StatusTooManyRequests)
+		return operations.NewPostPasswordForgotTooManyRequests().
+			WithRetryAfter(int64(math.Ceil(lockout.RetryAfter.Seconds()))).
+			WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, auth.ErrPasswordResetDisabled):
+		log.LogHandlerError(logger, err, http.StatusNotFound)
+		return operations.NewPostPasswordForgotNotFound().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case err != nil:
+		log.LogHandlerError(logger, fmt.Errorf("forgot password failed: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewPostPasswordForgotAccepted()
+}
+
+func (h *AuthHandler) HandleResetPassword(params operations.PostPasswordResetParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	if params.Body.Token == nil || params.Body.NewPassword == nil {
+		log.LogHandlerError(logger, errors.New("token and newPassword are required"), http.StatusBadRequest)
+		return operations.NewPostPasswordResetBadRequest().WithPayload(
+			&models.Error{Message: swag.String("token and newPassword are required")},
+		)
+	}
+
+	err := h.authUsecase.ResetPassword(params.HTTPRequest.Context(), *params.Body.Token, *params.Body.NewPassword)
+	switch {
+	case errors.Is(err, auth.ErrInvalidResetToken), errors.Is(err, auth.ErrWeakPassword):
+		log.LogHandlerError(logger, fmt.Errorf("reset password failed: %w", err), http.StatusBadRequest)
+		return operations.NewPostPasswordResetBadRequest().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case err != nil:
+		log.LogHandlerError(logger, fmt.Errorf("reset password failed: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewPostPasswordResetNoContent()
+}
+
+// jwksMaxAge — сколько партнерам можно кешировать ключи. Новый ключ при ротации
+// нужно опубликовать минимум за это время до того, как он начнет подписывать.
+const jwksMaxAge = 5 * time.Minute
+
+func (h *AuthHandler) HandleJWKS(params operations.GetWellKnownJwksJSONParams) middleware.Responder {
+	jwks := h.authUsecase.PublicKeys(params.HTTPRequest.Context())
+
+	return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+		w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(jwksMaxAge.Seconds())))
+		w.WriteHeader(http.StatusOK)
+		_ = p.Produce(w, jwks)
+	})
+}
+
+func setOAuthFlowCookie(w http.ResponseWriter, login *auth.ExternalLogin) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     oauthCookieName,
+		Value:    strings.Join([]string{login.State, login.Nonce, login.Verifier}, "."),
+		HttpOnly: true,
+		MaxAge:   int(oauthFlowTTL.Seconds()),
+		Path:     oauthCookiePath,
+		// Lax, иначе кука не придет с редиректом от провайдера.
+		SameSite: http.SameSiteLaxMode,
+	})
+}
+
+// oauthFlowFrom возвращает начатый вход, если state из callback совпадает с сохраненным в куке.
+func oauthFlowFrom(r *http.Request, state string) (*auth.ExternalLogin, bool) {
+	cookie, err := r.Cookie(oauthCookieName)
+	if err != nil {
+		return nil, false
+	}
+	parts := strings.Split(cookie.Value, ".")
+	if len(parts) != 3 || state == "" || subtle.ConstantTimeCompare([]byte(parts[0]), []byte(state)) != 1 {
+		return nil, false
+	}
+	return &auth.ExternalLogin{State: parts[0], Nonce: parts[1], Verifier: parts[2]}, true
+}
+
+func clearOAuthFlowCookie(w http.ResponseWriter) {
+	http.SetCookie(w, &http.Cookie{
+		Name:     oauthCookieName,
+		Value:    "",
+		HttpOnly: true,
+		MaxAge:   -1,
+		Path:     oauthCookiePath,
+	})
+}
+
+func (h *AuthHandler) HandleOAuthLogin(params operations.GetOauthLoginParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	login, err := h.authUsecase.StartExternalLogin(params.HTTPRequest.Context())
+	if errors.Is(err, auth.ErrSSODisabled) {
+		log.LogHandlerError(logger, err, http.StatusNotFound)
+		return operations.NewGetOauthLoginNotFound().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("oauth login failed: %w", err), http.StatusBadGateway)
+		return operations.NewGetOauthLoginBadGateway().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	}
+
+	return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+		setOAuthFlowCookie(w, login)
+		w.Header().Set("Location", login.URL)
+		w.WriteHeader(http.StatusFound)
+	})
+}
+
+func (h *AuthHandler) HandleOAuthCallback(params operations.GetOauthCallbackParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	fail := func(status int, err error) middleware.Responder {
+		log.LogHandlerError(logger, fmt.Errorf("oauth callback failed: %w", err), status)
+		return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+			clearOAuthFlowCookie(w)
+			w.WriteHeader(status)
+			_ = p.Produce(w, &models.Error{Message: swag.String(err.Error())})
+		})
+	}
+
+	flow, ok := oauthFlowFrom(params.HTTPRequest, swag.StringValue(params.State))
+	if !ok {
+		return fail(http.StatusBadRequest, errors.New("state не совпадает или вход не начинался"))
+	}
+	if params.Error != nil {
+		return fail(http.StatusUnauthorized, fmt.Errorf("%w: %s", auth.ErrExternalLogin, *params.Error))
+	}
+	if swag.StringValue(params.Code) == "" {
+		return fail(http.StatusBadRequest, errors.New("code is required"))
+	}
+
+	user, tokens, err := h.authUsecase.CompleteExternalLogin(withDevice(params.HTTPRequest, ""), *params.Code, flow.Verifier, flow.Nonce)
+	switch {
+	case errors.Is(err, auth.ErrSSODisabled):
+		return fail(http.StatusNotFound, err)
+	case errors.Is(err, auth.ErrNoRoleForGroups), errors.Is(err, auth.ErrUserDisabled):
+		return fail(http.StatusForbidden, err)
+	case err != nil:
+		return fail(http.StatusUnauthorized, err)
+	}
+
+	logger.Info("User logged in via SSO", slog.String("email", user.Email.String()))
+	return middleware.ResponderFunc(func(w http.ResponseWriter, p runtime.Producer) {
+		clearOAuthFlowCookie(w)
+		setSessionCookies(w, tokens, false)
+		w.WriteHeader(http.StatusOK)
+		_ = p.Produce(w, models.Token(tokens.Access))
+	})
+}
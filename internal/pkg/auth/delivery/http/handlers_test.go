@@ -5,13 +5,19 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/internal/pkg/metrics"
 	"github.com/totorialman/go-task-avito/models"
 	"github.com/totorialman/go-task-avito/restapi/operations"
 )
@@ -27,6 +33,8 @@ type DummyAuthUsecase struct {
 		Called bool
 		Email  string
 		Pass   string
+		IP     string
+		Device auth.Device
 		User   *models.User
 		Token  string
 		Err    error
@@ -36,10 +44,156 @@ type DummyAuthUsecase struct {
 		Email  string
 		Pass   string
 		Role   string
+		Invite string
 		User   *models.User
 		Token  string
 		Err    error
 	}
+	RefreshResult struct {
+		Called bool
+		Token  string
+		Tokens *auth.Tokens
+		Err    error
+	}
+	LogoutResult struct {
+		Called bool
+		Token  string
+		Err    error
+	}
+	JWKS *models.JWKS
+
+	StartResult struct {
+		Login *auth.ExternalLogin
+		Err   error
+	}
+	CompleteResult struct {
+		Called   bool
+		Code     string
+		Verifier string
+		Nonce    string
+		User     *models.User
+		Tokens   *auth.Tokens
+		Err      error
+	}
+
+	ListUsersResult struct {
+		Filter auth.UserFilter
+		Page   *auth.UserPage
+		Err    error
+	}
+	GetUserResult struct {
+		User *models.User
+		Err  error
+	}
+	UpdateUserResult struct {
+		Called  bool
+		ActorID strfmt.UUID
+		Patch   *models.UserPatch
+		User    *models.User
+		Err     error
+	}
+	DeleteUserResult struct {
+		ActorID strfmt.UUID
+		Err     error
+	}
+	UnlockResult struct {
+		UserID strfmt.UUID
+		Err    error
+	}
+	ChangePasswordResult struct {
+		OldPassword string
+		NewPassword string
+		Err         error
+	}
+	ForgotPasswordResult struct {
+		Email string
+		Err   error
+	}
+	ResetPasswordResult struct {
+		Token       string
+		NewPassword string
+		Err         error
+	}
+	InviteResult struct {
+		ActorID strfmt.UUID
+		Role    string
+		TTL     time.Duration
+		Invite  *models.Invite
+		Err     error
+	}
+	CreateAPIKeyResult struct {
+		Called  bool
+		ActorID strfmt.UUID
+		Name    string
+		Role    string
+		PVZIDs  []strfmt.UUID
+		TTL     time.Duration
+		Key     *models.APIKey
+		Err     error
+	}
+	ListAPIKeysResult struct {
+		Keys []*models.APIKey
+		Err  error
+	}
+	RevokeAPIKeyResult struct {
+		KeyID strfmt.UUID
+		Err   error
+	}
+	AuthenticateAPIKeyResult struct {
+		Key       string
+		Principal *auth.Principal
+		Err       error
+	}
+	ListPVZAssignmentsResult struct {
+		Assignments []*models.PVZAssignment
+		Err         error
+	}
+	AssignPVZResult struct {
+		ActorID strfmt.UUID
+		UserID  strfmt.UUID
+		PVZID   strfmt.UUID
+		Err     error
+	}
+	UnassignPVZResult struct {
+		UserID strfmt.UUID
+		PVZID  strfmt.UUID
+		Err    error
+	}
+	ListSessionsResult struct {
+		Sessions []*models.Session
+		Err      error
+	}
+	RevokeSessionResult struct {
+		SessionID strfmt.UUID
+		Err       error
+	}
+	RevokeUserSessionsResult struct {
+		ActorID strfmt.UUID
+		UserID  strfmt.UUID
+		Err     error
+	}
+	GetMeResult struct {
+		User *models.User
+		Err  error
+	}
+	UpdateMeResult struct {
+		Called bool
+		Patch  *models.MePatch
+		User   *models.User
+		Err    error
+	}
+	ConfirmEmailResult struct {
+		Token string
+		Err   error
+	}
+}
+
+// tokensFor оборачивает access-токен из таблицы теста в пару токенов.
+func tokensFor(access string) *auth.Tokens {
+	if access == "" {
+		return nil
+	}
+	return &auth.Tokens{Access: access, Refresh: "refresh-" + access}
 }
 
 func (m *DummyAuthUsecase) GenerateDummyToken(ctx context.Context, role string) (string, error) {
@@ -48,19 +202,171 @@ func (m *DummyAuthUsecase) GenerateDummyToken(ctx context.Context, role string)
 	return m.TokenResult.Token, m.TokenResult.Err
 }
 
-func (m *DummyAuthUsecase) Login(ctx context.Context, email, password string) (*models.User, string, error) {
+func (m *DummyAuthUsecase) Login(ctx context.Context, email, password, clientIP string) (*models.User, *auth.Tokens, error) {
 	m.LoginResult.Called = true
+	m.LoginResult.IP = clientIP
+	m.LoginResult.Device = auth.DeviceFromContext(ctx)
 	m.LoginResult.Email = email
 	m.LoginResult.Pass = password
-	return m.LoginResult.User, m.LoginResult.Token, m.LoginResult.Err
+	return m.LoginResult.User, tokensFor(m.LoginResult.Token), m.LoginResult.Err
 }
 
-func (m *DummyAuthUsecase) SignUp(ctx context.Context, email, password, role string) (*models.User, string, error) {
+func (m *DummyAuthUsecase) SignUp(ctx context.Context, email, password, role, inviteCode string) (*models.User, *auth.Tokens, error) {
 	m.SignUpResult.Called = true
 	m.SignUpResult.Email = email
 	m.SignUpResult.Pass = password
 	m.SignUpResult.Role = role
-	return m.SignUpResult.User, m.SignUpResult.Token, m.SignUpResult.Err
+	m.SignUpResult.Invite = inviteCode
+	return m.SignUpResult.User, tokensFor(m.SignUpResult.Token), m.SignUpResult.Err
+}
+
+func (m *DummyAuthUsecase) Refresh(ctx context.Context, refreshToken string) (*auth.Tokens, error) {
+	m.RefreshResult.Called = true
+	m.RefreshResult.Token = refreshToken
+	return m.RefreshResult.Tokens, m.RefreshResult.Err
+}
+
+func (m *DummyAuthUsecase) Logout(ctx context.Context, refreshToken string) error {
+	m.LogoutResult.Called = true
+	m.LogoutResult.Token = refreshToken
+	return m.LogoutResult.Err
+}
+
+func (m *DummyAuthUsecase) PublicKeys(ctx context.Context) *models.JWKS {
+	return m.JWKS
+}
+
+func (m *DummyAuthUsecase) StartExternalLogin(ctx context.Context) (*auth.ExternalLogin, error) {
+	return m.StartResult.Login, m.StartResult.Err
+}
+
+func (m *DummyAuthUsecase) CompleteExternalLogin(ctx context.Context, code, verifier, nonce string) (*models.User, *auth.Tokens, error) {
+	m.CompleteResult.Called = true
+	m.CompleteResult.Code = code
+	m.CompleteResult.Verifier = verifier
+	m.CompleteResult.Nonce = nonce
+	return m.CompleteResult.User, m.CompleteResult.Tokens, m.CompleteResult.Err
+}
+
+func (m *DummyAuthUsecase) ListUsers(ctx context.Context, filter auth.UserFilter, page, limit int) (*auth.UserPage, error) {
+	m.ListUsersResult.Filter = filter
+	return m.ListUsersResult.Page, m.ListUsersResult.Err
+}
+
+func (m *DummyAuthUsecase) GetUser(ctx context.Context, userID strfmt.UUID) (*models.User, error) {
+	return m.GetUserResult.User, m.GetUserResult.Err
+}
+
+func (m *DummyAuthUsecase) UpdateUser(ctx context.Context, actorID, userID strfmt.UUID, patch *models.UserPatch) (*models.User, error) {
+	m.UpdateUserResult.Called = true
+	m.UpdateUserResult.ActorID = actorID
+	m.UpdateUserResult.Patch = patch
+	return m.UpdateUserResult.User, m.UpdateUserResult.Err
+}
+
+func (m *DummyAuthUsecase) CreateInvite(ctx context.Context, actorID strfmt.UUID, role, email string, ttl time.Duration) (*models.Invite, error) {
+	m.InviteResult.ActorID = actorID
+	m.InviteResult.Role = role
+	m.InviteResult.TTL = ttl
+	return m.InviteResult.Invite, m.InviteResult.Err
+}
+
+func (m *DummyAuthUsecase) UnlockUser(ctx context.Context, actorID, userID strfmt.UUID) error {
+	m.UnlockResult.UserID = userID
+	return m.UnlockResult.Err
+}
+
+func (m *DummyAuthUsecase) ChangePassword(ctx context.Context, oldPassword, newPassword string) error {
+	m.ChangePasswordResult.OldPassword = oldPassword
+	m.ChangePasswordResult.NewPassword = newPassword
+	return m.ChangePasswordResult.Err
+}
+
+func (m *DummyAuthUsecase) ForgotPassword(ctx context.Context, email string) error {
+	m.ForgotPasswordResult.Email = email
+	return m.ForgotPasswordResult.Err
+}
+
+func (m *DummyAuthUsecase) ResetPassword(ctx context.Context, token, newPassword string) error {
+	m.ResetPasswordResult.Token = token
+	m.ResetPasswordResult.NewPassword = newPassword
+	return m.ResetPasswordResult.Err
+}
+
+func (m *DummyAuthUsecase) CreateAPIKey(ctx context.Context, actorID strfmt.UUID, name, role string, pvzIDs []strfmt.UUID, ttl time.Duration) (*models.APIKey, error) {
+	m.CreateAPIKeyResult.Called = true
+	m.CreateAPIKeyResult.ActorID = actorID
+	m.CreateAPIKeyResult.Name = name
+	m.CreateAPIKeyResult.Role = role
+	m.CreateAPIKeyResult.PVZIDs = pvzIDs
+	m.CreateAPIKeyResult.TTL = ttl
+	return m.CreateAPIKeyResult.Key, m.CreateAPIKeyResult.Err
+}
+
+func (m *DummyAuthUsecase) ListAPIKeys(ctx context.Context) ([]*models.APIKey, error) {
+	return m.ListAPIKeysResult.Keys, m.ListAPIKeysResult.Err
+}
+
+func (m *DummyAuthUsecase) RevokeAPIKey(ctx context.Context, keyID strfmt.UUID) error {
+	m.RevokeAPIKeyResult.KeyID = keyID
+	return m.RevokeAPIKeyResult.Err
+}
+
+func (m *DummyAuthUsecase) AuthenticateAPIKey(ctx context.Context, key string) (*auth.Principal, error) {
+	m.AuthenticateAPIKeyResult.Key = key
+	return m.AuthenticateAPIKeyResult.Principal, m.AuthenticateAPIKeyResult.Err
+}
+
+func (m *DummyAuthUsecase) ListPVZAssignments(ctx context.Context, userID strfmt.UUID) ([]*models.PVZAssignment, error) {
+	return m.ListPVZAssignmentsResult.Assignments, m.ListPVZAssignmentsResult.Err
+}
+
+func (m *DummyAuthUsecase) AssignPVZ(ctx context.Context, actorID, userID, pvzID strfmt.UUID) error {
+	m.AssignPVZResult.ActorID = actorID
+	m.AssignPVZResult.UserID = userID
+	m.AssignPVZResult.PVZID = pvzID
+	return m.AssignPVZResult.Err
+}
+
+func (m *DummyAuthUsecase) UnassignPVZ(ctx context.Context, actorID, userID, pvzID strfmt.UUID) error {
+	m.UnassignPVZResult.UserID = userID
+	m.UnassignPVZResult.PVZID = pvzID
+	return m.UnassignPVZResult.Err
+}
+
+func (m *DummyAuthUsecase) ListSessions(ctx context.Context) ([]*models.Session, error) {
+	return m.ListSessionsResult.Sessions, m.ListSessionsResult.Err
+}
+
+func (m *DummyAuthUsecase) RevokeSession(ctx context.Context, sessionID strfmt.UUID) error {
+	m.RevokeSessionResult.SessionID = sessionID
+	return m.RevokeSessionResult.Err
+}
+
+func (m *DummyAuthUsecase) RevokeUserSessions(ctx context.Context, actorID, userID strfmt.UUID) error {
+	m.RevokeUserSessionsResult.ActorID = actorID
+	m.RevokeUserSessionsResult.UserID = userID
+	return m.RevokeUserSessionsResult.Err
+}
+
+func (m *DummyAuthUsecase) GetMe(ctx context.Context) (*models.User, error) {
+	return m.GetMeResult.User, m.GetMeResult.Err
+}
+
+func (m *DummyAuthUsecase) UpdateMe(ctx context.Context, patch *models.MePatch) (*models.User, error) {
+	m.UpdateMeResult.Called = true
+	m.UpdateMeResult.Patch = patch
+	return m.UpdateMeResult.User, m.UpdateMeResult.Err
+}
+
+func (m *DummyAuthUsecase) ConfirmEmail(ctx context.Context, token string) error {
+	m.ConfirmEmailResult.Token = token
+	return m.ConfirmEmailResult.Err
+}
+
+func (m *DummyAuthUsecase) DeleteUser(ctx context.Context, actorID, userID strfmt.UUID) error {
+	m.DeleteUserResult.ActorID = actorID
+	return m.DeleteUserResult.Err
 }
 
 func TestAuthHandler_HandleDummyLogin(t *testing.T) {
@@ -82,7 +388,7 @@ func TestAuthHandler_HandleDummyLogin(t *testing.T) {
 			mock.TokenResult.Token = tt.mockToken
 			mock.TokenResult.Err = tt.mockError
 
-			handler := NewAuthHandler(mock)
+			handler := NewAuthHandler(mock, nil)
 
 			var jsonBody []byte
 			if tt.role != "" {
@@ -170,7 +476,7 @@ func TestAuthHandler_HandleLogin(t *testing.T) {
 			mock.LoginResult.Token = tt.mockToken
 			mock.LoginResult.Err = tt.mockError
 
-			handler := NewAuthHandler(mock)
+			handler := NewAuthHandler(mock, nil)
 
 			email := strfmt.Email(tt.email)
 			password := swag.String(tt.password)
@@ -268,19 +574,19 @@ func TestAuthHandler_HandleSignUp(t *testing.T) {
 			mock.SignUpResult.Token = tt.mockToken
 			mock.SignUpResult.Err = tt.mockError
 
-			handler := NewAuthHandler(mock)
+			handler := NewAuthHandler(mock, nil)
 
 			reqBody := operations.PostRegisterBody{
 				Email:    func() *strfmt.Email { e := strfmt.Email(tt.email); return &e }(),
 				Password: swag.String(tt.password),
-				Role:     swag.String(tt.role),
+				Role:     tt.role,
 			}
 			
 			// Если поля пустые, указываем nil
 			if tt.email == "" || tt.password == "" || tt.role == "" {
 				reqBody.Email = nil
 				reqBody.Password = nil
-				reqBody.Role = nil
+				reqBody.Role = ""
 			}
 
 			jsonBody, _ := json.Marshal(reqBody)
@@ -325,10 +631,401 @@ func TestAuthHandler_HandleSignUp(t *testing.T) {
 	}
 }
 
+func TestAuthHandler_HandleSignUpPolicy(t *testing.T) {
+	invited := &models.User{ID: employeeID, Role: swag.String(models.UserRoleModerator)}
+
+	tests := []struct {
+		name           string
+		role           string
+		inviteCode     string
+		mockUser       *models.User
+		mockToken      string
+		mockError      error
+		expectedStatus int
+		expectCookie   bool
+	}{
+		{
+			name:           "Moderator self-signup",
+			role:           models.UserRoleModerator,
+			mockError:      auth.ErrModeratorSignUp,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Email domain not allowed",
+			role:           models.UserRoleEmployee,
+			mockError:      auth.ErrEmailDomainNotAllowed,
+			expectedStatus: http.StatusForbidden,
+		},
+		{
+			name:           "Invite without role",
+			inviteCode:     "invite-code",
+			mockUser:       invited,
+			mockToken:      "test-token",
+			expectedStatus: http.StatusOK,
+			expectCookie:   true,
+		},
+		{
+			name:           "Invalid invite",
+			inviteCode:     "used-code",
+			mockError:      auth.ErrInvalidInvite,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Created by moderator gets no session",
+			role:           models.UserRoleModerator,
+			mockUser:       invited,
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Neither role nor invite",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.SignUpResult.User = tt.mockUser
+			mock.SignUpResult.Token = tt.mockToken
+			mock.SignUpResult.Err = tt.mockError
+			handler := NewAuthHandler(mock, nil)
+
+			email := strfmt.Email("new@corp.example")
+			resp := handler.HandleSignUp(operations.PostRegisterParams{
+				HTTPRequest: httptest.NewRequest(http.MethodPost, "/register", nil),
+				Body: operations.PostRegisterBody{
+					Email:      &email,
+					Password:   swag.String("password"),
+					Role:       tt.role,
+					InviteCode: tt.inviteCode,
+				},
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			if rr.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, rr.Code)
+			}
+			if got := len(rr.Result().Cookies()) > 0; got != tt.expectCookie {
+				t.Errorf("expected cookies %v, got %v", tt.expectCookie, got)
+			}
+			if mock.SignUpResult.Called && mock.SignUpResult.Invite != tt.inviteCode {
+				t.Errorf("expected invite code %q, got %q", tt.inviteCode, mock.SignUpResult.Invite)
+			}
+		})
+	}
+}
+
+func TestAuthHandler_HandleRefresh(t *testing.T) {
+	tests := []struct {
+		name           string
+		bodyToken      string
+		cookieToken    string
+		mockTokens     *auth.Tokens
+		mockError      error
+		expectedToken  string
+		expectedStatus int
+		expectCleared  bool
+	}{
+		{
+			name:           "Refresh token from body",
+			bodyToken:      "old-refresh",
+			mockTokens:     &auth.Tokens{Access: "new-access", Refresh: "new-refresh"},
+			expectedToken:  "old-refresh",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Refresh token from cookie",
+			cookieToken:    "cookie-refresh",
+			mockTokens:     &auth.Tokens{Access: "new-access", Refresh: "new-refresh"},
+			expectedToken:  "cookie-refresh",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "Invalid refresh token",
+			bodyToken:      "bad",
+			mockError:      auth.ErrInvalidRefreshToken,
+			expectedToken:  "bad",
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "Reused refresh token clears cookies",
+			cookieToken:    "reused",
+			mockError:      auth.ErrRefreshTokenReuse,
+			expectedToken:  "reused",
+			expectedStatus: http.StatusUnauthorized,
+			expectCleared:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.RefreshResult.Tokens = tt.mockTokens
+			mock.RefreshResult.Err = tt.mockError
+
+			handler := NewAuthHandler(mock, nil)
+
+			req := httptest.NewRequest("POST", "/refresh", nil)
+			if tt.cookieToken != "" {
+				req.AddCookie(&http.Cookie{Name: refreshCookieName, Value: tt.cookieToken})
+			}
+			params := operations.PostRefreshParams{
+				HTTPRequest: req,
+				Body:        operations.PostRefreshBody{RefreshToken: tt.bodyToken},
+			}
+
+			resp := handler.HandleRefresh(params)
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			if rr.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, rr.Code)
+			}
+			if mock.RefreshResult.Token != tt.expectedToken {
+				t.Errorf("expected refresh token %q, got %q", tt.expectedToken, mock.RefreshResult.Token)
+			}
+
+			cookies := map[string]*http.Cookie{}
+			for _, c := range rr.Result().Cookies() {
+				cookies[c.Name] = c
+			}
+			if tt.mockTokens != nil {
+				if c := cookies["JWT"]; c == nil || c.Value != tt.mockTokens.Access {
+					t.Error("JWT cookie not found or value mismatch")
+				}
+				if c := cookies[refreshCookieName]; c == nil || c.Value != tt.mockTokens.Refresh {
+					t.Error("refresh cookie not found or value mismatch")
+				}
+			}
+			if tt.expectCleared {
+				if c := cookies[refreshCookieName]; c == nil || c.MaxAge >= 0 {
+					t.Error("refresh cookie was not cleared")
+				}
+			}
+		})
+	}
+}
+
+func TestAuthHandler_HandleLogout(t *testing.T) {
+	mock := &DummyAuthUsecase{}
+	handler := NewAuthHandler(mock, nil)
+
+	req := httptest.NewRequest("POST", "/logout", nil)
+	req.AddCookie(&http.Cookie{Name: refreshCookieName, Value: "session-refresh"})
+
+	resp := handler.HandleLogout(operations.PostLogoutParams{HTTPRequest: req})
+	rr := httptest.NewRecorder()
+	resp.WriteResponse(rr, runtime.JSONProducer())
+
+	if rr.Code != http.StatusNoContent {
+		t.Errorf("expected status %d, got %d", http.StatusNoContent, rr.Code)
+	}
+	if !mock.LogoutResult.Called || mock.LogoutResult.Token != "session-refresh" {
+		t.Errorf("expected logout with cookie token, got %q", mock.LogoutResult.Token)
+	}
+	for _, c := range rr.Result().Cookies() {
+		if c.MaxAge >= 0 {
+			t.Errorf("cookie %s was not cleared", c.Name)
+		}
+	}
+}
+
+func TestAuthHandler_HandleJWKS(t *testing.T) {
+	mock := &DummyAuthUsecase{JWKS: &models.JWKS{Keys: []*models.JWK{{
+		Kid: swag.String("2025-01"),
+		Kty: swag.String(models.JWKKtyOKP),
+		Alg: swag.String(models.JWKAlgEdDSA),
+		Use: swag.String("sig"),
+		Crv: "Ed25519",
+		X:   "11qYAYKxCrfVS_7TyWQHOg7hcvPapiMlrwIaaPcHURo",
+	}}}}
+	handler := NewAuthHandler(mock, nil)
+
+	req := httptest.NewRequest("GET", "/.well-known/jwks.json", nil)
+	resp := handler.HandleJWKS(operations.GetWellKnownJwksJSONParams{HTTPRequest: req})
+	rr := httptest.NewRecorder()
+	resp.WriteResponse(rr, runtime.JSONProducer())
+
+	if rr.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d", http.StatusOK, rr.Code)
+	}
+	if rr.Header().Get("Cache-Control") == "" {
+		t.Error("expected Cache-Control header")
+	}
+
+	var got models.JWKS
+	if err := json.Unmarshal(rr.Body.Bytes(), &got); err != nil {
+		t.Fatalf("failed to decode body: %v", err)
+	}
+	if len(got.Keys) != 1 || *got.Keys[0].Kid != "2025-01" || got.Keys[0].X == "" {
+		t.Errorf("unexpected keys: %+v", got.Keys)
+	}
+}
+
+func TestAuthHandler_HandleOAuthLogin(t *testing.T) {
+	tests := []struct {
+		name           string
+		login          *auth.ExternalLogin
+		err            error
+		expectedStatus int
+	}{
+		{
+			name:           "Redirects to provider",
+			login:          &auth.ExternalLogin{URL: "https://sso.example/authorize?x=1", State: "st", Nonce: "no", Verifier: "ve"},
+			expectedStatus: http.StatusFound,
+		},
+		{"SSO disabled", nil, auth.ErrSSODisabled, http.StatusNotFound},
+		{"Provider unavailable", nil, auth.ErrExternalLogin, http.StatusBadGateway},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.StartResult.Login = tt.login
+			mock.StartResult.Err = tt.err
+			handler := NewAuthHandler(mock, nil)
+
+			req := httptest.NewRequest("GET", "/oauth/login", nil)
+			resp := handler.HandleOAuthLogin(operations.GetOauthLoginParams{HTTPRequest: req})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			if rr.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d", tt.expectedStatus, rr.Code)
+			}
+			if tt.login == nil {
+				return
+			}
+			if rr.Header().Get("Location") != tt.login.URL {
+				t.Errorf("unexpected Location %q", rr.Header().Get("Location"))
+			}
+			var flow *http.Cookie
+			for _, c := range rr.Result().Cookies() {
+				if c.Name == oauthCookieName {
+					flow = c
+				}
+			}
+			if flow == nil || flow.Value != "st.no.ve" || !flow.HttpOnly {
+				t.Errorf("unexpected flow cookie: %+v", flow)
+			}
+		})
+	}
+}
+
+func TestAuthHandler_HandleOAuthCallback(t *testing.T) {
+	email := strfmt.Email("ivan@corp.example")
+	user := &models.User{ID: "11111111-1111-1111-1111-111111111111", Email: &email, Role: swag.String("employee")}
+
+	tests := []struct {
+		name           string
+		cookie         string
+		state          *string
+		code           *string
+		providerError  *string
+		completeErr    error
+		expectedStatus int
+		expectComplete bool
+	}{
+		{
+			name:           "Success",
+			cookie:         "st.no.ve",
+			state:          swag.String("st"),
+			code:           swag.String("the-code"),
+			expectedStatus: http.StatusOK,
+			expectComplete: true,
+		},
+		{
+			name:           "Flow was not started",
+			state:          swag.String("st"),
+			code:           swag.String("the-code"),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "State mismatch",
+			cookie:         "st.no.ve",
+			state:          swag.String("forged"),
+			code:           swag.String("the-code"),
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Provider returned an error",
+			cookie:         "st.no.ve",
+			state:          swag.String("st"),
+			providerError:  swag.String("access_denied"),
+			expectedStatus: http.StatusUnauthorized,
+		},
+		{
+			name:           "No mapped group",
+			cookie:         "st.no.ve",
+			state:          swag.String("st"),
+			code:           swag.String("the-code"),
+			completeErr:    auth.ErrNoRoleForGroups,
+			expectedStatus: http.StatusForbidden,
+			expectComplete: true,
+		},
+		{
+			name:           "Exchange failed",
+			cookie:         "st.no.ve",
+			state:          swag.String("st"),
+			code:           swag.String("the-code"),
+			completeErr:    auth.ErrExternalLogin,
+			expectedStatus: http.StatusUnauthorized,
+			expectComplete: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.CompleteResult.Err = tt.completeErr
+			if tt.completeErr == nil {
+				mock.CompleteResult.User = user
+				mock.CompleteResult.Tokens = tokensFor("access-token")
+			}
+			handler := NewAuthHandler(mock, nil)
+
+			req := httptest.NewRequest("GET", "/oauth/callback", nil)
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: oauthCookieName, Value: tt.cookie})
+			}
+			resp := handler.HandleOAuthCallback(operations.GetOauthCallbackParams{
+				HTTPRequest: req,
+				State:       tt.state,
+				Code:        tt.code,
+				Error:       tt.providerError,
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			if rr.Code != tt.expectedStatus {
+				t.Fatalf("expected status %d, got %d", tt.expectedStatus, rr.Code)
+			}
+			if mock.CompleteResult.Called != tt.expectComplete {
+				t.Fatalf("expected usecase call %v, got %v", tt.expectComplete, mock.CompleteResult.Called)
+			}
+			if tt.expectComplete && (mock.CompleteResult.Verifier != "ve" || mock.CompleteResult.Nonce != "no") {
+				t.Errorf("verifier and nonce must come from the flow cookie, got %q %q",
+					mock.CompleteResult.Verifier, mock.CompleteResult.Nonce)
+			}
+
+			cookies := map[string]*http.Cookie{}
+			for _, c := range rr.Result().Cookies() {
+				cookies[c.Name] = c
+			}
+			if c := cookies[oauthCookieName]; c == nil || c.MaxAge >= 0 {
+				t.Error("flow cookie was not cleared")
+			}
+			if tt.expectedStatus == http.StatusOK && cookies["JWT"] == nil {
+				t.Error("session cookies were not set")
+			}
+		})
+	}
+}
 
 func TestNewAuthHandler(t *testing.T) {
 	mock := &DummyAuthUsecase{}
-	handler := NewAuthHandler(mock)
+	handler := NewAuthHandler(mock, nil)
 	if handler == nil {
 		t.Fatal("handler is nil")
 	}
@@ -336,3 +1033,158 @@ func TestNewAuthHandler(t *testing.T) {
 		t.Fatal("handler usecase mismatch")
 	}
 }
+
+func TestAuthHandler_HandleLoginLockout(t *testing.T) {
+	tests := []struct {
+		name             string
+		mockError        error
+		expectedStatus   int
+		expectRetryAfter string
+		expectReason     string
+	}{
+		{
+			name:             "Locked",
+			mockError:        &auth.LockoutError{RetryAfter: 90*time.Second + time.Millisecond},
+			expectedStatus:   http.StatusTooManyRequests,
+			expectRetryAfter: "91",
+			expectReason:     "locked",
+		},
+		{
+			name:           "Wrong password",
+			mockError:      auth.ErrInvalidPassword,
+			expectedStatus: http.StatusUnauthorized,
+			expectReason:   "invalid_password",
+		},
+		{
+			name:           "Unknown user",
+			mockError:      auth.ErrInvalidLogin,
+			expectedStatus: http.StatusUnauthorized,
+			expectReason:   "unknown_user",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.LoginResult.Err = tt.mockError
+			mt := &metrics.AuthMetrics{FailedLogins: prometheus.NewCounterVec(
+				prometheus.CounterOpts{Name: "failed_logins_total"}, []string{"reason"},
+			)}
+			handler := NewAuthHandler(mock, mt)
+
+			req := httptest.NewRequest(http.MethodPost, "/login", nil)
+			req.RemoteAddr = "10.0.0.7:53211"
+			email := strfmt.Email("ivan@corp.example")
+			resp := handler.HandleLogin(operations.PostLoginParams{
+				HTTPRequest: req,
+				Body:        operations.PostLoginBody{Email: &email, Password: swag.String("secret")},
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			if rr.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, rr.Code)
+			}
+			if got := rr.Header().Get("Retry-After"); got != tt.expectRetryAfter {
+				t.Errorf("expected Retry-After %q, got %q", tt.expectRetryAfter, got)
+			}
+			if mock.LoginResult.IP != "10.0.0.7" {
+				t.Errorf("expected client IP 10.0.0.7, got %q", mock.LoginResult.IP)
+			}
+
+			var metric dto.Metric
+			if err := mt.FailedLogins.WithLabelValues(tt.expectReason).Write(&metric); err != nil {
+				t.Fatal(err)
+			}
+			if metric.GetCounter().GetValue() != 1 {
+				t.Errorf("expected failed_logins_total{reason=%q} = 1, got %v", tt.expectReason, metric.GetCounter().GetValue())
+			}
+		})
+	}
+}
+
+func TestAuthHandler_HandleForgotPassword(t *testing.T) {
+	email := strfmt.Email("ivan@corp.example")
+
+	tests := []struct {
+		name               string
+		email              *strfmt.Email
+		mockError          error
+		expectedStatus     int
+		expectedRetryAfter string
+	}{
+		{"Accepted", &email, nil, http.StatusAccepted, ""},
+		{"Missing email", nil, nil, http.StatusBadRequest, ""},
+		{"Disabled", &email, auth.ErrPasswordResetDisabled, http.StatusNotFound, ""},
+		{
+			"Throttled",
+			&email,
+			&auth.LockoutError{RetryAfter: 15*time.Minute - time.Millisecond, Err: auth.ErrTooManyResetRequests},
+			http.StatusTooManyRequests,
+			"900",
+		},
+		{"DB error", &email, auth.ErrDBError, http.StatusInternalServerError, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.ForgotPasswordResult.Err = tt.mockError
+			handler := NewAuthHandler(mock, nil)
+
+			resp := handler.HandleForgotPassword(operations.PostPasswordForgotParams{
+				HTTPRequest: httptest.NewRequest(http.MethodPost, "/password/forgot", nil),
+				Body:        operations.PostPasswordForgotBody{Email: tt.email},
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			if rr.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, rr.Code)
+			}
+			if got := rr.Header().Get("Retry-After"); got != tt.expectedRetryAfter {
+				t.Errorf("expected Retry-After %q, got %q", tt.expectedRetryAfter, got)
+			}
+			if tt.email != nil && mock.ForgotPasswordResult.Email != email.String() {
+				t.Errorf("expected email %s, got %s", email, mock.ForgotPasswordResult.Email)
+			}
+		})
+	}
+}
+
+func TestAuthHandler_HandleResetPassword(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           operations.PostPasswordResetBody
+		mockError      error
+		expectedStatus int
+	}{
+		{"Success", operations.PostPasswordResetBody{Token: swag.String("token"), NewPassword: swag.String("new")}, nil, http.StatusNoContent},
+		{"Missing token", operations.PostPasswordResetBody{NewPassword: swag.String("new")}, nil, http.StatusBadRequest},
+		{"Invalid token", operations.PostPasswordResetBody{Token: swag.String("token"), NewPassword: swag.String("new")}, auth.ErrInvalidResetToken, http.StatusBadRequest},
+		{"Weak password", operations.PostPasswordResetBody{Token: swag.String("token"), NewPassword: swag.String("new")}, fmt.Errorf("%w: too short", auth.ErrWeakPassword), http.StatusBadRequest},
+		{"DB error", operations.PostPasswordResetBody{Token: swag.String("token"), NewPassword: swag.String("new")}, auth.ErrDBError, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.ResetPasswordResult.Err = tt.mockError
+			handler := NewAuthHandler(mock, nil)
+
+			resp := handler.HandleResetPassword(operations.PostPasswordResetParams{
+				HTTPRequest: httptest.NewRequest(http.MethodPost, "/password/reset", nil),
+				Body:        tt.body,
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			if rr.Code != tt.expectedStatus {
+				t.Errorf("expected status %d, got %d", tt.expectedStatus, rr.Code)
+			}
+			if tt.body.Token != nil && mock.ResetPasswordResult.Token != "token" {
+				t.Errorf("expected token %q, got %q", "token", mock.ResetPasswordResult.Token)
+			}
+		})
+	}
+}
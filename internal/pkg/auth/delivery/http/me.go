@@ -0,0 +1,106 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/internal/pkg/utils/log"
+	"github.com/totorialman/go-task-avito/models"
+	"github.com/totorialman/go-task-avito/restapi/operations"
+)
+
+// errNoProfile — запрос по API-ключу или тестовому токену: профиля у него нет.
+const errNoProfile = "Профиль есть только у вошедших пользователей"
+
+func (h *AuthHandler) HandleGetMe(params operations.GetMeParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	user, err := h.authUsecase.GetMe(params.HTTPRequest.Context())
+	if errors.Is(err, auth.ErrUserNotFound) {
+		log.LogHandlerError(logger, fmt.Errorf("GetMe error: %w", err), http.StatusForbidden)
+		return operations.NewGetMeForbidden().WithPayload(&models.Error{Message: swag.String(errNoProfile)})
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("GetMe error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewGetMeOK().WithPayload(user)
+}
+
+func (h *AuthHandler) HandleUpdateMe(params operations.PatchMeParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	patch := params.Body
+	var message string
+	switch {
+	case patch == nil || (patch.Email == nil && patch.NewPassword == ""):
+		message = "email or newPassword is required"
+	case patch.OldPassword == "":
+		message = "oldPassword is required"
+	}
+	if message != "" {
+		log.LogHandlerError(logger, errors.New(message), http.StatusBadRequest)
+		return operations.NewPatchMeBadRequest().WithPayload(&models.Error{Message: swag.String(message)})
+	}
+
+	user, err := h.authUsecase.UpdateMe(params.HTTPRequest.Context(), patch)
+	var lockout *auth.LockoutError
+	switch {
+	case errors.As(err, &lockout):
+		log.LogHandlerError(logger, fmt.Errorf("UpdateMe throttled: %w", err), http.StatusTooManyRequests)
+		return operations.NewPatchMeTooManyRequests().
+			WithRetryAfter(int64(math.Ceil(lockout.RetryAfter.Seconds()))).
+			WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, auth.ErrUserNotFound):
+		log.LogHandlerError(logger, fmt.Errorf("UpdateMe error: %w", err), http.StatusForbidden)
+		return operations.NewPatchMeForbidden().WithPayload(&models.Error{Message: swag.String(errNoProfile)})
+	case errors.Is(err, auth.ErrInvalidPassword):
+		log.LogHandlerError(logger, fmt.Errorf("UpdateMe error: %w", err), http.StatusUnauthorized)
+		return operations.NewPatchMeUnauthorized().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, auth.ErrWeakPassword), errors.Is(err, auth.ErrSamePassword),
+		errors.Is(err, auth.ErrSameEmail), errors.Is(err, auth.ErrEmailChangeDisabled):
+		log.LogHandlerError(logger, fmt.Errorf("UpdateMe error: %w", err), http.StatusBadRequest)
+		return operations.NewPatchMeBadRequest().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, auth.ErrEmailTaken):
+		log.LogHandlerError(logger, fmt.Errorf("UpdateMe error: %w", err), http.StatusConflict)
+		return operations.NewPatchMeConflict().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case err != nil:
+		log.LogHandlerError(logger, fmt.Errorf("UpdateMe error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewPatchMeOK().WithPayload(user)
+}
+
+func (h *AuthHandler) HandleConfirmEmail(params operations.PostEmailConfirmParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	if params.Body.Token == nil {
+		log.LogHandlerError(logger, errors.New("token is required"), http.StatusBadRequest)
+		return operations.NewPostEmailConfirmBadRequest().WithPayload(
+			&models.Error{Message: swag.String("token is required")},
+		)
+	}
+
+	err := h.authUsecase.ConfirmEmail(params.HTTPRequest.Context(), *params.Body.Token)
+	switch {
+	case errors.Is(err, auth.ErrInvalidEmailToken):
+		log.LogHandlerError(logger, fmt.Errorf("confirm email failed: %w", err), http.StatusBadRequest)
+		return operations.NewPostEmailConfirmBadRequest().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, auth.ErrEmailTaken):
+		log.LogHandlerError(logger, fmt.Errorf("confirm email failed: %w", err), http.StatusConflict)
+		return operations.NewPostEmailConfirmConflict().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case err != nil:
+		log.LogHandlerError(logger, fmt.Errorf("confirm email failed: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewPostEmailConfirmNoContent()
+}
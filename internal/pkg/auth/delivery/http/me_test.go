@@ -0,0 +1,160 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/models"
+	"github.com/totorialman/go-task-avito/restapi/operations"
+)
+
+func TestAuthHandler_HandleGetMe(t *testing.T) {
+	email := strfmt.Email("employee@example.com")
+	pvzID := strfmt.UUID("55555555-5555-5555-5555-555555555555")
+	user := &models.User{
+		ID:             moderatorID,
+		Email:          &email,
+		Role:           swag.String(models.UserRoleEmployee),
+		PendingEmail:   "new@example.com",
+		PvzAssignments: []*models.PVZAssignment{{PvzID: &pvzID, AssignedAt: strfmt.DateTime(time.Now())}},
+	}
+
+	tests := []struct {
+		name           string
+		mockUser       *models.User
+		mockError      error
+		expectedStatus int
+	}{
+		{"Success", user, nil, http.StatusOK},
+		{"No user", nil, auth.ErrUserNotFound, http.StatusForbidden},
+		{"DB error", nil, auth.ErrDBError, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.GetMeResult.User = tt.mockUser
+			mock.GetMeResult.Err = tt.mockError
+			handler := NewAuthHandler(mock, nil)
+
+			resp := handler.HandleGetMe(operations.GetMeParams{
+				HTTPRequest: moderatorRequest(http.MethodGet, "/me"),
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var got models.User
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				assert.Equal(t, strfmt.Email("new@example.com"), got.PendingEmail)
+				require.Len(t, got.PvzAssignments, 1)
+				assert.Equal(t, pvzID, *got.PvzAssignments[0].PvzID)
+			}
+		})
+	}
+}
+
+func TestAuthHandler_HandleUpdateMe(t *testing.T) {
+	newEmail := strfmt.Email("new@example.com")
+	email := strfmt.Email("employee@example.com")
+	user := &models.User{ID: moderatorID, Email: &email, Role: swag.String(models.UserRoleEmployee), PendingEmail: newEmail}
+
+	tests := []struct {
+		name             string
+		body             *models.MePatch
+		mockError        error
+		expectedStatus   int
+		expectCall       bool
+		expectRetryAfter string
+	}{
+		{name: "Email change", body: &models.MePatch{Email: &newEmail, OldPassword: "old"}, expectedStatus: http.StatusOK, expectCall: true},
+		{name: "Email change without password", body: &models.MePatch{Email: &newEmail}, expectedStatus: http.StatusBadRequest},
+		{name: "Password change", body: &models.MePatch{OldPassword: "old", NewPassword: "new"}, expectedStatus: http.StatusOK, expectCall: true},
+		{name: "Empty patch", body: &models.MePatch{}, expectedStatus: http.StatusBadRequest},
+		{name: "Only old password", body: &models.MePatch{OldPassword: "old"}, expectedStatus: http.StatusBadRequest},
+		{name: "New password without old", body: &models.MePatch{NewPassword: "new"}, expectedStatus: http.StatusBadRequest},
+		{name: "Wrong password", body: &models.MePatch{OldPassword: "bad", NewPassword: "new"}, mockError: auth.ErrInvalidPassword, expectedStatus: http.StatusUnauthorized, expectCall: true},
+		{name: "Weak password", body: &models.MePatch{OldPassword: "old", NewPassword: "1"}, mockError: auth.ErrWeakPassword, expectedStatus: http.StatusBadRequest, expectCall: true},
+		{name: "Same email", body: &models.MePatch{Email: &email, OldPassword: "old"}, mockError: auth.ErrSameEmail, expectedStatus: http.StatusBadRequest, expectCall: true},
+		{name: "Email change disabled", body: &models.MePatch{Email: &newEmail, OldPassword: "old"}, mockError: auth.ErrEmailChangeDisabled, expectedStatus: http.StatusBadRequest, expectCall: true},
+		{name: "Email taken", body: &models.MePatch{Email: &newEmail, OldPassword: "old"}, mockError: auth.ErrEmailTaken, expectedStatus: http.StatusConflict, expectCall: true},
+		{name: "No user", body: &models.MePatch{Email: &newEmail, OldPassword: "old"}, mockError: auth.ErrUserNotFound, expectedStatus: http.StatusForbidden, expectCall: true},
+		{
+			name:             "Throttled",
+			body:             &models.MePatch{Email: &newEmail, OldPassword: "old"},
+			mockError:        &auth.LockoutError{RetryAfter: 10 * time.Minute, Err: auth.ErrTooManyEmailChanges},
+			expectedStatus:   http.StatusTooManyRequests,
+			expectCall:       true,
+			expectRetryAfter: "600",
+		},
+		{name: "Mail error", body: &models.MePatch{Email: &newEmail, OldPassword: "old"}, mockError: auth.ErrSendingMail, expectedStatus: http.StatusInternalServerError, expectCall: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.UpdateMeResult.User = user
+			mock.UpdateMeResult.Err = tt.mockError
+			handler := NewAuthHandler(mock, nil)
+
+			resp := handler.HandleUpdateMe(operations.PatchMeParams{
+				HTTPRequest: moderatorRequest(http.MethodPatch, "/me"),
+				Body:        tt.body,
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Equal(t, tt.expectCall, mock.UpdateMeResult.Called)
+			assert.Equal(t, tt.expectRetryAfter, rr.Header().Get("Retry-After"))
+			if tt.expectCall {
+				assert.Same(t, tt.body, mock.UpdateMeResult.Patch)
+			}
+		})
+	}
+}
+
+func TestAuthHandler_HandleConfirmEmail(t *testing.T) {
+	tests := []struct {
+		name           string
+		token          *string
+		mockError      error
+		expectedStatus int
+	}{
+		{"Success", swag.String("token"), nil, http.StatusNoContent},
+		{"No token", nil, nil, http.StatusBadRequest},
+		{"Invalid token", swag.String("token"), auth.ErrInvalidEmailToken, http.StatusBadRequest},
+		{"Email taken", swag.String("token"), auth.ErrEmailTaken, http.StatusConflict},
+		{"DB error", swag.String("token"), auth.ErrDBError, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.ConfirmEmailResult.Err = tt.mockError
+			handler := NewAuthHandler(mock, nil)
+
+			resp := handler.HandleConfirmEmail(operations.PostEmailConfirmParams{
+				HTTPRequest: httptest.NewRequest(http.MethodPost, "/email/confirm", nil),
+				Body:        operations.PostEmailConfirmBody{Token: tt.token},
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.token != nil {
+				assert.Equal(t, *tt.token, mock.ConfirmEmailResult.Token)
+			}
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/internal/pkg/utils/log"
+	"github.com/totorialman/go-task-avito/models"
+	"github.com/totorialman/go-task-avito/restapi/operations"
+)
+
+// errNoUserSessions — запрос по API-ключу или тестовому токену: сессий у него нет.
+const errNoUserSessions = "Сессии есть только у вошедших пользователей"
+
+func (h *AuthHandler) HandleListSessions(params operations.GetMeSessionsParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	sessions, err := h.authUsecase.ListSessions(params.HTTPRequest.Context())
+	if errors.Is(err, auth.ErrUserNotFound) {
+		log.LogHandlerError(logger, fmt.Errorf("ListSessions error: %w", err), http.StatusForbidden)
+		return operations.NewGetMeSessionsForbidden().WithPayload(&models.Error{Message: swag.String(errNoUserSessions)})
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("ListSessions error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewGetMeSessionsOK().WithPayload(sessions)
+}
+
+func (h *AuthHandler) HandleRevokeSession(params operations.DeleteMeSessionsSessionIDParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	err := h.authUsecase.RevokeSession(params.HTTPRequest.Context(), params.SessionID)
+	switch {
+	case errors.Is(err, auth.ErrUserNotFound):
+		log.LogHandlerError(logger, fmt.Errorf("RevokeSession error: %w", err), http.StatusForbidden)
+		return operations.NewDeleteMeSessionsSessionIDForbidden().WithPayload(&models.Error{Message: swag.String(errNoUserSessions)})
+	case errors.Is(err, auth.ErrSessionNotFound):
+		log.LogHandlerError(logger, fmt.Errorf("RevokeSession error: %w", err), http.StatusNotFound)
+		return operations.NewDeleteMeSessionsSessionIDNotFound().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case err != nil:
+		log.LogHandlerError(logger, fmt.Errorf("RevokeSession error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewDeleteMeSessionsSessionIDNoContent()
+}
+
+func (h *AuthHandler) HandleRevokeUserSessions(params operations.DeleteUsersUserIDSessionsParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+	ctx := params.HTTPRequest.Context()
+
+	err := h.authUsecase.RevokeUserSessions(ctx, auth.UserIDFromContext(ctx), params.UserID)
+	if errors.Is(err, auth.ErrUserNotFound) {
+		log.LogHandlerError(logger, fmt.Errorf("RevokeUserSessions error: %w", err), http.StatusNotFound)
+		return operations.NewDeleteUsersUserIDSessionsNotFound().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("RevokeUserSessions error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewDeleteUsersUserIDSessionsNoContent()
+}
@@ -0,0 +1,150 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/models"
+	"github.com/totorialman/go-task-avito/restapi/operations"
+)
+
+const sessionID = strfmt.UUID("44444444-4444-4444-4444-444444444444")
+
+func TestAuthHandler_HandleListSessions(t *testing.T) {
+	now := strfmt.DateTime(time.Now())
+	id := sessionID
+	sessions := []*models.Session{{
+		ID:          &id,
+		DeviceLabel: "Терминал 3",
+		CreatedAt:   &now,
+		LastSeenAt:  &now,
+		Current:     swag.Bool(true),
+	}}
+
+	tests := []struct {
+		name           string
+		mockSessions   []*models.Session
+		mockError      error
+		expectedStatus int
+	}{
+		{"Success", sessions, nil, http.StatusOK},
+		{"No user", nil, auth.ErrUserNotFound, http.StatusForbidden},
+		{"DB error", nil, auth.ErrDBError, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.ListSessionsResult.Sessions = tt.mockSessions
+			mock.ListSessionsResult.Err = tt.mockError
+			handler := NewAuthHandler(mock, nil)
+
+			resp := handler.HandleListSessions(operations.GetMeSessionsParams{
+				HTTPRequest: moderatorRequest(http.MethodGet, "/me/sessions"),
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.expectedStatus == http.StatusOK {
+				var got []*models.Session
+				require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &got))
+				require.Len(t, got, 1)
+				assert.Equal(t, "Терминал 3", got[0].DeviceLabel)
+				assert.True(t, *got[0].Current)
+			}
+		})
+	}
+}
+
+func TestAuthHandler_HandleRevokeSession(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockError      error
+		expectedStatus int
+	}{
+		{"Success", nil, http.StatusNoContent},
+		{"No user", auth.ErrUserNotFound, http.StatusForbidden},
+		{"Not found", auth.ErrSessionNotFound, http.StatusNotFound},
+		{"DB error", auth.ErrDBError, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.RevokeSessionResult.Err = tt.mockError
+			handler := NewAuthHandler(mock, nil)
+
+			resp := handler.HandleRevokeSession(operations.DeleteMeSessionsSessionIDParams{
+				HTTPRequest: moderatorRequest(http.MethodDelete, "/me/sessions/"+sessionID.String()),
+				SessionID:   sessionID,
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Equal(t, sessionID, mock.RevokeSessionResult.SessionID)
+		})
+	}
+}
+
+func TestAuthHandler_HandleRevokeUserSessions(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockError      error
+		expectedStatus int
+	}{
+		{"Success", nil, http.StatusNoContent},
+		{"User not found", auth.ErrUserNotFound, http.StatusNotFound},
+		{"DB error", auth.ErrDBError, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.RevokeUserSessionsResult.Err = tt.mockError
+			handler := NewAuthHandler(mock, nil)
+
+			resp := handler.HandleRevokeUserSessions(operations.DeleteUsersUserIDSessionsParams{
+				HTTPRequest: moderatorRequest(http.MethodDelete, "/users/"+employeeID.String()+"/sessions"),
+				UserID:      employeeID,
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Equal(t, moderatorID, mock.RevokeUserSessionsResult.ActorID)
+			assert.Equal(t, employeeID, mock.RevokeUserSessionsResult.UserID)
+		})
+	}
+}
+
+func TestAuthHandler_HandleLoginDevice(t *testing.T) {
+	mock := &DummyAuthUsecase{}
+	mock.LoginResult.Token = "test-token"
+	handler := NewAuthHandler(mock, nil)
+
+	email := strfmt.Email("test@example.com")
+	req := httptest.NewRequest(http.MethodPost, "/login", nil)
+	req.RemoteAddr = "10.0.0.7:51234"
+	req.Header.Set("User-Agent", "TSD-Scanner/2.1")
+
+	resp := handler.HandleLogin(operations.PostLoginParams{
+		HTTPRequest: req,
+		Body:        operations.PostLoginBody{Email: &email, Password: swag.String("password"), DeviceLabel: " Терминал 3 "},
+	})
+	rr := httptest.NewRecorder()
+	resp.WriteResponse(rr, runtime.JSONProducer())
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, auth.Device{Label: "Терминал 3", UserAgent: "TSD-Scanner/2.1", IP: "10.0.0.7"}, mock.LoginResult.Device)
+}
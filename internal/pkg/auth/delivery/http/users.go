@@ -0,0 +1,212 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/internal/pkg/utils/log"
+	"github.com/totorialman/go-task-avito/models"
+	"github.com/totorialman/go-task-avito/restapi/operations"
+)
+
+func (h *AuthHandler) HandleListUsers(params operations.GetUsersParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	page := int(*params.Page)
+	limit := int(*params.Limit)
+	filter := auth.UserFilter{Role: params.Role, Disabled: params.Disabled}
+
+	users, err := h.authUsecase.ListUsers(params.HTTPRequest.Context(), filter, page, limit)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("ListUsers error: %w", err), http.StatusBadRequest)
+		return operations.NewGetUsersBadRequest().WithPayload(&models.Error{
+			Message: swag.String("Ошибка при получении пользователей"),
+		})
+	}
+
+	resp := operations.NewGetUsersOK().WithPayload(users.Items).WithXTotalCount(users.Total)
+	if int64(page)*int64(limit) < users.Total {
+		resp.WithXNextPage(strconv.Itoa(page + 1))
+	}
+	return resp
+}
+
+func (h *AuthHandler) HandleGetUser(params operations.GetUsersUserIDParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	user, err := h.authUsecase.GetUser(params.HTTPRequest.Context(), params.UserID)
+	if errors.Is(err, auth.ErrUserNotFound) {
+		log.LogHandlerError(logger, fmt.Errorf("GetUser error: %w", err), http.StatusNotFound)
+		return operations.NewGetUsersUserIDNotFound().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("GetUser error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewGetUsersUserIDOK().WithPayload(user)
+}
+
+func (h *AuthHandler) HandleUpdateUser(params operations.PatchUsersUserIDParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+	ctx := params.HTTPRequest.Context()
+
+	if params.Body == nil || (params.Body.Role == nil && params.Body.Disabled == nil) {
+		log.LogHandlerError(logger, errors.New("role or disabled is required"), http.StatusBadRequest)
+		return operations.NewPatchUsersUserIDBadRequest().WithPayload(&models.Error{
+			Message: swag.String("role or disabled is required"),
+		})
+	}
+
+	user, err := h.authUsecase.UpdateUser(ctx, auth.UserIDFromContext(ctx), params.UserID, params.Body)
+	switch {
+	case errors.Is(err, auth.ErrForbidden):
+		log.LogHandlerError(logger, fmt.Errorf("UpdateUser error: %w", err), http.StatusForbidden)
+		return operations.NewPatchUsersUserIDForbidden().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, auth.ErrUserNotFound):
+		log.LogHandlerError(logger, fmt.Errorf("UpdateUser error: %w", err), http.StatusNotFound)
+		return operations.NewPatchUsersUserIDNotFound().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, auth.ErrSelfModification):
+		log.LogHandlerError(logger, fmt.Errorf("UpdateUser error: %w", err), http.StatusConflict)
+		return operations.NewPatchUsersUserIDConflict().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case err != nil:
+		log.LogHandlerError(logger, fmt.Errorf("UpdateUser error: %w", err), http.StatusBadRequest)
+		return operations.NewPatchUsersUserIDBadRequest().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewPatchUsersUserIDOK().WithPayload(user)
+}
+
+func (h *AuthHandler) HandleDeleteUser(params operations.DeleteUsersUserIDParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+	ctx := params.HTTPRequest.Context()
+
+	err := h.authUsecase.DeleteUser(ctx, auth.UserIDFromContext(ctx), params.UserID)
+	switch {
+	case errors.Is(err, auth.ErrForbidden):
+		log.LogHandlerError(logger, fmt.Errorf("DeleteUser error: %w", err), http.StatusForbidden)
+		return operations.NewDeleteUsersUserIDForbidden().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, auth.ErrUserNotFound):
+		log.LogHandlerError(logger, fmt.Errorf("DeleteUser error: %w", err), http.StatusNotFound)
+		return operations.NewDeleteUsersUserIDNotFound().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, auth.ErrSelfModification):
+		log.LogHandlerError(logger, fmt.Errorf("DeleteUser error: %w", err), http.StatusConflict)
+		return operations.NewDeleteUsersUserIDConflict().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case err != nil:
+		log.LogHandlerError(logger, fmt.Errorf("DeleteUser error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewDeleteUsersUserIDNoContent()
+}
+
+func (h *AuthHandler) HandleCreateInvite(params operations.PostInvitesParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+	ctx := params.HTTPRequest.Context()
+
+	if params.Body.Role == nil {
+		log.LogHandlerError(logger, errors.New("role is required"), http.StatusBadRequest)
+		return operations.NewPostInvitesBadRequest().WithPayload(&models.Error{
+			Message: swag.String("role is required"),
+		})
+	}
+
+	ttl := time.Duration(params.Body.TTLHours) * time.Hour
+	invite, err := h.authUsecase.CreateInvite(ctx, auth.UserIDFromContext(ctx), *params.Body.Role, string(params.Body.Email), ttl)
+	if errors.Is(err, auth.ErrForbidden) {
+		log.LogHandlerError(logger, fmt.Errorf("CreateInvite error: %w", err), http.StatusForbidden)
+		return operations.NewPostInvitesForbidden().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("CreateInvite error: %w", err), http.StatusBadRequest)
+		return operations.NewPostInvitesBadRequest().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewPostInvitesCreated().WithPayload(invite)
+}
+
+func (h *AuthHandler) HandleUnlockUser(params operations.PostUsersUserIDUnlockParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+	ctx := params.HTTPRequest.Context()
+
+	err := h.authUsecase.UnlockUser(ctx, auth.UserIDFromContext(ctx), params.UserID)
+	switch {
+	case errors.Is(err, auth.ErrForbidden):
+		log.LogHandlerError(logger, fmt.Errorf("UnlockUser error: %w", err), http.StatusForbidden)
+		return operations.NewPostUsersUserIDUnlockForbidden().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, auth.ErrUserNotFound):
+		log.LogHandlerError(logger, fmt.Errorf("UnlockUser error: %w", err), http.StatusNotFound)
+		return operations.NewPostUsersUserIDUnlockNotFound().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case err != nil:
+		log.LogHandlerError(logger, fmt.Errorf("UnlockUser error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewPostUsersUserIDUnlockNoContent()
+}
+
+func (h *AuthHandler) HandleListPVZAssignments(params operations.GetUsersUserIDPvzParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	assignments, err := h.authUsecase.ListPVZAssignments(params.HTTPRequest.Context(), params.UserID)
+	if errors.Is(err, auth.ErrUserNotFound) {
+		log.LogHandlerError(logger, fmt.Errorf("ListPVZAssignments error: %w", err), http.StatusNotFound)
+		return operations.NewGetUsersUserIDPvzNotFound().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("ListPVZAssignments error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewGetUsersUserIDPvzOK().WithPayload(assignments)
+}
+
+func (h *AuthHandler) HandleAssignPVZ(params operations.PutUsersUserIDPvzPvzIDParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+	ctx := params.HTTPRequest.Context()
+
+	err := h.authUsecase.AssignPVZ(ctx, auth.UserIDFromContext(ctx), params.UserID, params.PvzID)
+	switch {
+	case errors.Is(err, auth.ErrForbidden):
+		log.LogHandlerError(logger, fmt.Errorf("AssignPVZ error: %w", err), http.StatusForbidden)
+		return operations.NewPutUsersUserIDPvzPvzIDForbidden().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, auth.ErrUserNotFound), errors.Is(err, auth.ErrPVZNotFound):
+		log.LogHandlerError(logger, fmt.Errorf("AssignPVZ error: %w", err), http.StatusNotFound)
+		return operations.NewPutUsersUserIDPvzPvzIDNotFound().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, auth.ErrNotEmployee):
+		log.LogHandlerError(logger, fmt.Errorf("AssignPVZ error: %w", err), http.StatusBadRequest)
+		return operations.NewPutUsersUserIDPvzPvzIDBadRequest().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case err != nil:
+		log.LogHandlerError(logger, fmt.Errorf("AssignPVZ error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewPutUsersUserIDPvzPvzIDNoContent()
+}
+
+func (h *AuthHandler) HandleUnassignPVZ(params operations.DeleteUsersUserIDPvzPvzIDParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+	ctx := params.HTTPRequest.Context()
+
+	err := h.authUsecase.UnassignPVZ(ctx, auth.UserIDFromContext(ctx), params.UserID, params.PvzID)
+	switch {
+	case errors.Is(err, auth.ErrForbidden):
+		log.LogHandlerError(logger, fmt.Errorf("UnassignPVZ error: %w", err), http.StatusForbidden)
+		return operations.NewDeleteUsersUserIDPvzPvzIDForbidden().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, auth.ErrAssignmentNotFound):
+		log.LogHandlerError(logger, fmt.Errorf("UnassignPVZ error: %w", err), http.StatusNotFound)
+		return operations.NewDeleteUsersUserIDPvzPvzIDNotFound().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case err != nil:
+		log.LogHandlerError(logger, fmt.Errorf("UnassignPVZ error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewDeleteUsersUserIDPvzPvzIDNoContent()
+}
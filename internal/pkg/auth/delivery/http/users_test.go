@@ -0,0 +1,382 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/models"
+	"github.com/totorialman/go-task-avito/restapi/operations"
+)
+
+const (
+	moderatorID = strfmt.UUID("11111111-1111-1111-1111-111111111111")
+	employeeID  = strfmt.UUID("22222222-2222-2222-2222-222222222222")
+)
+
+func moderatorRequest(method, target string) *http.Request {
+	req := httptest.NewRequest(method, target, nil)
+	ctx := auth.WithPrincipal(req.Context(), &auth.Principal{UserID: moderatorID, Role: models.UserRoleModerator})
+	return req.WithContext(ctx)
+}
+
+func TestAuthHandler_HandleListUsers(t *testing.T) {
+	tests := []struct {
+		name           string
+		page           int64
+		total          int64
+		mockError      error
+		expectedStatus int
+		expectedNext   string
+	}{
+		{"First of two pages", 1, 3, nil, http.StatusOK, "2"},
+		{"Last page", 2, 3, nil, http.StatusOK, ""},
+		{"Usecase error", 1, 0, auth.ErrDBError, http.StatusBadRequest, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.ListUsersResult.Page = &auth.UserPage{Items: []*models.User{{ID: employeeID}}, Total: tt.total}
+			mock.ListUsersResult.Err = tt.mockError
+			handler := NewAuthHandler(mock, nil)
+
+			resp := handler.HandleListUsers(operations.GetUsersParams{
+				HTTPRequest: moderatorRequest(http.MethodGet, "/users"),
+				Role:        swag.String(models.UserRoleEmployee),
+				Page:        swag.Int64(tt.page),
+				Limit:       swag.Int64(2),
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Equal(t, models.UserRoleEmployee, swag.StringValue(mock.ListUsersResult.Filter.Role))
+			if tt.mockError == nil {
+				assert.Equal(t, "3", rr.Header().Get("X-Total-Count"))
+				assert.Equal(t, tt.expectedNext, rr.Header().Get("X-Next-Page"))
+			}
+		})
+	}
+}
+
+func TestAuthHandler_HandleGetUser(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockError      error
+		expectedStatus int
+	}{
+		{"Success", nil, http.StatusOK},
+		{"Not found", auth.ErrUserNotFound, http.StatusNotFound},
+		{"DB error", auth.ErrDBError, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.GetUserResult.User = &models.User{ID: employeeID}
+			mock.GetUserResult.Err = tt.mockError
+			handler := NewAuthHandler(mock, nil)
+
+			resp := handler.HandleGetUser(operations.GetUsersUserIDParams{
+				HTTPRequest: moderatorRequest(http.MethodGet, "/users/"+employeeID.String()),
+				UserID:      employeeID,
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+		})
+	}
+}
+
+func TestAuthHandler_HandleUpdateUser(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           *models.UserPatch
+		mockError      error
+		expectedStatus int
+		expectCalled   bool
+	}{
+		{
+			name:           "Success",
+			body:           &models.UserPatch{Disabled: swag.Bool(true)},
+			expectedStatus: http.StatusOK,
+			expectCalled:   true,
+		},
+		{
+			name:           "Empty patch",
+			body:           &models.UserPatch{},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "Self modification",
+			body:           &models.UserPatch{Role: swag.String(models.UserRoleEmployee)},
+			mockError:      auth.ErrSelfModification,
+			expectedStatus: http.StatusConflict,
+			expectCalled:   true,
+		},
+		{
+			name:           "No actor",
+			body:           &models.UserPatch{Disabled: swag.Bool(true)},
+			mockError:      auth.ErrForbidden,
+			expectedStatus: http.StatusForbidden,
+			expectCalled:   true,
+		},
+		{
+			name:           "Not found",
+			body:           &models.UserPatch{Disabled: swag.Bool(false)},
+			mockError:      auth.ErrUserNotFound,
+			expectedStatus: http.StatusNotFound,
+			expectCalled:   true,
+		},
+		{
+			name:           "Other error",
+			body:           &models.UserPatch{Disabled: swag.Bool(false)},
+			mockError:      errors.New("boom"),
+			expectedStatus: http.StatusBadRequest,
+			expectCalled:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.UpdateUserResult.User = &models.User{ID: employeeID}
+			mock.UpdateUserResult.Err = tt.mockError
+			handler := NewAuthHandler(mock, nil)
+
+			resp := handler.HandleUpdateUser(operations.PatchUsersUserIDParams{
+				HTTPRequest: moderatorRequest(http.MethodPatch, "/users/"+employeeID.String()),
+				UserID:      employeeID,
+				Body:        tt.body,
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Equal(t, tt.expectCalled, mock.UpdateUserResult.Called)
+			if tt.expectCalled {
+				assert.Equal(t, moderatorID, mock.UpdateUserResult.ActorID)
+			}
+		})
+	}
+}
+
+func TestAuthHandler_HandleDeleteUser(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockError      error
+		expectedStatus int
+	}{
+		{"Success", nil, http.StatusNoContent},
+		{"Self deletion", auth.ErrSelfModification, http.StatusConflict},
+		{"No actor", auth.ErrForbidden, http.StatusForbidden},
+		{"Not found", auth.ErrUserNotFound, http.StatusNotFound},
+		{"DB error", auth.ErrDBError, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.DeleteUserResult.Err = tt.mockError
+			handler := NewAuthHandler(mock, nil)
+
+			resp := handler.HandleDeleteUser(operations.DeleteUsersUserIDParams{
+				HTTPRequest: moderatorRequest(http.MethodDelete, "/users/"+employeeID.String()),
+				UserID:      employeeID,
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Equal(t, moderatorID, mock.DeleteUserResult.ActorID)
+		})
+	}
+}
+
+func TestAuthHandler_HandleCreateInvite(t *testing.T) {
+	tests := []struct {
+		name           string
+		role           *string
+		ttlHours       int64
+		mockError      error
+		expectedStatus int
+		expectedTTL    time.Duration
+	}{
+		{"Default TTL", swag.String(models.UserRoleModerator), 0, nil, http.StatusCreated, 0},
+		{"Custom TTL", swag.String(models.UserRoleEmployee), 24, nil, http.StatusCreated, 24 * time.Hour},
+		{"Missing role", nil, 0, nil, http.StatusBadRequest, 0},
+		{"No actor", swag.String(models.UserRoleModerator), 0, auth.ErrForbidden, http.StatusForbidden, 0},
+		{"Usecase error", swag.String(models.UserRoleEmployee), 0, auth.ErrDBError, http.StatusBadRequest, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.InviteResult.Invite = &models.Invite{Code: "code", Role: tt.role}
+			mock.InviteResult.Err = tt.mockError
+			handler := NewAuthHandler(mock, nil)
+
+			resp := handler.HandleCreateInvite(operations.PostInvitesParams{
+				HTTPRequest: moderatorRequest(http.MethodPost, "/invites"),
+				Body:        operations.PostInvitesBody{Role: tt.role, TTLHours: tt.ttlHours},
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.role != nil {
+				assert.Equal(t, moderatorID, mock.InviteResult.ActorID)
+				assert.Equal(t, tt.expectedTTL, mock.InviteResult.TTL)
+			}
+		})
+	}
+}
+
+func TestAuthHandler_HandleUnlockUser(t *testing.T) {
+	tests := []struct {
+		name           string
+		mockError      error
+		expectedStatus int
+	}{
+		{"Success", nil, http.StatusNoContent},
+		{"No actor", auth.ErrForbidden, http.StatusForbidden},
+		{"Not found", auth.ErrUserNotFound, http.StatusNotFound},
+		{"DB error", auth.ErrDBError, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.UnlockResult.Err = tt.mockError
+			handler := NewAuthHandler(mock, nil)
+
+			resp := handler.HandleUnlockUser(operations.PostUsersUserIDUnlockParams{
+				HTTPRequest: moderatorRequest(http.MethodPost, "/users/"+employeeID.String()+"/unlock"),
+				UserID:      employeeID,
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Equal(t, employeeID, mock.UnlockResult.UserID)
+		})
+	}
+}
+
+func TestAuthHandler_HandleChangePassword(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           operations.PostMePasswordBody
+		mockError      error
+		expectedStatus int
+	}{
+		{"Success", operations.PostMePasswordBody{OldPassword: swag.String("old"), NewPassword: swag.String("new")}, nil, http.StatusNoContent},
+		{"Missing field", operations.PostMePasswordBody{OldPassword: swag.String("old")}, nil, http.StatusBadRequest},
+		{"Wrong old password", operations.PostMePasswordBody{OldPassword: swag.String("old"), NewPassword: swag.String("new")}, auth.ErrInvalidPassword, http.StatusUnauthorized},
+		{"Weak password", operations.PostMePasswordBody{OldPassword: swag.String("old"), NewPassword: swag.String("new")}, fmt.Errorf("%w: too short", auth.ErrWeakPassword), http.StatusBadRequest},
+		{"Same password", operations.PostMePasswordBody{OldPassword: swag.String("old"), NewPassword: swag.String("new")}, auth.ErrSamePassword, http.StatusBadRequest},
+		{"DB error", operations.PostMePasswordBody{OldPassword: swag.String("old"), NewPassword: swag.String("new")}, auth.ErrDBError, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.ChangePasswordResult.Err = tt.mockError
+			handler := NewAuthHandler(mock, nil)
+
+			resp := handler.HandleChangePassword(operations.PostMePasswordParams{
+				HTTPRequest: moderatorRequest(http.MethodPost, "/me/password"),
+				Body:        tt.body,
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			if tt.body.NewPassword != nil {
+				assert.Equal(t, "new", mock.ChangePasswordResult.NewPassword)
+			}
+		})
+	}
+}
+
+func TestAuthHandler_HandleAssignPVZ(t *testing.T) {
+	pvzID := strfmt.UUID("33333333-3333-3333-3333-333333333333")
+
+	tests := []struct {
+		name           string
+		mockError      error
+		expectedStatus int
+	}{
+		{"Success", nil, http.StatusNoContent},
+		{"No actor", auth.ErrForbidden, http.StatusForbidden},
+		{"User not found", auth.ErrUserNotFound, http.StatusNotFound},
+		{"PVZ not found", auth.ErrPVZNotFound, http.StatusNotFound},
+		{"Not an employee", auth.ErrNotEmployee, http.StatusBadRequest},
+		{"DB error", auth.ErrDBError, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.AssignPVZResult.Err = tt.mockError
+			handler := NewAuthHandler(mock, nil)
+
+			resp := handler.HandleAssignPVZ(operations.PutUsersUserIDPvzPvzIDParams{
+				HTTPRequest: moderatorRequest(http.MethodPut, "/users/"+employeeID.String()+"/pvz/"+pvzID.String()),
+				UserID:      employeeID,
+				PvzID:       pvzID,
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Equal(t, moderatorID, mock.AssignPVZResult.ActorID)
+			assert.Equal(t, employeeID, mock.AssignPVZResult.UserID)
+			assert.Equal(t, pvzID, mock.AssignPVZResult.PVZID)
+		})
+	}
+}
+
+func TestAuthHandler_HandleUnassignPVZ(t *testing.T) {
+	pvzID := strfmt.UUID("33333333-3333-3333-3333-333333333333")
+
+	tests := []struct {
+		name           string
+		mockError      error
+		expectedStatus int
+	}{
+		{"Success", nil, http.StatusNoContent},
+		{"No actor", auth.ErrForbidden, http.StatusForbidden},
+		{"Not assigned", auth.ErrAssignmentNotFound, http.StatusNotFound},
+		{"DB error", auth.ErrDBError, http.StatusInternalServerError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &DummyAuthUsecase{}
+			mock.UnassignPVZResult.Err = tt.mockError
+			handler := NewAuthHandler(mock, nil)
+
+			resp := handler.HandleUnassignPVZ(operations.DeleteUsersUserIDPvzPvzIDParams{
+				HTTPRequest: moderatorRequest(http.MethodDelete, "/users/"+employeeID.String()+"/pvz/"+pvzID.String()),
+				UserID:      employeeID,
+				PvzID:       pvzID,
+			})
+			rr := httptest.NewRecorder()
+			resp.WriteResponse(rr, runtime.JSONProducer())
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Equal(t, pvzID, mock.UnassignPVZResult.PVZID)
+		})
+	}
+}
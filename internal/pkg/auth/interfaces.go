@@ -3,9 +3,11 @@ package auth
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/go-openapi/strfmt"
-	"github.com/gofrs/uuid"
+	"github.com/golang-jwt/jwt"
 	"github.com/totorialman/go-task-avito/models"
 )
 
@@ -26,15 +28,181 @@ var (
 	ErrFileDeletion       = errors.New("Ошибка при удалении файла")
 	ErrDBError            = errors.New("Ошибка БД")
 	ErrAddressNotFound    = errors.New("Ошибка поиска адреса")
+
+	ErrInvalidRefreshToken = errors.New("Недействительный refresh-токен")
+	ErrRefreshTokenReuse   = errors.New("Повторное использование refresh-токена, сессия отозвана")
+
+	ErrSSODisabled      = errors.New("Вход через SSO не настроен")
+	ErrExternalLogin    = errors.New("Ошибка входа через SSO")
+	ErrNoRoleForGroups  = errors.New("Группы пользователя не дают доступа к сервису")
+	ErrIdentityConflict = errors.New("Пользователь с таким email уже существует")
+	ErrIdentityNotFound = errors.New("Внешняя учетная запись не привязана")
+
+	ErrUserDisabled     = errors.New("Пользователь заблокирован")
+	ErrSelfModification = errors.New("Нельзя заблокировать, понизить или удалить себя")
+	ErrForbidden        = errors.New("Действие доступно только пользователю с учетной записью")
+
+	ErrModeratorSignUp       = errors.New("Модератора может зарегистрировать только модератор или приглашение")
+	ErrEmailDomainNotAllowed = errors.New("Регистрация с этим email не разрешена")
+	ErrInvalidInvite         = errors.New("Приглашение недействительно или уже использовано")
+	ErrAlreadyBootstrapped   = errors.New("Модератор уже существует")
+
+	ErrTooManyAttempts = errors.New("Слишком много неудачных попыток входа")
+
+	ErrWeakPassword = errors.New("Пароль не соответствует требованиям")
+
+	ErrPasswordResetDisabled = errors.New("Восстановление пароля не настроено")
+	ErrInvalidResetToken     = errors.New("Ссылка для сброса пароля недействительна или уже использована")
+	ErrTooManyResetRequests  = errors.New("Слишком много запросов на сброс пароля")
+
+	ErrInvalidAPIKey  = errors.New("Недействительный API-ключ")
+	ErrAPIKeyNotFound = errors.New("API-ключ не найден")
+	ErrPVZForbidden   = errors.New("Нет доступа к этому ПВЗ")
+
+	ErrPVZNotFound        = errors.New("ПВЗ не найден")
+	ErrNotEmployee        = errors.New("На ПВЗ назначаются только сотрудники")
+	ErrAssignmentNotFound = errors.New("Сотрудник не назначен на этот ПВЗ")
+
+	ErrSessionNotFound = errors.New("Сессия не найдена")
+
+	ErrEmailChangeDisabled = errors.New("Смена email не настроена")
+	ErrEmailTaken          = errors.New("Email занят другим пользователем")
+	ErrSameEmail           = errors.New("Новый email совпадает с текущим")
+	ErrInvalidEmailToken   = errors.New("Ссылка для подтверждения email недействительна или уже использована")
+	ErrTooManyEmailChanges = errors.New("Слишком много запросов на смену email")
+	ErrSendingMail         = errors.New("Не удалось отправить письмо")
 )
 
 type AuthRepo interface {
 	InsertUser(ctx context.Context, userID strfmt.UUID, email string, hashedPassword string, role string) error
-	GetUserCredsByEmail(ctx context.Context, email string) (userID uuid.UUID, role string, passwordHash string, err error)
+	GetUserCredsByEmail(ctx context.Context, email string) (*UserCreds, error)
+	ListUsers(ctx context.Context, filter UserFilter, page, limit int) (*UserPage, error)
+	GetUser(ctx context.Context, userID strfmt.UUID) (*models.User, error)
+	UpdateUser(ctx context.Context, userID strfmt.UUID, patch *models.UserPatch) (*models.User, error)
+	DeleteUser(ctx context.Context, userID strfmt.UUID) error
+	RevokeUserSessions(ctx context.Context, userID strfmt.UUID) error
+	RevokeOtherSessions(ctx context.Context, userID strfmt.UUID, keepFamilyID string) error
+	GetPasswordHash(ctx context.Context, userID strfmt.UUID) (string, error)
+	UpdatePasswordHash(ctx context.Context, userID strfmt.UUID, hashedPassword string) error
+	InsertRefreshToken(ctx context.Context, token *RefreshToken) error
+	GetRefreshToken(ctx context.Context, tokenHash string) (*RefreshToken, error)
+	MarkRefreshTokenUsed(ctx context.Context, id strfmt.UUID) (bool, error)
+	RevokeRefreshFamily(ctx context.Context, familyID strfmt.UUID) error
+	IsSessionActive(ctx context.Context, familyID string) (bool, error)
+	InsertSession(ctx context.Context, session *Session) error
+	ListSessions(ctx context.Context, userID strfmt.UUID, activeSince time.Time) ([]*Session, error)
+	TouchSession(ctx context.Context, sessionID strfmt.UUID, seenAt time.Time) error
+	RevokeSession(ctx context.Context, userID, sessionID strfmt.UUID) error
+	GetUserByIdentity(ctx context.Context, provider, subject string) (*models.User, error)
+	LinkIdentity(ctx context.Context, provider, subject string, userID strfmt.UUID) error
+	InsertInvite(ctx context.Context, invite *Invite) error
+	InsertUserWithInvite(ctx context.Context, userID strfmt.UUID, email, hashedPassword, role, codeHash string) (string, error)
+	InsertPasswordReset(ctx context.Context, reset *PasswordReset) error
+	GetPasswordReset(ctx context.Context, tokenHash string) (*PasswordReset, error)
+	CompletePasswordReset(ctx context.Context, resetID strfmt.UUID, hashedPassword string) error
+	InsertAPIKey(ctx context.Context, key *APIKey) error
+	ListAPIKeys(ctx context.Context) ([]*APIKey, error)
+	GetAPIKeyByPrefix(ctx context.Context, prefix string) (*APIKey, error)
+	RevokeAPIKey(ctx context.Context, keyID strfmt.UUID) error
+	TouchAPIKey(ctx context.Context, keyID strfmt.UUID, usedAt time.Time) error
+	ListPVZAssignments(ctx context.Context, userID strfmt.UUID) ([]*models.PVZAssignment, error)
+	AssignPVZ(ctx context.Context, userID, pvzID, actorID strfmt.UUID) error
+	UnassignPVZ(ctx context.Context, userID, pvzID strfmt.UUID) error
+	IsAssignedToPVZ(ctx context.Context, userID, pvzID strfmt.UUID) (bool, error)
+	GetProfile(ctx context.Context, userID strfmt.UUID) (*models.User, error)
+	InsertEmailChange(ctx context.Context, change *EmailChange) error
+	GetEmailChange(ctx context.Context, tokenHash string) (*EmailChange, error)
+	CompleteEmailChange(ctx context.Context, changeID strfmt.UUID) error
+}
+
+// IdentityProvider — внешний провайдер учетных записей, через которого можно войти
+// вместо пароля. Exchange проверяет код авторизации и возвращает подтвержденного пользователя.
+type IdentityProvider interface {
+	Name() string
+	AuthCodeURL(ctx context.Context, state, nonce, verifier string) (string, error)
+	Exchange(ctx context.Context, code, verifier, nonce string) (*ExternalIdentity, error)
+}
+
+// LoginThrottler ограничивает подбор паролей: считает неудачные входы по аккаунту
+// и по IP и временно блокирует вход. Check и Fail возвращают, сколько еще длится
+// блокировка; 0 — вход разрешен.
+type LoginThrottler interface {
+	Check(ctx context.Context, email, ip string) (time.Duration, error)
+	Fail(ctx context.Context, email, ip string) (time.Duration, error)
+	Succeed(ctx context.Context, email, ip string) error
+	Unlock(ctx context.Context, email string) error
+}
+
+// LockoutError возвращается, когда действие временно запрещено. Err — причина,
+// по умолчанию ErrTooManyAttempts (заблокирован вход); errors.Is(err, Err) верно.
+type LockoutError struct {
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *LockoutError) Error() string {
+	return fmt.Sprintf("%s, повторите через %s", e.Unwrap(), e.RetryAfter.Round(time.Second))
+}
+
+func (e *LockoutError) Unwrap() error {
+	if e.Err == nil {
+		return ErrTooManyAttempts
+	}
+	return e.Err
+}
+
+// Mailer отправляет письма пользователям.
+type Mailer interface {
+	Send(ctx context.Context, msg *MailMessage) error
+}
+
+// ResetThrottler ограничивает частоту запросов на сброс пароля для одного email.
+// Allow учитывает запрос и возвращает, сколько еще ждать; 0 — запрос разрешен.
+type ResetThrottler interface {
+	Allow(ctx context.Context, email string) (time.Duration, error)
+}
+
+// TokenSigner подписывает access-токены и публикует ключи для их проверки.
+type TokenSigner interface {
+	Sign(claims jwt.Claims) (string, error)
+	JWKS() *models.JWKS
 }
 
 type AuthUsecase interface {
 	GenerateDummyToken(ctx context.Context, role string) (string, error)
-	SignUp(ctx context.Context, email string, password string, role string) (*models.User, string, error)
-	Login(ctx context.Context, email, password string) (*models.User, string, error)
+	SignUp(ctx context.Context, email, password, role, inviteCode string) (*models.User, *Tokens, error)
+	Login(ctx context.Context, email, password, clientIP string) (*models.User, *Tokens, error)
+	Refresh(ctx context.Context, refreshToken string) (*Tokens, error)
+	Logout(ctx context.Context, refreshToken string) error
+	PublicKeys(ctx context.Context) *models.JWKS
+	StartExternalLogin(ctx context.Context) (*ExternalLogin, error)
+	CompleteExternalLogin(ctx context.Context, code, verifier, nonce string) (*models.User, *Tokens, error)
+	ListUsers(ctx context.Context, filter UserFilter, page, limit int) (*UserPage, error)
+	GetUser(ctx context.Context, userID strfmt.UUID) (*models.User, error)
+	UpdateUser(ctx context.Context, actorID, userID strfmt.UUID, patch *models.UserPatch) (*models.User, error)
+	DeleteUser(ctx context.Context, actorID, userID strfmt.UUID) error
+	UnlockUser(ctx context.Context, actorID, userID strfmt.UUID) error
+	CreateInvite(ctx context.Context, actorID strfmt.UUID, role, email string, ttl time.Duration) (*models.Invite, error)
+	ChangePassword(ctx context.Context, oldPassword, newPassword string) error
+	ForgotPassword(ctx context.Context, email string) error
+	ResetPassword(ctx context.Context, token, newPassword string) error
+	CreateAPIKey(ctx context.Context, actorID strfmt.UUID, name, role string, pvzIDs []strfmt.UUID, ttl time.Duration) (*models.APIKey, error)
+	ListAPIKeys(ctx context.Context) ([]*models.APIKey, error)
+	RevokeAPIKey(ctx context.Context, keyID strfmt.UUID) error
+	AuthenticateAPIKey(ctx context.Context, key string) (*Principal, error)
+	ListPVZAssignments(ctx context.Context, userID strfmt.UUID) ([]*models.PVZAssignment, error)
+	AssignPVZ(ctx context.Context, actorID, userID, pvzID strfmt.UUID) error
+	UnassignPVZ(ctx context.Context, actorID, userID, pvzID strfmt.UUID) error
+	ListSessions(ctx context.Context) ([]*models.Session, error)
+	RevokeSession(ctx context.Context, sessionID strfmt.UUID) error
+	RevokeUserSessions(ctx context.Context, actorID, userID strfmt.UUID) error
+	GetMe(ctx context.Context) (*models.User, error)
+	UpdateMe(ctx context.Context, patch *models.MePatch) (*models.User, error)
+	ConfirmEmail(ctx context.Context, token string) error
+}
+
+// PVZAuthorizer решает, может ли пользователь запроса менять приемки и товары ПВЗ.
+// Отказ — ErrPVZForbidden.
+type PVZAuthorizer interface {
+	AuthorizePVZ(ctx context.Context, pvzID strfmt.UUID) error
 }
@@ -0,0 +1,73 @@
+package keys
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+
+	"github.com/go-openapi/swag"
+	"github.com/totorialman/go-task-avito/models"
+)
+
+const jwkUseSig = "sig"
+
+// JWKS возвращает публичные части всех ключей набора в порядке kid.
+func (ks *KeySet) JWKS() *models.JWKS {
+	set := &models.JWKS{Keys: make([]*models.JWK, 0, len(ks.order))}
+	for _, id := range ks.order {
+		set.Keys = append(set.Keys, toJWK(ks.keys[id]))
+	}
+	return set
+}
+
+func toJWK(k *Key) *models.JWK {
+	jwk := &models.JWK{
+		Kid: swag.String(k.ID),
+		Use: swag.String(jwkUseSig),
+		Alg: swag.String(k.Method.Alg()),
+	}
+	switch pub := k.Public.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = swag.String(models.JWKKtyRSA)
+		jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = swag.String(models.JWKKtyOKP)
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+	}
+	return jwk
+}
+
+// FromJWK восстанавливает ключ проверки из JWK, например из набора ключей внешнего провайдера.
+func FromJWK(jwk *models.JWK) (*Key, error) {
+	kid := swag.StringValue(jwk.Kid)
+	switch swag.StringValue(jwk.Kty) {
+	case models.JWKKtyRSA:
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			return nil, fmt.Errorf("jwk %s: n: %w", kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			return nil, fmt.Errorf("jwk %s: e: %w", kid, err)
+		}
+		return newKey(kid, &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())})
+	case models.JWKKtyOKP:
+		if jwk.Crv != "Ed25519" {
+			return nil, fmt.Errorf("%w: кривая %q", ErrUnsupportedKey, jwk.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(jwk.X)
+		if err != nil {
+			return nil, fmt.Errorf("jwk %s: x: %w", kid, err)
+		}
+		if len(x) != ed25519.PublicKeySize {
+			return nil, fmt.Errorf("%w: длина ключа %d", ErrUnsupportedKey, len(x))
+		}
+		return newKey(kid, ed25519.PublicKey(x))
+	default:
+		return nil, fmt.Errorf("%w: kty %q", ErrUnsupportedKey, swag.StringValue(jwk.Kty))
+	}
+}
@@ -0,0 +1,246 @@
+// Package keys хранит ключи, которыми подписываются и проверяются access-токены.
+//
+// Ключи лежат в каталоге по одному PEM-файлу на ключ, имя файла без ".pem"
+// (и без ".pub" у публичных ключей) — kid.
+// Приватный ключ (RSA или Ed25519, PKCS#1/PKCS#8) годится и для подписи, и для проверки,
+// публичный — только для проверки. Подписывает ровно один ключ, проверяют все:
+// так при ротации токены, выданные старым ключом, продолжают приниматься, пока
+// его файл не удален из каталога.
+package keys
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/golang-jwt/jwt"
+)
+
+const (
+	AlgRS256 = "RS256"
+	AlgEdDSA = "EdDSA"
+
+	rsaKeyBits = 2048
+)
+
+var (
+	ErrNoSigningKey      = errors.New("нет ключа для подписи токенов")
+	ErrUnknownKeyID      = errors.New("неизвестный kid")
+	ErrUnsupportedKey    = errors.New("неподдерживаемый тип ключа")
+	ErrUnsupportedAlg    = errors.New("неподдерживаемый алгоритм подписи")
+	ErrSigningKeyMissing = errors.New("ключ для подписи не найден в каталоге")
+)
+
+// Key — один ключ набора. У ключа только для проверки Private равен nil.
+type Key struct {
+	ID      string
+	Method  jwt.SigningMethod
+	Private crypto.Signer
+	Public  crypto.PublicKey
+}
+
+func (k *Key) CanSign() bool {
+	return k.Private != nil
+}
+
+// KeySet — набор ключей с одним ключом для подписи.
+type KeySet struct {
+	signing *Key
+	keys    map[string]*Key
+	order   []string
+}
+
+// NewKeySet собирает набор из готовых ключей. signingKID должен указывать на ключ
+// с приватной частью; пустой signingKID допустим, только если такой ключ один.
+func NewKeySet(signingKID string, keys ...*Key) (*KeySet, error) {
+	ks := &KeySet{keys: make(map[string]*Key, len(keys))}
+	for _, k := range keys {
+		if _, ok := ks.keys[k.ID]; ok {
+			return nil, fmt.Errorf("ключ %q встречается дважды", k.ID)
+		}
+		ks.keys[k.ID] = k
+		ks.order = append(ks.order, k.ID)
+	}
+	sort.Strings(ks.order)
+
+	if signingKID == "" {
+		for _, id := range ks.order {
+			if !ks.keys[id].CanSign() {
+				continue
+			}
+			if signingKID != "" {
+				return nil, fmt.Errorf("%w: приватных ключей несколько, задайте JWT_SIGNING_KEY_ID", ErrNoSigningKey)
+			}
+			signingKID = id
+		}
+		if signingKID == "" {
+			return nil, ErrNoSigningKey
+		}
+	}
+
+	signing, ok := ks.keys[signingKID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrSigningKeyMissing, signingKID)
+	}
+	if !signing.CanSign() {
+		return nil, fmt.Errorf("%w: у ключа %s нет приватной части", ErrNoSigningKey, signingKID)
+	}
+	ks.signing = signing
+
+	return ks, nil
+}
+
+// LoadDir читает все *.pem из dir.
+func LoadDir(dir, signingKID string) (*KeySet, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.pem"))
+	if err != nil {
+		return nil, err
+	}
+
+	byID := make(map[string]*Key, len(paths))
+	for _, path := range paths {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		kid := strings.TrimSuffix(strings.TrimSuffix(filepath.Base(path), ".pem"), ".pub")
+		key, err := ParsePEM(kid, data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		// <kid>.pub.pem рядом с <kid>.pem ничего не добавляет к приватному ключу.
+		if prev, ok := byID[kid]; ok && prev.CanSign() {
+			continue
+		}
+		byID[kid] = key
+	}
+
+	keys := make([]*Key, 0, len(byID))
+	for _, key := range byID {
+		keys = append(keys, key)
+	}
+
+	return NewKeySet(signingKID, keys...)
+}
+
+// ParsePEM разбирает приватный или публичный ключ RSA/Ed25519.
+func ParsePEM(kid string, data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("файл не содержит PEM-блока")
+	}
+
+	var parsed interface{}
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		parsed, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("%w: PEM %q", ErrUnsupportedKey, block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return newKey(kid, parsed)
+}
+
+func newKey(kid string, raw interface{}) (*Key, error) {
+	switch k := raw.(type) {
+	case *rsa.PrivateKey:
+		return &Key{ID: kid, Method: jwt.SigningMethodRS256, Private: k, Public: &k.PublicKey}, nil
+	case *rsa.PublicKey:
+		return &Key{ID: kid, Method: jwt.SigningMethodRS256, Public: k}, nil
+	case ed25519.PrivateKey:
+		return &Key{ID: kid, Method: jwt.SigningMethodEdDSA, Private: k, Public: k.Public()}, nil
+	case ed25519.PublicKey:
+		return &Key{ID: kid, Method: jwt.SigningMethodEdDSA, Public: k}, nil
+	default:
+		return nil, fmt.Errorf("%w: %T", ErrUnsupportedKey, raw)
+	}
+}
+
+// Generate создает новый приватный ключ для алгоритма alg.
+func Generate(kid, alg string) (*Key, error) {
+	switch alg {
+	case AlgRS256:
+		k, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return nil, err
+		}
+		return newKey(kid, k)
+	case AlgEdDSA:
+		_, k, err := ed25519.GenerateKey(rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		return newKey(kid, k)
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedAlg, alg)
+	}
+}
+
+// MarshalPrivatePEM кодирует приватную часть ключа в PKCS#8 PEM, в формате, который читает LoadDir.
+func MarshalPrivatePEM(k *Key) ([]byte, error) {
+	if !k.CanSign() {
+		return nil, fmt.Errorf("%w: у ключа %s нет приватной части", ErrNoSigningKey, k.ID)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(k.Private)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// MarshalPublicPEM кодирует публичную часть ключа: ее кладут в каталог, когда ключ
+// выведен из подписи, но выданные им токены еще должны проверяться.
+func MarshalPublicPEM(k *Key) ([]byte, error) {
+	der, err := x509.MarshalPKIXPublicKey(k.Public)
+	if err != nil {
+		return nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), nil
+}
+
+// SigningKeyID возвращает kid ключа, которым подписываются новые токены.
+func (ks *KeySet) SigningKeyID() string {
+	return ks.signing.ID
+}
+
+// Sign подписывает claims текущим ключом и проставляет kid в заголовок.
+func (ks *KeySet) Sign(claims jwt.Claims) (string, error) {
+	token := jwt.NewWithClaims(ks.signing.Method, claims)
+	token.Header["kid"] = ks.signing.ID
+	return token.SignedString(ks.signing.Private)
+}
+
+// Parse проверяет подпись ключом из заголовка kid. Алгоритм берется из ключа,
+// а не из токена, поэтому подмена alg (none, HS256 с публичным ключом) не проходит.
+func (ks *KeySet) Parse(tokenString string, claims jwt.Claims) (*jwt.Token, error) {
+	return jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		key, ok := ks.keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownKeyID, kid)
+		}
+		if token.Method.Alg() != key.Method.Alg() {
+			return nil, fmt.Errorf("%w: %v для ключа %s", ErrUnsupportedAlg, token.Header["alg"], kid)
+		}
+		return key.Public, nil
+	})
+}
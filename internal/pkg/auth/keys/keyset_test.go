@@ -0,0 +1,291 @@
+package keys
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeKey(t *testing.T, dir string, key *Key, public bool) {
+	t.Helper()
+	var data []byte
+	var err error
+	name := key.ID + ".pem"
+	if public {
+		data, err = MarshalPublicPEM(key)
+		name = key.ID + ".pub.pem"
+	} else {
+		data, err = MarshalPrivatePEM(key)
+	}
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), data, 0600))
+}
+
+func generate(t *testing.T, kid, alg string) *Key {
+	t.Helper()
+	key, err := Generate(kid, alg)
+	require.NoError(t, err)
+	return key
+}
+
+func testClaims() jwt.MapClaims {
+	return jwt.MapClaims{"role": "employee", "exp": time.Now().Add(time.Minute).Unix()}
+}
+
+func TestKeySet_SignParse(t *testing.T) {
+	tests := []struct {
+		name string
+		alg  string
+	}{
+		{"RS256", AlgRS256},
+		{"EdDSA", AlgEdDSA},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ks, err := NewKeySet("", generate(t, "k1", tt.alg))
+			require.NoError(t, err)
+
+			signed, err := ks.Sign(testClaims())
+			require.NoError(t, err)
+
+			claims := jwt.MapClaims{}
+			token, err := ks.Parse(signed, claims)
+			require.NoError(t, err)
+			assert.Equal(t, "k1", token.Header["kid"])
+			assert.Equal(t, tt.alg, token.Header["alg"])
+			assert.Equal(t, "employee", claims["role"])
+		})
+	}
+}
+
+func TestKeySet_ParseRejects(t *testing.T) {
+	ks, err := NewKeySet("", generate(t, "k1", AlgEdDSA))
+	require.NoError(t, err)
+	other, err := NewKeySet("", generate(t, "k1", AlgEdDSA))
+	require.NoError(t, err)
+
+	forged, err := other.Sign(testClaims())
+	require.NoError(t, err)
+
+	unknownKid := jwt.NewWithClaims(jwt.SigningMethodEdDSA, testClaims())
+	unknownKid.Header["kid"] = "missing"
+	unknownSigned, err := unknownKid.SignedString(ks.signing.Private)
+	require.NoError(t, err)
+
+	hmac := jwt.NewWithClaims(jwt.SigningMethodHS256, testClaims())
+	hmac.Header["kid"] = "k1"
+	hmacSigned, err := hmac.SignedString([]byte(ks.keys["k1"].Public.(ed25519.PublicKey)))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"Signed by another key with the same kid", forged},
+		{"Unknown kid", unknownSigned},
+		{"HS256 with the public key as secret", hmacSigned},
+		{"Garbage", "not-a-token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ks.Parse(tt.token, jwt.MapClaims{})
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestLoadDir(t *testing.T) {
+	tests := []struct {
+		name        string
+		files       func(t *testing.T, dir string)
+		signingKID  string
+		expectKID   string
+		expectKeys  int
+		expectError error
+	}{
+		{
+			name: "Single private key signs",
+			files: func(t *testing.T, dir string) {
+				writeKey(t, dir, generate(t, "a", AlgEdDSA), false)
+			},
+			expectKID:  "a",
+			expectKeys: 1,
+		},
+		{
+			name: "Retired public key stays for verification",
+			files: func(t *testing.T, dir string) {
+				writeKey(t, dir, generate(t, "old", AlgRS256), true)
+				writeKey(t, dir, generate(t, "new", AlgEdDSA), false)
+			},
+			expectKID:  "new",
+			expectKeys: 2,
+		},
+		{
+			name: "Public copy of a private key is not a second key",
+			files: func(t *testing.T, dir string) {
+				key := generate(t, "a", AlgEdDSA)
+				writeKey(t, dir, key, false)
+				writeKey(t, dir, key, true)
+			},
+			expectKID:  "a",
+			expectKeys: 1,
+		},
+		{
+			name: "Several private keys need an explicit kid",
+			files: func(t *testing.T, dir string) {
+				writeKey(t, dir, generate(t, "a", AlgEdDSA), false)
+				writeKey(t, dir, generate(t, "b", AlgEdDSA), false)
+			},
+			expectError: ErrNoSigningKey,
+		},
+		{
+			name: "Explicit kid picks among private keys",
+			files: func(t *testing.T, dir string) {
+				writeKey(t, dir, generate(t, "a", AlgEdDSA), false)
+				writeKey(t, dir, generate(t, "b", AlgEdDSA), false)
+			},
+			signingKID: "b",
+			expectKID:  "b",
+			expectKeys: 2,
+		},
+		{
+			name: "Explicit kid must exist",
+			files: func(t *testing.T, dir string) {
+				writeKey(t, dir, generate(t, "a", AlgEdDSA), false)
+			},
+			signingKID:  "b",
+			expectError: ErrSigningKeyMissing,
+		},
+		{
+			name: "Only public keys",
+			files: func(t *testing.T, dir string) {
+				writeKey(t, dir, generate(t, "a", AlgEdDSA), true)
+			},
+			expectError: ErrNoSigningKey,
+		},
+		{
+			name:        "Empty directory",
+			files:       func(t *testing.T, dir string) {},
+			expectError: ErrNoSigningKey,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			tt.files(t, dir)
+
+			ks, err := LoadDir(dir, tt.signingKID)
+			if tt.expectError != nil {
+				assert.ErrorIs(t, err, tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectKID, ks.SigningKeyID())
+			assert.Len(t, ks.JWKS().Keys, tt.expectKeys)
+		})
+	}
+}
+
+func TestKeySet_Rotation(t *testing.T) {
+	dir := t.TempDir()
+	oldKey := generate(t, "old", AlgRS256)
+	writeKey(t, dir, oldKey, false)
+
+	before, err := LoadDir(dir, "")
+	require.NoError(t, err)
+	issued, err := before.Sign(testClaims())
+	require.NoError(t, err)
+
+	// Новый ключ начинает подписывать, старый остается только для проверки.
+	require.NoError(t, os.Remove(filepath.Join(dir, "old.pem")))
+	writeKey(t, dir, oldKey, true)
+	writeKey(t, dir, generate(t, "new", AlgEdDSA), false)
+
+	after, err := LoadDir(dir, "")
+	require.NoError(t, err)
+	assert.Equal(t, "new", after.SigningKeyID())
+
+	_, err = after.Parse(issued, jwt.MapClaims{})
+	assert.NoError(t, err, "tokens from the retired key are still accepted")
+
+	fresh, err := after.Sign(testClaims())
+	require.NoError(t, err)
+	_, err = before.Parse(fresh, jwt.MapClaims{})
+	var verr *jwt.ValidationError
+	require.ErrorAs(t, err, &verr)
+	assert.ErrorIs(t, verr.Inner, ErrUnknownKeyID)
+}
+
+func TestKeySet_JWKS(t *testing.T) {
+	rsaKey := generate(t, "r", AlgRS256)
+	edKey := generate(t, "e", AlgEdDSA)
+	ks, err := NewKeySet("e", rsaKey, edKey)
+	require.NoError(t, err)
+
+	jwks := ks.JWKS()
+	require.Len(t, jwks.Keys, 2)
+
+	ed, rs := jwks.Keys[0], jwks.Keys[1]
+
+	assert.Equal(t, "e", *ed.Kid)
+	assert.Equal(t, "OKP", *ed.Kty)
+	assert.Equal(t, "EdDSA", *ed.Alg)
+	assert.Equal(t, "Ed25519", ed.Crv)
+	x, err := base64.RawURLEncoding.DecodeString(ed.X)
+	require.NoError(t, err)
+	assert.Equal(t, []byte(edKey.Public.(ed25519.PublicKey)), x)
+
+	assert.Equal(t, "r", *rs.Kid)
+	assert.Equal(t, "RSA", *rs.Kty)
+	assert.Equal(t, "RS256", *rs.Alg)
+	assert.Equal(t, "sig", *rs.Use)
+	n, err := base64.RawURLEncoding.DecodeString(rs.N)
+	require.NoError(t, err)
+	e, err := base64.RawURLEncoding.DecodeString(rs.E)
+	require.NoError(t, err)
+	pub := rsaKey.Public.(*rsa.PublicKey)
+	assert.Equal(t, 0, pub.N.Cmp(new(big.Int).SetBytes(n)))
+	assert.Equal(t, int64(pub.E), new(big.Int).SetBytes(e).Int64())
+	assert.Empty(t, rs.X)
+}
+
+func TestFromJWK_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		alg  string
+	}{
+		{"RS256", AlgRS256},
+		{"EdDSA", AlgEdDSA},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := generate(t, "k", tt.alg)
+			ks, err := NewKeySet("", key)
+			require.NoError(t, err)
+
+			restored, err := FromJWK(ks.JWKS().Keys[0])
+			require.NoError(t, err)
+			assert.Equal(t, "k", restored.ID)
+			assert.Equal(t, tt.alg, restored.Method.Alg())
+			assert.False(t, restored.CanSign())
+
+			signed, err := ks.Sign(testClaims())
+			require.NoError(t, err)
+			_, err = jwt.Parse(signed, func(*jwt.Token) (interface{}, error) { return restored.Public, nil })
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+)
+
+var testMessage = &auth.MailMessage{
+	To:      "ivan@corp.example",
+	Subject: "Сброс пароля",
+	Body:    "Ссылка:\nhttps://pvz.example/reset?token=abc",
+}
+
+// parse читает письмо обратно, как это сделал бы почтовый клиент.
+func parse(t *testing.T, raw []byte) (*mail.Message, string) {
+	t.Helper()
+	msg, err := mail.ReadMessage(bytes.NewReader(raw))
+	require.NoError(t, err)
+	body, err := io.ReadAll(quotedprintable.NewReader(msg.Body))
+	require.NoError(t, err)
+	return msg, string(body)
+}
+
+func TestFormat(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	raw, err := Format("ПВЗ <noreply@pvz.example>", testMessage, now)
+	require.NoError(t, err)
+
+	msg, body := parse(t, raw)
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, "Сброс пароля", subject)
+	assert.Equal(t, "ivan@corp.example", msg.Header.Get("To"))
+	assert.Contains(t, msg.Header.Get("Message-Id"), "@pvz.example>")
+	date, err := msg.Header.Date()
+	require.NoError(t, err)
+	assert.True(t, now.Equal(date))
+	assert.Equal(t, "Ссылка:\r\nhttps://pvz.example/reset?token=abc", body)
+
+	_, err = Format("noreply@pvz.example", &auth.MailMessage{To: "not an address"}, now)
+	assert.Error(t, err)
+}
+
+func TestOutboxMailer(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "outbox")
+	m, err := NewOutboxMailer(dir, "noreply@pvz.example")
+	require.NoError(t, err)
+
+	require.NoError(t, m.Send(context.Background(), testMessage))
+	require.NoError(t, m.Send(context.Background(), testMessage))
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+	require.NoError(t, err)
+	require.Len(t, files, 2, "every mail is a separate file")
+
+	info, err := os.Stat(files[0])
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	raw, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	_, body := parse(t, raw)
+	assert.Contains(t, body, "token=abc")
+}
+
+func TestSMTPMailer(t *testing.T) {
+	tests := []struct {
+		name       string
+		cfg        SMTPConfig
+		sendErr    error
+		expectAuth bool
+		expectErr  bool
+	}{
+		{
+			name:       "With auth",
+			cfg:        SMTPConfig{Addr: "smtp.corp.example:587", Username: "pvz", Password: "s3cret", From: "ПВЗ <noreply@pvz.example>"},
+			expectAuth: true,
+		},
+		{
+			name: "Without auth",
+			cfg:  SMTPConfig{Addr: "localhost:25", From: "noreply@pvz.example"},
+		},
+		{
+			name:      "Server error",
+			cfg:       SMTPConfig{Addr: "localhost:25", From: "noreply@pvz.example"},
+			sendErr:   errors.New("550 mailbox unavailable"),
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewSMTPMailer(tt.cfg)
+			require.NoError(t, err)
+
+			var gotAddr, gotFrom string
+			var gotTo []string
+			var gotAuth smtp.Auth
+			m.send = func(addr string, a smtp.Auth, from string, to []string, msg []byte) error {
+				gotAddr, gotAuth, gotFrom, gotTo = addr, a, from, to
+				return tt.sendErr
+			}
+
+			err = m.Send(context.Background(), testMessage)
+			if tt.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.cfg.Addr, gotAddr)
+			assert.Equal(t, "noreply@pvz.example", gotFrom, "envelope sender is the bare address")
+			assert.Equal(t, []string{"ivan@corp.example"}, gotTo)
+			assert.Equal(t, tt.expectAuth, gotAuth != nil)
+		})
+	}
+}
+
+func TestNewSMTPMailer_InvalidConfig(t *testing.T) {
+	_, err := NewSMTPMailer(SMTPConfig{Addr: "localhost:25", From: "not an address"})
+	assert.Error(t, err)
+	_, err = NewSMTPMailer(SMTPConfig{Addr: "localhost", From: "noreply@pvz.example"})
+	assert.Error(t, err)
+}
@@ -0,0 +1,41 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+)
+
+// OutboxMailer реализует auth.Mailer и складывает письма файлами в каталог.
+// Каждое письмо — отдельный .eml, его можно открыть почтовым клиентом.
+type OutboxMailer struct {
+	dir  string
+	from string
+	now  func() time.Time
+}
+
+func NewOutboxMailer(dir, from string) (*OutboxMailer, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("не удалось создать каталог писем: %w", err)
+	}
+	return &OutboxMailer{dir: dir, from: from, now: time.Now}, nil
+}
+
+func (m *OutboxMailer) Send(ctx context.Context, msg *auth.MailMessage) error {
+	now := m.now()
+	body, err := Format(m.from, msg, now)
+	if err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%s-%s.eml", now.UTC().Format("20060102T150405.000000000"), messageID())
+	// Письмо со ссылкой сброса — секрет, как и ключи подписи.
+	if err := os.WriteFile(filepath.Join(m.dir, name), body, 0o600); err != nil {
+		return fmt.Errorf("ошибка записи письма: %w", err)
+	}
+	return nil
+}
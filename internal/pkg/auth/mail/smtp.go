@@ -0,0 +1,61 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"time"
+
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+)
+
+type SMTPConfig struct {
+	// Addr — host:port сервера.
+	Addr string
+	// Username и Password для PLAIN-аутентификации; без Username письма
+	// отправляются без аутентификации.
+	Username string
+	Password string
+	// From — адрес отправителя, например "ПВЗ <noreply@pvz.example>".
+	From string
+}
+
+// SMTPMailer реализует auth.Mailer. STARTTLS используется, если сервер его
+// поддерживает (это делает net/smtp).
+type SMTPMailer struct {
+	cfg      SMTPConfig
+	envelope string
+	send     func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+func NewSMTPMailer(cfg SMTPConfig) (*SMTPMailer, error) {
+	from, err := mail.ParseAddress(cfg.From)
+	if err != nil {
+		return nil, fmt.Errorf("некорректный адрес отправителя %q: %w", cfg.From, err)
+	}
+	if _, _, err := net.SplitHostPort(cfg.Addr); err != nil {
+		return nil, fmt.Errorf("некорректный адрес SMTP-сервера %q: %w", cfg.Addr, err)
+	}
+	return &SMTPMailer{cfg: cfg, envelope: from.Address, send: smtp.SendMail}, nil
+}
+
+func (m *SMTPMailer) Send(ctx context.Context, msg *auth.MailMessage) error {
+	body, err := Format(m.cfg.From, msg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	var a smtp.Auth
+	if m.cfg.Username != "" {
+		host, _, _ := net.SplitHostPort(m.cfg.Addr)
+		a = smtp.PlainAuth("", m.cfg.Username, m.cfg.Password, host)
+	}
+
+	to, _ := mail.ParseAddress(msg.To)
+	if err := m.send(m.cfg.Addr, a, m.envelope, []string{to.Address}, body); err != nil {
+		return fmt.Errorf("ошибка отправки письма: %w", err)
+	}
+	return nil
+}
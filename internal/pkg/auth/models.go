@@ -0,0 +1,192 @@
+package auth
+
+import (
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/totorialman/go-task-avito/models"
+)
+
+// UserCreds — данные пользователя, нужные для входа по паролю.
+type UserCreds struct {
+	ID           strfmt.UUID
+	Role         string
+	PasswordHash string
+	Disabled     bool
+}
+
+// UserFilter — фильтр списка пользователей, nil-поля не фильтруют.
+type UserFilter struct {
+	Role     *string
+	Disabled *bool
+}
+
+type UserPage struct {
+	Items []*models.User
+	Total int64
+}
+
+// RefreshToken — строка таблицы refresh_tokens вместе с email и ролью владельца.
+type RefreshToken struct {
+	ID        strfmt.UUID
+	FamilyID  strfmt.UUID
+	UserID    strfmt.UUID
+	Email     string
+	Role      string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+	RevokedAt *time.Time
+}
+
+// Tokens — пара токенов, выдаваемая при входе и при обновлении.
+type Tokens struct {
+	Access           string
+	AccessExpiresAt  time.Time
+	Refresh          string
+	RefreshExpiresAt time.Time
+}
+
+// ExternalIdentity — пользователь, подтвержденный внешним провайдером (SSO).
+// Provider и Subject однозначно определяют учетную запись у провайдера.
+type ExternalIdentity struct {
+	Provider      string
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Groups        []string
+}
+
+// ExternalLogin — параметры начатого входа через провайдера. State, Nonce и
+// Verifier нужно сохранить у клиента и вернуть в CompleteExternalLogin.
+type ExternalLogin struct {
+	URL      string
+	State    string
+	Nonce    string
+	Verifier string
+}
+
+// RoleMapping сопоставляет группы пользователя у провайдера нашим ролям.
+// Модераторские группы проверяются первыми.
+type RoleMapping struct {
+	ModeratorGroups []string
+	EmployeeGroups  []string
+}
+
+// RoleFor возвращает роль по группам или пустую строку, если ни одна группа не подходит.
+func (m RoleMapping) RoleFor(groups []string) string {
+	member := func(allowed []string) bool {
+		for _, g := range groups {
+			for _, a := range allowed {
+				if g == a {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	switch {
+	case member(m.ModeratorGroups):
+		return models.UserRoleModerator
+	case member(m.EmployeeGroups):
+		return models.UserRoleEmployee
+	default:
+		return ""
+	}
+}
+
+// RegistrationPolicy ограничивает самостоятельную регистрацию сотрудников.
+// Пустой AllowedDomains разрешает любой email.
+type RegistrationPolicy struct {
+	AllowedDomains []string
+}
+
+func (p RegistrationPolicy) AllowsEmail(email string) bool {
+	if len(p.AllowedDomains) == 0 {
+		return true
+	}
+	at := strings.LastIndex(email, "@")
+	if at < 0 {
+		return false
+	}
+	domain := email[at+1:]
+	for _, allowed := range p.AllowedDomains {
+		if strings.EqualFold(domain, allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+// Invite — строка таблицы invites. Сам код приглашения не хранится, только его хеш.
+type Invite struct {
+	ID        strfmt.UUID
+	CodeHash  string
+	Role      string
+	Email     string
+	CreatedBy strfmt.UUID
+	ExpiresAt time.Time
+}
+
+// PasswordReset — строка таблицы password_resets вместе с email владельца.
+type PasswordReset struct {
+	ID        strfmt.UUID
+	UserID    strfmt.UUID
+	Email     string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+}
+
+// MailMessage — текстовое письмо одному получателю.
+type MailMessage struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// APIKey — строка таблицы api_keys. Сам ключ не хранится, только хеш его секретной части.
+type APIKey struct {
+	ID         strfmt.UUID
+	Name       string
+	Prefix     string
+	SecretHash string
+	Role       string
+	PVZIDs     []strfmt.UUID
+	CreatedBy  strfmt.UUID
+	CreatedAt  time.Time
+	ExpiresAt  *time.Time
+	LastUsedAt *time.Time
+	RevokedAt  *time.Time
+}
+
+// Device — устройство, с которого пользователь входит. Label указывает сам
+// пользователь при входе, например номер терминала.
+type Device struct {
+	Label     string
+	UserAgent string
+	IP        string
+}
+
+// Session — строка таблицы sessions: один вход пользователя, семейство его
+// refresh-токенов.
+type Session struct {
+	ID         strfmt.UUID
+	UserID     strfmt.UUID
+	Device     Device
+	CreatedAt  time.Time
+	LastSeenAt time.Time
+	RevokedAt  *time.Time
+}
+
+// EmailChange — строка таблицы email_changes вместе с текущим email владельца.
+type EmailChange struct {
+	ID        strfmt.UUID
+	UserID    strfmt.UUID
+	Email     string
+	NewEmail  string
+	TokenHash string
+	ExpiresAt time.Time
+	UsedAt    *time.Time
+}
@@ -0,0 +1,49 @@
+package auth
+
+import "testing"
+
+func TestRegistrationPolicy_AllowsEmail(t *testing.T) {
+	tests := []struct {
+		name    string
+		domains []string
+		email   string
+		want    bool
+	}{
+		{"No list allows everyone", nil, "ivan@mail.example", true},
+		{"Listed domain", []string{"corp.example", "avito.example"}, "ivan@avito.example", true},
+		{"Domain is case-insensitive", []string{"corp.example"}, "Ivan@CORP.example", true},
+		{"Other domain", []string{"corp.example"}, "ivan@mail.example", false},
+		{"Subdomain is not the domain", []string{"corp.example"}, "ivan@evil.corp.example", false},
+		{"Suffix is not the domain", []string{"corp.example"}, "ivan@notcorp.example", false},
+		{"Not an email", []string{"corp.example"}, "corp.example", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := RegistrationPolicy{AllowedDomains: tt.domains}
+			if got := policy.AllowsEmail(tt.email); got != tt.want {
+				t.Errorf("AllowsEmail(%q) = %v, want %v", tt.email, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrincipal_IsAnonymous(t *testing.T) {
+	tests := []struct {
+		name      string
+		principal Principal
+		want      bool
+	}{
+		{"Dummy login", Principal{Role: "moderator"}, true},
+		{"User", Principal{UserID: "11111111-1111-1111-1111-111111111111", Role: "moderator"}, false},
+		{"API key", Principal{APIKeyID: "22222222-2222-2222-2222-222222222222", Role: "employee"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.principal.IsAnonymous(); got != tt.want {
+				t.Errorf("IsAnonymous() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
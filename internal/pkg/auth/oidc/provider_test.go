@@ -0,0 +1,165 @@
+package oidc_test
+
+import (
+	"context"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth/oidc"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth/oidc/oidctest"
+)
+
+const redirectURL = "http://localhost:8080/oauth/callback"
+
+func newProvider(t *testing.T) (*oidctest.Server, *oidc.Provider) {
+	t.Helper()
+	srv, err := oidctest.NewServer("avito-pvz", "s3cret")
+	require.NoError(t, err)
+	t.Cleanup(srv.Close)
+
+	srv.User = oidctest.User{
+		Subject:       "emp-42",
+		Email:         "ivan@corp.example",
+		EmailVerified: true,
+		Groups:        []string{"pvz-staff"},
+	}
+
+	provider := oidc.NewProvider(oidc.Config{
+		Issuer:       srv.Issuer(),
+		ClientID:     "avito-pvz",
+		ClientSecret: "s3cret",
+		RedirectURL:  redirectURL,
+	}, srv.Client())
+	return srv, provider
+}
+
+func TestProvider_AuthCodeURL(t *testing.T) {
+	srv, provider := newProvider(t)
+
+	authURL, err := provider.AuthCodeURL(context.Background(), "state-1", "nonce-1", "verifier-1")
+	require.NoError(t, err)
+
+	u, err := url.Parse(authURL)
+	require.NoError(t, err)
+	q := u.Query()
+	assert.Equal(t, srv.URL+"/authorize", u.Scheme+"://"+u.Host+u.Path)
+	assert.Equal(t, "code", q.Get("response_type"))
+	assert.Equal(t, "avito-pvz", q.Get("client_id"))
+	assert.Equal(t, redirectURL, q.Get("redirect_uri"))
+	assert.Equal(t, "openid email profile", q.Get("scope"))
+	assert.Equal(t, "state-1", q.Get("state"))
+	assert.Equal(t, "nonce-1", q.Get("nonce"))
+	assert.Equal(t, "S256", q.Get("code_challenge_method"))
+	assert.Equal(t, oidc.CodeChallenge("verifier-1"), q.Get("code_challenge"))
+	assert.NotContains(t, authURL, "verifier-1")
+}
+
+func TestProvider_Exchange(t *testing.T) {
+	srv, provider := newProvider(t)
+	ctx := context.Background()
+
+	authURL, err := provider.AuthCodeURL(ctx, "state-1", "nonce-1", "verifier-1")
+	require.NoError(t, err)
+	code, state, err := srv.Authorize(authURL)
+	require.NoError(t, err)
+	assert.Equal(t, "state-1", state)
+
+	identity, err := provider.Exchange(ctx, code, "verifier-1", "nonce-1")
+	require.NoError(t, err)
+	assert.Equal(t, oidc.DefaultName, identity.Provider)
+	assert.Equal(t, "emp-42", identity.Subject)
+	assert.Equal(t, "ivan@corp.example", identity.Email)
+	assert.True(t, identity.EmailVerified)
+	assert.Equal(t, []string{"pvz-staff"}, identity.Groups)
+
+	_, err = provider.Exchange(ctx, code, "verifier-1", "nonce-1")
+	assert.ErrorIs(t, err, oidc.ErrTokenEndpoint, "authorization code is single-use")
+}
+
+func TestProvider_ExchangeRejects(t *testing.T) {
+	tests := []struct {
+		name        string
+		verifier    string
+		nonce       string
+		claims      map[string]interface{}
+		secret      string
+		expectError error
+	}{
+		{
+			name:        "Wrong PKCE verifier",
+			verifier:    "another-verifier",
+			nonce:       "nonce-1",
+			expectError: oidc.ErrTokenEndpoint,
+		},
+		{
+			name:        "Wrong client secret",
+			verifier:    "verifier-1",
+			nonce:       "nonce-1",
+			secret:      "guess",
+			expectError: oidc.ErrTokenEndpoint,
+		},
+		{
+			name:        "Nonce mismatch",
+			verifier:    "verifier-1",
+			nonce:       "nonce-2",
+			expectError: oidc.ErrInvalidIDToken,
+		},
+		{
+			name:        "Token for another client",
+			verifier:    "verifier-1",
+			nonce:       "nonce-1",
+			claims:      map[string]interface{}{"aud": "someone-else"},
+			expectError: oidc.ErrInvalidIDToken,
+		},
+		{
+			name:        "Token from another issuer",
+			verifier:    "verifier-1",
+			nonce:       "nonce-1",
+			claims:      map[string]interface{}{"iss": "https://evil.example"},
+			expectError: oidc.ErrInvalidIDToken,
+		},
+		{
+			name:        "Expired token",
+			verifier:    "verifier-1",
+			nonce:       "nonce-1",
+			claims:      map[string]interface{}{"exp": 1},
+			expectError: oidc.ErrInvalidIDToken,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, provider := newProvider(t)
+			srv.Claims = tt.claims
+			if tt.secret != "" {
+				srv.ClientSecret = tt.secret
+			}
+			ctx := context.Background()
+
+			authURL, err := provider.AuthCodeURL(ctx, "state-1", "nonce-1", "verifier-1")
+			require.NoError(t, err)
+			code, _, err := srv.Authorize(authURL)
+			require.NoError(t, err)
+
+			_, err = provider.Exchange(ctx, code, tt.verifier, tt.nonce)
+			assert.ErrorIs(t, err, tt.expectError)
+		})
+	}
+}
+
+func TestProvider_IssuerMismatch(t *testing.T) {
+	srv, err := oidctest.NewServer("avito-pvz", "s3cret")
+	require.NoError(t, err)
+	defer srv.Close()
+
+	provider := oidc.NewProvider(oidc.Config{
+		Name:     "corp",
+		Issuer:   srv.Issuer() + "/tenant",
+		ClientID: "avito-pvz",
+	}, srv.Client())
+
+	_, err = provider.AuthCodeURL(context.Background(), "s", "n", "v")
+	assert.Error(t, err)
+}
@@ -0,0 +1,130 @@
+// Package password хеширует пароли argon2id в формате PHC
+// ($argon2id$v=19$m=65536,t=2,p=4$<соль>$<хеш>) и проверяет их требования к сложности.
+// Параметры хранятся в самом хеше, поэтому их можно усилить, не ломая старые хеши:
+// Verify сообщает, что хеш пора пересчитать.
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+)
+
+var ErrInvalidHash = errors.New("неизвестный формат хеша пароля")
+
+// Params — параметры argon2id. Memory в KiB.
+type Params struct {
+	Time    uint32
+	Memory  uint32
+	Threads uint8
+	SaltLen uint32
+	KeyLen  uint32
+}
+
+var DefaultParams = Params{Time: 2, Memory: 64 * 1024, Threads: 4, SaltLen: 16, KeyLen: 32}
+
+// Параметры, которыми хешировались пароли до перехода на PHC: 8 байт соли и
+// hex(соль || хеш) без указания параметров.
+var legacyParams = Params{Time: 1, Memory: 64 * 1024, Threads: 4, SaltLen: 8, KeyLen: 32}
+
+type Hasher struct {
+	params Params
+}
+
+func NewHasher(params Params) *Hasher {
+	return &Hasher{params: params}
+}
+
+func (h *Hasher) Hash(password string) (string, error) {
+	salt := make([]byte, h.params.SaltLen)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("ошибка генерации соли: %w", err)
+	}
+	key := argon2.IDKey([]byte(password), salt, h.params.Time, h.params.Memory, h.params.Threads, h.params.KeyLen)
+
+	b64 := base64.RawStdEncoding
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, h.params.Memory, h.params.Time, h.params.Threads,
+		b64.EncodeToString(salt), b64.EncodeToString(key)), nil
+}
+
+// Verify проверяет пароль. needsRehash верно, если пароль подошел, но хеш записан
+// в старом формате или с другими параметрами. Пустой хеш (вход только через SSO)
+// не подходит ни к одному паролю.
+func (h *Hasher) Verify(encoded, password string) (ok, needsRehash bool, err error) {
+	if encoded == "" {
+		return false, false, nil
+	}
+
+	var params Params
+	var salt, key []byte
+	if strings.HasPrefix(encoded, "$") {
+		params, salt, key, err = decodePHC(encoded)
+	} else {
+		params, salt, key, err = decodeLegacy(encoded)
+	}
+	if err != nil {
+		return false, false, err
+	}
+
+	computed := argon2.IDKey([]byte(password), salt, params.Time, params.Memory, params.Threads, uint32(len(key)))
+	if subtle.ConstantTimeCompare(computed, key) != 1 {
+		return false, false, nil
+	}
+	return true, params != h.params, nil
+}
+
+func decodePHC(encoded string) (Params, []byte, []byte, error) {
+	// "", "argon2id", "v=19", "m=...,t=...,p=...", соль, хеш
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != "argon2id" {
+		return Params{}, nil, nil, ErrInvalidHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return Params{}, nil, nil, fmt.Errorf("%w: версия %q", ErrInvalidHash, parts[2])
+	}
+
+	var params Params
+	_, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Time, &params.Threads)
+	// argon2 паникует при нулевых t или p.
+	if err != nil || params.Time == 0 || params.Threads == 0 {
+		return Params{}, nil, nil, fmt.Errorf("%w: параметры %q", ErrInvalidHash, parts[3])
+	}
+
+	b64 := base64.RawStdEncoding
+	salt, err := b64.DecodeString(parts[4])
+	if err != nil {
+		return Params{}, nil, nil, fmt.Errorf("%w: соль", ErrInvalidHash)
+	}
+	key, err := b64.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return Params{}, nil, nil, fmt.Errorf("%w: хеш", ErrInvalidHash)
+	}
+	params.SaltLen = uint32(len(salt))
+	params.KeyLen = uint32(len(key))
+
+	return params, salt, key, nil
+}
+
+func decodeLegacy(encoded string) (Params, []byte, []byte, error) {
+	raw, err := hex.DecodeString(encoded)
+	if err != nil || len(raw) <= int(legacyParams.SaltLen) {
+		return Params{}, nil, nil, ErrInvalidHash
+	}
+	return legacyParams, raw[:legacyParams.SaltLen], raw[legacyParams.SaltLen:], nil
+}
+
+// LegacyHash считает хеш в прежнем hex-формате. Новые пароли так не хешируются,
+// функция нужна для проверки совместимости.
+func LegacyHash(salt []byte, password string) string {
+	key := argon2.IDKey([]byte(password), salt, legacyParams.Time, legacyParams.Memory, legacyParams.Threads, legacyParams.KeyLen)
+	return hex.EncodeToString(append(salt, key...))
+}
@@ -0,0 +1,116 @@
+package password
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+)
+
+// testParams делают тесты быстрыми; формат хеша от параметров не зависит.
+var testParams = Params{Time: 1, Memory: 1024, Threads: 1, SaltLen: 16, KeyLen: 32}
+
+func TestHasher_RoundTrip(t *testing.T) {
+	h := NewHasher(testParams)
+
+	hash, err := h.Hash("secret")
+	require.NoError(t, err)
+	assert.True(t, strings.HasPrefix(hash, "$argon2id$v=19$m=1024,t=1,p=1$"), hash)
+
+	ok, needsRehash, err := h.Verify(hash, "secret")
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.False(t, needsRehash)
+
+	ok, _, err = h.Verify(hash, "Secret")
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	again, err := h.Hash("secret")
+	require.NoError(t, err)
+	assert.NotEqual(t, hash, again, "every hash gets its own salt")
+}
+
+func TestHasher_NeedsRehash(t *testing.T) {
+	legacy := LegacyHash([]byte("saltsalt"), "secret")
+	stronger := testParams
+	stronger.Time = 2
+	oldHash, err := NewHasher(testParams).Hash("secret")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		encoded string
+	}{
+		{"Legacy hex hash", legacy},
+		{"Weaker PHC params", oldHash},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ok, needsRehash, err := NewHasher(stronger).Verify(tt.encoded, "secret")
+			require.NoError(t, err)
+			assert.True(t, ok)
+			assert.True(t, needsRehash)
+
+			_, needsRehash, err = NewHasher(stronger).Verify(tt.encoded, "wrong")
+			require.NoError(t, err)
+			assert.False(t, needsRehash, "rehash only after a successful check")
+		})
+	}
+}
+
+func TestHasher_InvalidHash(t *testing.T) {
+	h := NewHasher(testParams)
+
+	ok, _, err := h.Verify("", "anything")
+	assert.NoError(t, err)
+	assert.False(t, ok, "SSO users have no password")
+
+	for _, encoded := range []string{
+		"not-hex",
+		"abcd",
+		"$bcrypt$v=19$m=1024,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=16$m=1024,t=1,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=0,p=1$c2FsdA$a2V5",
+		"$argon2id$v=19$m=1024,t=1,p=1$c2FsdA",
+		"$argon2id$v=19$m=1024,t=1,p=1$c2FsdA$!!",
+	} {
+		ok, _, err := h.Verify(encoded, "anything")
+		assert.ErrorIs(t, err, ErrInvalidHash, encoded)
+		assert.False(t, ok, encoded)
+	}
+}
+
+func TestPolicy_Validate(t *testing.T) {
+	policy := Policy{MinLength: 8, MaxLength: 16, MinClasses: 3}
+
+	tests := []struct {
+		name     string
+		password string
+		email    string
+		wantErr  bool
+	}{
+		{"Strong", "Pvz-2024x", "ivan@corp.example", false},
+		{"Too short", "Pv-1", "ivan@corp.example", true},
+		{"Too long", "Pvz-2024x-Pvz-2024x", "ivan@corp.example", true},
+		{"Too few classes", "pvzpvzpvz", "ivan@corp.example", true},
+		{"Contains email name", "Ivan-2024x", "ivan@corp.example", true},
+		{"Short email name is ignored", "Pvz-2024x", "pv@corp.example", false},
+		{"Length counts runes", "Пароль-12", "ivan@corp.example", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := policy.Validate(tt.password, tt.email)
+			if !tt.wantErr {
+				assert.NoError(t, err)
+				return
+			}
+			assert.True(t, errors.Is(err, auth.ErrWeakPassword), "got %v", err)
+		})
+	}
+}
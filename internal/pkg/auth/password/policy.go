@@ -0,0 +1,69 @@
+package password
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+)
+
+// Policy — требования к новому паролю. MinClasses — сколько разных классов
+// символов (строчные, заглавные, цифры, прочие) должно быть в пароле.
+type Policy struct {
+	MinLength  int
+	MaxLength  int
+	MinClasses int
+}
+
+var DefaultPolicy = Policy{MinLength: 8, MaxLength: 128, MinClasses: 1}
+
+// Validate возвращает ошибку, для которой errors.Is(err, auth.ErrWeakPassword)
+// верно, с перечнем нарушенных требований. email не должен входить в пароль.
+func (p Policy) Validate(password, email string) error {
+	var problems []string
+
+	length := utf8.RuneCountInString(password)
+	if length < p.MinLength {
+		problems = append(problems, fmt.Sprintf("не короче %d символов", p.MinLength))
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		problems = append(problems, fmt.Sprintf("не длиннее %d символов", p.MaxLength))
+	}
+	if classes := countClasses(password); classes < p.MinClasses {
+		problems = append(problems, fmt.Sprintf("минимум %d из: строчные, заглавные, цифры, другие символы", p.MinClasses))
+	}
+	if name, _, _ := strings.Cut(email, "@"); len(name) >= 3 && strings.Contains(strings.ToLower(password), strings.ToLower(name)) {
+		problems = append(problems, "не содержит имя из email")
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("%w: %s", auth.ErrWeakPassword, strings.Join(problems, "; "))
+	}
+	return nil
+}
+
+func countClasses(password string) int {
+	var lower, upper, digit, other bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			other = true
+		}
+	}
+
+	count := 0
+	for _, present := range []bool{lower, upper, digit, other} {
+		if present {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,95 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+)
+
+type ctxKey string
+
+const (
+	principalKey ctxKey = "principal"
+	deviceKey    ctxKey = "device"
+)
+
+// Principal — пользователь, от имени которого выполняется запрос. Собирается
+// из claims access-токена; у тестовых токенов /dummyLogin UserID пустой.
+// Запросы по API-ключу идут без пользователя: заполнены Role, APIKeyID и PVZIDs.
+type Principal struct {
+	UserID    strfmt.UUID
+	Email     string
+	Role      string
+	TokenID   string
+	SessionID string
+	APIKeyID  strfmt.UUID
+	// PVZIDs ограничивает ПВЗ, с которыми можно работать; пустой — любые.
+	PVZIDs []strfmt.UUID
+}
+
+// IsAnonymous сообщает, что за запросом нет ни пользователя, ни API-ключа: так
+// выглядят тестовые токены /dummyLogin. Такие запросы ничего не меняют.
+func (p *Principal) IsAnonymous() bool {
+	return p.UserID == "" && p.APIKeyID == ""
+}
+
+// CanAccessPVZ сообщает, можно ли работать с ПВЗ pvzID.
+func (p *Principal) CanAccessPVZ(pvzID strfmt.UUID) bool {
+	if len(p.PVZIDs) == 0 {
+		return true
+	}
+	for _, id := range p.PVZIDs {
+		if id == pvzID {
+			return true
+		}
+	}
+	return false
+}
+
+func WithPrincipal(ctx context.Context, principal *Principal) context.Context {
+	return context.WithValue(ctx, principalKey, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (*Principal, bool) {
+	principal, ok := ctx.Value(principalKey).(*Principal)
+	return principal, ok && principal != nil
+}
+
+// UserIDFromContext возвращает ID пользователя запроса или пустую строку,
+// если пользователь неизвестен.
+func UserIDFromContext(ctx context.Context) strfmt.UUID {
+	if principal, ok := PrincipalFromContext(ctx); ok {
+		return principal.UserID
+	}
+	return ""
+}
+
+// RequireActor проверяет, что изменение вносит пользователь с учетной записью. У
+// тестовых токенов /dummyLogin и API-ключей пользователя нет: в журнале не останется,
+// кто внес изменение, поэтому им возвращается ErrForbidden.
+func RequireActor(actorID strfmt.UUID) error {
+	if actorID == "" {
+		return ErrForbidden
+	}
+	return nil
+}
+
+// CanAccessPVZ проверяет доступ к ПВЗ для пользователя запроса. Без пользователя
+// в контексте ограничений нет: их проверяет ACL.
+func CanAccessPVZ(ctx context.Context, pvzID strfmt.UUID) bool {
+	if principal, ok := PrincipalFromContext(ctx); ok {
+		return principal.CanAccessPVZ(pvzID)
+	}
+	return true
+}
+
+// WithDevice запоминает устройство запроса: по нему подписывается сессия,
+// которая откроется при входе.
+func WithDevice(ctx context.Context, device Device) context.Context {
+	return context.WithValue(ctx, deviceKey, device)
+}
+
+func DeviceFromContext(ctx context.Context) Device {
+	device, _ := ctx.Value(deviceKey).(Device)
+	return device
+}
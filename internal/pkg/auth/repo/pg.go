@@ -2,13 +2,18 @@ package pg
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/go-openapi/strfmt"
-	"github.com/gofrs/uuid"
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgtype/pgxtype"
+	"github.com/jackc/pgx/v4"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
 	"github.com/totorialman/go-task-avito/internal/pkg/utils/log"
+	"github.com/totorialman/go-task-avito/models"
 	"log/slog"
 )
 
@@ -29,26 +34,29 @@ func NewAuthRepo(db pgxtype.Querier) *AuthRepo {
 	return &AuthRepo{db: db}
 }
 
-const getUserCredsQuery = `SELECT id, role, password_hash FROM users WHERE email = $1`
+// У пользователей SSO нет пароля: пустой хеш не совпадет ни с одним паролем.
+const getUserCredsQuery = `SELECT id, role, COALESCE(password_hash, ''), disabled FROM users WHERE email = $1`
 
-func (r *AuthRepo) GetUserCredsByEmail(ctx context.Context, email string) (uuid.UUID, string, string, error) {
+func (r *AuthRepo) GetUserCredsByEmail(ctx context.Context, email string) (*auth.UserCreds, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
 
-	var id uuid.UUID
-	var role, hash string
-	err := r.db.QueryRow(ctx, getUserCredsQuery, email).Scan(&id, &role, &hash)
+	var creds auth.UserCreds
+	err := r.db.QueryRow(ctx, getUserCredsQuery, email).Scan(&creds.ID, &creds.Role, &creds.PasswordHash, &creds.Disabled)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, auth.ErrUserNotFound
+	}
 	if err != nil {
 		log.LogHandlerError(logger, fmt.Errorf("failed to get user credentials: %w", err), http.StatusInternalServerError)
-		return uuid.Nil, "", "", err
+		return nil, err
 	}
 
-	return id, role, hash, nil
+	return &creds, nil
 }
 
 func (repo *AuthRepo) InsertUser(ctx context.Context, userID strfmt.UUID, email string, hashedPassword string, role string) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
 
-	query := `INSERT INTO users (id, email, role, password_hash) VALUES ($1, $2, $3, $4)`
+	query := `INSERT INTO users (id, email, role, password_hash) VALUES ($1, $2, $3, NULLIF($4, ''))`
 	_, err := repo.db.Exec(ctx, query, userID, email, role, hashedPassword)
 	if err != nil {
 		log.LogHandlerError(logger, fmt.Errorf("failed to insert user: %w", err), http.StatusInternalServerError)
@@ -56,4 +64,892 @@ func (repo *AuthRepo) InsertUser(ctx context.Context, userID strfmt.UUID, email
 	}
 
 	return nil
-}
\ No newline at end of file
+}
+
+const (
+	insertRefreshTokenQuery = `
+		INSERT INTO refresh_tokens (id, family_id, user_id, token_hash, expires_at)
+		VALUES ($1, $2, $3, $4, $5)`
+	getRefreshTokenQuery = `
+		SELECT t.id, t.family_id, t.user_id, u.email, u.role, t.token_hash, t.expires_at, t.used_at, t.revoked_at
+		FROM refresh_tokens t
+		JOIN users u ON u.id = t.user_id
+		WHERE t.token_hash = $1`
+	markRefreshTokenUsedQuery = `
+		UPDATE refresh_tokens SET used_at = now()
+		WHERE id = $1 AND used_at IS NULL AND revoked_at IS NULL`
+	// Отзыв сессии отзывает и ее refresh-токены, чтобы их нельзя было обменять.
+	revokeRefreshFamilyQuery = `
+		WITH revoked AS (
+			UPDATE sessions SET revoked_at = now()
+			WHERE id = $1 AND revoked_at IS NULL
+		)
+		UPDATE refresh_tokens SET revoked_at = now()
+		WHERE family_id = $1 AND revoked_at IS NULL`
+	// Сессия активна, пока она не отозвана и владелец не заблокирован.
+	isSessionActiveQuery = `
+		SELECT COALESCE(bool_and(s.revoked_at IS NULL AND NOT u.disabled), false)
+		FROM sessions s
+		JOIN users u ON u.id = s.user_id
+		WHERE s.id = $1`
+	revokeUserSessionsQuery = `
+		WITH revoked AS (
+			UPDATE sessions SET revoked_at = now()
+			WHERE user_id = $1 AND revoked_at IS NULL
+		)
+		UPDATE refresh_tokens SET revoked_at = now()
+		WHERE user_id = $1 AND revoked_at IS NULL`
+	revokeOtherSessionsQuery = `
+		WITH revoked AS (
+			UPDATE sessions SET revoked_at = now()
+			WHERE user_id = $1 AND revoked_at IS NULL AND id::text <> $2
+		)
+		UPDATE refresh_tokens SET revoked_at = now()
+		WHERE user_id = $1 AND revoked_at IS NULL AND family_id::text <> $2`
+)
+
+func (r *AuthRepo) InsertRefreshToken(ctx context.Context, token *auth.RefreshToken) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	_, err := r.db.Exec(ctx, insertRefreshTokenQuery, token.ID, token.FamilyID, token.UserID, token.TokenHash, token.ExpiresAt)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to insert refresh token: %w", err), http.StatusInternalServerError)
+		return err
+	}
+
+	return nil
+}
+
+func (r *AuthRepo) GetRefreshToken(ctx context.Context, tokenHash string) (*auth.RefreshToken, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	var token auth.RefreshToken
+	err := r.db.QueryRow(ctx, getRefreshTokenQuery, tokenHash).Scan(
+		&token.ID, &token.FamilyID, &token.UserID, &token.Email, &token.Role, &token.TokenHash,
+		&token.ExpiresAt, &token.UsedAt, &token.RevokedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, auth.ErrInvalidRefreshToken
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get refresh token: %w", err), http.StatusInternalServerError)
+		return nil, err
+	}
+
+	return &token, nil
+}
+
+// MarkRefreshTokenUsed помечает токен использованным и возвращает false,
+// если его уже успел использовать или отозвать другой запрос.
+func (r *AuthRepo) MarkRefreshTokenUsed(ctx context.Context, id strfmt.UUID) (bool, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	tag, err := r.db.Exec(ctx, markRefreshTokenUsedQuery, id)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to mark refresh token used: %w", err), http.StatusInternalServerError)
+		return false, err
+	}
+
+	return tag.RowsAffected() == 1, nil
+}
+
+func (r *AuthRepo) RevokeRefreshFamily(ctx context.Context, familyID strfmt.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	_, err := r.db.Exec(ctx, revokeRefreshFamilyQuery, familyID)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to revoke refresh token family: %w", err), http.StatusInternalServerError)
+		return err
+	}
+
+	return nil
+}
+
+func (r *AuthRepo) IsSessionActive(ctx context.Context, familyID string) (bool, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	var active bool
+	err := r.db.QueryRow(ctx, isSessionActiveQuery, familyID).Scan(&active)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to check session: %w", err), http.StatusInternalServerError)
+		return false, err
+	}
+
+	return active, nil
+}
+
+const (
+	insertSessionQuery = `
+		INSERT INTO sessions (id, user_id, device_label, user_agent, ip)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING created_at, last_seen_at`
+	listSessionsQuery = `
+		SELECT id, user_id, device_label, user_agent, ip, created_at, last_seen_at, revoked_at
+		FROM sessions
+		WHERE user_id = $1 AND revoked_at IS NULL AND last_seen_at > $2
+		ORDER BY last_seen_at DESC, id`
+	touchSessionQuery  = `UPDATE sessions SET last_seen_at = $2 WHERE id = $1`
+	revokeSessionQuery = `
+		WITH revoked AS (
+			UPDATE sessions SET revoked_at = now()
+			WHERE id = $1 AND user_id = $2 AND revoked_at IS NULL
+			RETURNING id
+		), tokens AS (
+			UPDATE refresh_tokens SET revoked_at = now()
+			WHERE family_id IN (SELECT id FROM revoked) AND revoked_at IS NULL
+		)
+		SELECT count(*) FROM revoked`
+)
+
+func (r *AuthRepo) InsertSession(ctx context.Context, session *auth.Session) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	err := r.db.QueryRow(ctx, insertSessionQuery, session.ID, session.UserID, session.Device.Label,
+		session.Device.UserAgent, session.Device.IP).Scan(&session.CreatedAt, &session.LastSeenAt)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to insert session: %w", err), http.StatusInternalServerError)
+		return err
+	}
+
+	return nil
+}
+
+// ListSessions возвращает неотозванные сессии пользователя, которыми пользовались
+// после activeSince.
+func (r *AuthRepo) ListSessions(ctx context.Context, userID strfmt.UUID, activeSince time.Time) ([]*auth.Session, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	rows, err := r.db.Query(ctx, listSessionsQuery, userID, activeSince)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to list sessions: %w", err), http.StatusInternalServerError)
+		return nil, err
+	}
+	defer rows.Close()
+
+	sessions := []*auth.Session{}
+	for rows.Next() {
+		var session auth.Session
+		err := rows.Scan(&session.ID, &session.UserID, &session.Device.Label, &session.Device.UserAgent,
+			&session.Device.IP, &session.CreatedAt, &session.LastSeenAt, &session.RevokedAt)
+		if err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to scan session: %w", err), http.StatusInternalServerError)
+			return nil, err
+		}
+		sessions = append(sessions, &session)
+	}
+	if err := rows.Err(); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("session iteration error: %w", err), http.StatusInternalServerError)
+		return nil, err
+	}
+
+	return sessions, nil
+}
+
+func (r *AuthRepo) TouchSession(ctx context.Context, sessionID strfmt.UUID, seenAt time.Time) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	_, err := r.db.Exec(ctx, touchSessionQuery, sessionID, seenAt)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to update session: %w", err), http.StatusInternalServerError)
+		return err
+	}
+
+	return nil
+}
+
+// RevokeSession отзывает сессию пользователя userID. Чужая, уже отозванная или
+// несуществующая сессия — ErrSessionNotFound.
+func (r *AuthRepo) RevokeSession(ctx context.Context, userID, sessionID strfmt.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	var revoked int
+	if err := r.db.QueryRow(ctx, revokeSessionQuery, sessionID, userID).Scan(&revoked); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to revoke session: %w", err), http.StatusInternalServerError)
+		return err
+	}
+	if revoked == 0 {
+		return auth.ErrSessionNotFound
+	}
+
+	return nil
+}
+
+const (
+	getUserByIdentityQuery = `
+		SELECT u.id, u.email, u.role, u.disabled
+		FROM user_identities i
+		JOIN users u ON u.id = i.user_id
+		WHERE i.provider = $1 AND i.subject = $2`
+	linkIdentityQuery = `
+		INSERT INTO user_identities (provider, subject, user_id)
+		VALUES ($1, $2, $3)`
+)
+
+func (r *AuthRepo) GetUserByIdentity(ctx context.Context, provider, subject string) (*models.User, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	user, err := scanUser(r.db.QueryRow(ctx, getUserByIdentityQuery, provider, subject))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, auth.ErrIdentityNotFound
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get user by identity: %w", err), http.StatusInternalServerError)
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (r *AuthRepo) LinkIdentity(ctx context.Context, provider, subject string, userID strfmt.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	_, err := r.db.Exec(ctx, linkIdentityQuery, provider, subject, userID)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to link identity: %w", err), http.StatusInternalServerError)
+		return err
+	}
+
+	return nil
+}
+
+func (r *AuthRepo) RevokeUserSessions(ctx context.Context, userID strfmt.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	_, err := r.db.Exec(ctx, revokeUserSessionsQuery, userID)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to revoke user sessions: %w", err), http.StatusInternalServerError)
+		return err
+	}
+
+	return nil
+}
+
+// RevokeOtherSessions отзывает все сессии пользователя, кроме keepFamilyID.
+func (r *AuthRepo) RevokeOtherSessions(ctx context.Context, userID strfmt.UUID, keepFamilyID string) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	_, err := r.db.Exec(ctx, revokeOtherSessionsQuery, userID, keepFamilyID)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to revoke other sessions: %w", err), http.StatusInternalServerError)
+		return err
+	}
+
+	return nil
+}
+
+const (
+	getPasswordHashQuery    = `SELECT COALESCE(password_hash, '') FROM users WHERE id = $1`
+	updatePasswordHashQuery = `UPDATE users SET password_hash = $2 WHERE id = $1`
+)
+
+func (r *AuthRepo) GetPasswordHash(ctx context.Context, userID strfmt.UUID) (string, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	var hash string
+	err := r.db.QueryRow(ctx, getPasswordHashQuery, userID).Scan(&hash)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", auth.ErrUserNotFound
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get password hash: %w", err), http.StatusInternalServerError)
+		return "", err
+	}
+
+	return hash, nil
+}
+
+func (r *AuthRepo) UpdatePasswordHash(ctx context.Context, userID strfmt.UUID, hashedPassword string) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	tag, err := r.db.Exec(ctx, updatePasswordHashQuery, userID, hashedPassword)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to update password hash: %w", err), http.StatusInternalServerError)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return auth.ErrUserNotFound
+	}
+
+	return nil
+}
+
+const (
+	userColumns = "id, email, role, disabled"
+
+	countUsersQuery = `
+		SELECT COUNT(*)
+		FROM users
+		WHERE ($1::text IS NULL OR role = $1) AND ($2::boolean IS NULL OR disabled = $2)`
+	listUsersQuery = `
+		SELECT ` + userColumns + `
+		FROM users
+		WHERE ($1::text IS NULL OR role = $1) AND ($2::boolean IS NULL OR disabled = $2)
+		ORDER BY email
+		LIMIT $3 OFFSET $4`
+	getUserQuery    = `SELECT ` + userColumns + ` FROM users WHERE id = $1`
+	updateUserQuery = `
+		UPDATE users
+		SET role = COALESCE($2, role), disabled = COALESCE($3, disabled)
+		WHERE id = $1
+		RETURNING ` + userColumns
+	deleteUserQuery = `DELETE FROM users WHERE id = $1`
+)
+
+func scanUser(row pgx.Row) (*models.User, error) {
+	var user models.User
+	var email strfmt.Email
+	var role string
+	var disabled bool
+	if err := row.Scan(&user.ID, &email, &role, &disabled); err != nil {
+		return nil, err
+	}
+	user.Email = &email
+	user.Role = &role
+	user.Disabled = &disabled
+	return &user, nil
+}
+
+func (r *AuthRepo) ListUsers(ctx context.Context, filter auth.UserFilter, page, limit int) (*auth.UserPage, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	var total int64
+	err := r.db.QueryRow(ctx, countUsersQuery, filter.Role, filter.Disabled).Scan(&total)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to count users: %w", err), http.StatusInternalServerError)
+		return nil, err
+	}
+
+	rows, err := r.db.Query(ctx, listUsersQuery, filter.Role, filter.Disabled, limit, (page-1)*limit)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to list users: %w", err), http.StatusInternalServerError)
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := &auth.UserPage{Items: []*models.User{}, Total: total}
+	for rows.Next() {
+		user, err := scanUser(rows)
+		if err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to scan user: %w", err), http.StatusInternalServerError)
+			return nil, err
+		}
+		result.Items = append(result.Items, user)
+	}
+	if err := rows.Err(); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("user iteration error: %w", err), http.StatusInternalServerError)
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func (r *AuthRepo) GetUser(ctx context.Context, userID strfmt.UUID) (*models.User, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	user, err := scanUser(r.db.QueryRow(ctx, getUserQuery, userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, auth.ErrUserNotFound
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get user: %w", err), http.StatusInternalServerError)
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// UpdateUser меняет только переданные в patch поля.
+func (r *AuthRepo) UpdateUser(ctx context.Context, userID strfmt.UUID, patch *models.UserPatch) (*models.User, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	user, err := scanUser(r.db.QueryRow(ctx, updateUserQuery, userID, patch.Role, patch.Disabled))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, auth.ErrUserNotFound
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to update user: %w", err), http.StatusInternalServerError)
+		return nil, err
+	}
+
+	return user, nil
+}
+
+func (r *AuthRepo) DeleteUser(ctx context.Context, userID strfmt.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	tag, err := r.db.Exec(ctx, deleteUserQuery, userID)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to delete user: %w", err), http.StatusInternalServerError)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return auth.ErrUserNotFound
+	}
+
+	return nil
+}
+
+const (
+	insertInviteQuery = `
+		INSERT INTO invites (id, code_hash, role, email, created_by, expires_at)
+		VALUES ($1, $2, $3, NULLIF($4, ''), NULLIF($5, '')::uuid, $6)`
+	// Приглашение гасится и пользователь создается одним запросом: если email
+	// занят, вставка падает и приглашение остается неиспользованным.
+	insertUserWithInviteQuery = `
+		WITH invite AS (
+			UPDATE invites SET used_at = now(), used_by = $1
+			WHERE code_hash = $5
+				AND used_at IS NULL
+				AND expires_at > now()
+				AND (email IS NULL OR lower(email) = lower($2))
+				AND (NULLIF($4, '') IS NULL OR role = $4)
+			RETURNING role
+		)
+		INSERT INTO users (id, email, role, password_hash)
+		SELECT $1, $2, invite.role, NULLIF($3, '') FROM invite
+		RETURNING role`
+)
+
+func (r *AuthRepo) InsertInvite(ctx context.Context, invite *auth.Invite) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	_, err := r.db.Exec(ctx, insertInviteQuery,
+		invite.ID, invite.CodeHash, invite.Role, invite.Email, invite.CreatedBy.String(), invite.ExpiresAt)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to insert invite: %w", err), http.StatusInternalServerError)
+		return err
+	}
+
+	return nil
+}
+
+// InsertUserWithInvite создает пользователя по приглашению и возвращает его роль.
+// Пустой role принимает роль из приглашения.
+func (r *AuthRepo) InsertUserWithInvite(ctx context.Context, userID strfmt.UUID, email, hashedPassword, role, codeHash string) (string, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	var inviteRole string
+	err := r.db.QueryRow(ctx, insertUserWithInviteQuery, userID, email, hashedPassword, role, codeHash).Scan(&inviteRole)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", auth.ErrInvalidInvite
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to insert user with invite: %w", err), http.StatusInternalServerError)
+		return "", err
+	}
+
+	return inviteRole, nil
+}
+
+const (
+	insertPasswordResetQuery = `
+		INSERT INTO password_resets (id, user_id, token_hash, expires_at)
+		VALUES ($1, $2, $3, $4)`
+	getPasswordResetQuery = `
+		SELECT r.id, r.user_id, u.email, r.token_hash, r.expires_at, r.used_at
+		FROM password_resets r
+		JOIN users u ON u.id = r.user_id
+		WHERE r.token_hash = $1`
+	// Токен гасится и пароль меняется одним запросом; заодно гасятся остальные
+	// неиспользованные токены пользователя, чтобы старые письма перестали работать.
+	completePasswordResetQuery = `
+		WITH reset AS (
+			UPDATE password_resets SET used_at = now()
+			WHERE id = $1 AND used_at IS NULL AND expires_at > now()
+			RETURNING user_id
+		), others AS (
+			UPDATE password_resets SET used_at = now()
+			WHERE user_id = (SELECT user_id FROM reset) AND id <> $1 AND used_at IS NULL
+		)
+		UPDATE users SET password_hash = $2
+		WHERE id = (SELECT user_id FROM reset)`
+)
+
+func (r *AuthRepo) InsertPasswordReset(ctx context.Context, reset *auth.PasswordReset) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	_, err := r.db.Exec(ctx, insertPasswordResetQuery, reset.ID, reset.UserID, reset.TokenHash, reset.ExpiresAt)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to insert password reset: %w", err), http.StatusInternalServerError)
+		return err
+	}
+
+	return nil
+}
+
+func (r *AuthRepo) GetPasswordReset(ctx context.Context, tokenHash string) (*auth.PasswordReset, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	var reset auth.PasswordReset
+	err := r.db.QueryRow(ctx, getPasswordResetQuery, tokenHash).Scan(
+		&reset.ID, &reset.UserID, &reset.Email, &reset.TokenHash, &reset.ExpiresAt, &reset.UsedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, auth.ErrInvalidResetToken
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get password reset: %w", err), http.StatusInternalServerError)
+		return nil, err
+	}
+
+	return &reset, nil
+}
+
+// CompletePasswordReset гасит токен и ставит новый пароль. Если токен уже
+// использован или истек, возвращает auth.ErrInvalidResetToken.
+func (r *AuthRepo) CompletePasswordReset(ctx context.Context, resetID strfmt.UUID, hashedPassword string) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	tag, err := r.db.Exec(ctx, completePasswordResetQuery, resetID, hashedPassword)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to complete password reset: %w", err), http.StatusInternalServerError)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return auth.ErrInvalidResetToken
+	}
+
+	return nil
+}
+
+const (
+	apiKeyColumns = `id, name, prefix, secret_hash, role, pvz_ids::text[], COALESCE(created_by::text, ''),
+		created_at, expires_at, last_used_at, revoked_at`
+
+	insertAPIKeyQuery = `
+		INSERT INTO api_keys (id, name, prefix, secret_hash, role, pvz_ids, created_by, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6::uuid[], NULLIF($7, '')::uuid, $8)
+		RETURNING created_at`
+	listAPIKeysQuery       = `SELECT ` + apiKeyColumns + ` FROM api_keys ORDER BY created_at DESC`
+	getAPIKeyByPrefixQuery = `SELECT ` + apiKeyColumns + ` FROM api_keys WHERE prefix = $1`
+	revokeAPIKeyQuery      = `UPDATE api_keys SET revoked_at = COALESCE(revoked_at, now()) WHERE id = $1`
+	touchAPIKeyQuery       = `UPDATE api_keys SET last_used_at = $2 WHERE id = $1`
+)
+
+func scanAPIKey(row pgx.Row) (*auth.APIKey, error) {
+	var key auth.APIKey
+	var pvzIDs []string
+	var createdBy string
+	err := row.Scan(&key.ID, &key.Name, &key.Prefix, &key.SecretHash, &key.Role, &pvzIDs, &createdBy,
+		&key.CreatedAt, &key.ExpiresAt, &key.LastUsedAt, &key.RevokedAt)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range pvzIDs {
+		key.PVZIDs = append(key.PVZIDs, strfmt.UUID(id))
+	}
+	key.CreatedBy = strfmt.UUID(createdBy)
+	return &key, nil
+}
+
+func (r *AuthRepo) InsertAPIKey(ctx context.Context, key *auth.APIKey) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	pvzIDs := make([]string, 0, len(key.PVZIDs))
+	for _, id := range key.PVZIDs {
+		pvzIDs = append(pvzIDs, id.String())
+	}
+
+	err := r.db.QueryRow(ctx, insertAPIKeyQuery, key.ID, key.Name, key.Prefix, key.SecretHash, key.Role,
+		pvzIDs, key.CreatedBy.String(), key.ExpiresAt).Scan(&key.CreatedAt)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to insert api key: %w", err), http.StatusInternalServerError)
+		return err
+	}
+
+	return nil
+}
+
+func (r *AuthRepo) ListAPIKeys(ctx context.Context) ([]*auth.APIKey, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	rows, err := r.db.Query(ctx, listAPIKeysQuery)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to list api keys: %w", err), http.StatusInternalServerError)
+		return nil, err
+	}
+	defer rows.Close()
+
+	keys := []*auth.APIKey{}
+	for rows.Next() {
+		key, err := scanAPIKey(rows)
+		if err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to scan api key: %w", err), http.StatusInternalServerError)
+			return nil, err
+		}
+		keys = append(keys, key)
+	}
+	if err := rows.Err(); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("api key iteration error: %w", err), http.StatusInternalServerError)
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (r *AuthRepo) GetAPIKeyByPrefix(ctx context.Context, prefix string) (*auth.APIKey, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	key, err := scanAPIKey(r.db.QueryRow(ctx, getAPIKeyByPrefixQuery, prefix))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, auth.ErrInvalidAPIKey
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get api key: %w", err), http.StatusInternalServerError)
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// RevokeAPIKey отзывает ключ; повторный отзыв не меняет время первого.
+func (r *AuthRepo) RevokeAPIKey(ctx context.Context, keyID strfmt.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	tag, err := r.db.Exec(ctx, revokeAPIKeyQuery, keyID)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to revoke api key: %w", err), http.StatusInternalServerError)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return auth.ErrAPIKeyNotFound
+	}
+
+	return nil
+}
+
+func (r *AuthRepo) TouchAPIKey(ctx context.Context, keyID strfmt.UUID, usedAt time.Time) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	_, err := r.db.Exec(ctx, touchAPIKeyQuery, keyID, usedAt)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to update api key usage: %w", err), http.StatusInternalServerError)
+		return err
+	}
+
+	return nil
+}
+
+const (
+	listPVZAssignmentsQuery = `
+		SELECT pvz_id, COALESCE(assigned_by::text, ''), assigned_at
+		FROM user_pvz_assignments WHERE user_id = $1
+		ORDER BY assigned_at, pvz_id`
+	// Повторное назначение ничего не меняет, но считается успешным: пустой
+	// результат значит только, что такого ПВЗ нет.
+	assignPVZQuery = `
+		INSERT INTO user_pvz_assignments (user_id, pvz_id, assigned_by)
+		SELECT $1, id, NULLIF($3, '')::uuid FROM pvz WHERE id = $2
+		ON CONFLICT (user_id, pvz_id) DO UPDATE SET assigned_by = user_pvz_assignments.assigned_by`
+	unassignPVZQuery     = `DELETE FROM user_pvz_assignments WHERE user_id = $1 AND pvz_id = $2`
+	isAssignedToPVZQuery = `SELECT EXISTS (SELECT 1 FROM user_pvz_assignments WHERE user_id = $1 AND pvz_id = $2)`
+)
+
+func (r *AuthRepo) ListPVZAssignments(ctx context.Context, userID strfmt.UUID) ([]*models.PVZAssignment, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	rows, err := r.db.Query(ctx, listPVZAssignmentsQuery, userID)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to list pvz assignments: %w", err), http.StatusInternalServerError)
+		return nil, err
+	}
+	defer rows.Close()
+
+	assignments := []*models.PVZAssignment{}
+	for rows.Next() {
+		var pvzID strfmt.UUID
+		var assignedBy string
+		var assignedAt time.Time
+		if err := rows.Scan(&pvzID, &assignedBy, &assignedAt); err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to scan pvz assignment: %w", err), http.StatusInternalServerError)
+			return nil, err
+		}
+		assignment := &models.PVZAssignment{PvzID: &pvzID, AssignedAt: strfmt.DateTime(assignedAt)}
+		if assignedBy != "" {
+			actorID := strfmt.UUID(assignedBy)
+			assignment.AssignedBy = &actorID
+		}
+		assignments = append(assignments, assignment)
+	}
+	if err := rows.Err(); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("pvz assignment iteration error: %w", err), http.StatusInternalServerError)
+		return nil, err
+	}
+
+	return assignments, nil
+}
+
+func (r *AuthRepo) AssignPVZ(ctx context.Context, userID, pvzID, actorID strfmt.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	tag, err := r.db.Exec(ctx, assignPVZQuery, userID, pvzID, actorID.String())
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to assign pvz: %w", err), http.StatusInternalServerError)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return auth.ErrPVZNotFound
+	}
+
+	return nil
+}
+
+func (r *AuthRepo) UnassignPVZ(ctx context.Context, userID, pvzID strfmt.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	tag, err := r.db.Exec(ctx, unassignPVZQuery, userID, pvzID)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to unassign pvz: %w", err), http.StatusInternalServerError)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return auth.ErrAssignmentNotFound
+	}
+
+	return nil
+}
+
+func (r *AuthRepo) IsAssignedToPVZ(ctx context.Context, userID, pvzID strfmt.UUID) (bool, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	var assigned bool
+	if err := r.db.QueryRow(ctx, isAssignedToPVZQuery, userID, pvzID).Scan(&assigned); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to check pvz assignment: %w", err), http.StatusInternalServerError)
+		return false, err
+	}
+
+	return assigned, nil
+}
+
+const (
+	emailUniqueConstraint = "users_email_key"
+
+	// Последний вход — начало последней открытой сессии; ожидающий email — самый
+	// свежий непогашенный запрос на смену.
+	getProfileQuery = `
+		SELECT u.id, u.email, u.role, u.disabled, u.created_at,
+			(SELECT max(s.created_at) FROM sessions s WHERE s.user_id = u.id),
+			COALESCE((
+				SELECT c.new_email FROM email_changes c
+				WHERE c.user_id = u.id AND c.used_at IS NULL AND c.expires_at > now()
+				ORDER BY c.created_at DESC LIMIT 1
+			), '')
+		FROM users u
+		WHERE u.id = $1`
+	// Новый запрос гасит прежние, чтобы работала только ссылка из последнего письма.
+	insertEmailChangeQuery = `
+		WITH previous AS (
+			UPDATE email_changes SET used_at = now()
+			WHERE user_id = $2 AND used_at IS NULL
+		)
+		INSERT INTO email_changes (id, user_id, new_email, token_hash, expires_at)
+		VALUES ($1, $2, $3, $4, $5)`
+	getEmailChangeQuery = `
+		SELECT c.id, c.user_id, u.email, c.new_email, c.token_hash, c.expires_at, c.used_at
+		FROM email_changes c
+		JOIN users u ON u.id = c.user_id
+		WHERE c.token_hash = $1`
+	// Запрос гасится и email меняется одним запросом. Неиспользованные ссылки для
+	// сброса пароля ушли на прежний адрес и тоже гасятся.
+	completeEmailChangeQuery = `
+		WITH change AS (
+			UPDATE email_changes SET used_at = now()
+			WHERE id = $1 AND used_at IS NULL AND expires_at > now()
+			RETURNING user_id, new_email
+		), resets AS (
+			UPDATE password_resets SET used_at = now()
+			WHERE user_id = (SELECT user_id FROM change) AND used_at IS NULL
+		)
+		UPDATE users SET email = change.new_email
+		FROM change
+		WHERE users.id = change.user_id`
+)
+
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == constraint
+}
+
+// GetProfile возвращает пользователя вместе с временем регистрации и последнего
+// входа и email, ожидающим подтверждения. Назначения на ПВЗ не заполняются.
+func (r *AuthRepo) GetProfile(ctx context.Context, userID strfmt.UUID) (*models.User, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	var user models.User
+	var email strfmt.Email
+	var role, pendingEmail string
+	var disabled bool
+	var createdAt, lastLoginAt *time.Time
+	err := r.db.QueryRow(ctx, getProfileQuery, userID).Scan(
+		&user.ID, &email, &role, &disabled, &createdAt, &lastLoginAt, &pendingEmail)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, auth.ErrUserNotFound
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get profile: %w", err), http.StatusInternalServerError)
+		return nil, err
+	}
+
+	user.Email = &email
+	user.Role = &role
+	user.Disabled = &disabled
+	user.PendingEmail = strfmt.Email(pendingEmail)
+	if createdAt != nil {
+		dt := strfmt.DateTime(*createdAt)
+		user.CreatedAt = &dt
+	}
+	if lastLoginAt != nil {
+		dt := strfmt.DateTime(*lastLoginAt)
+		user.LastLoginAt = &dt
+	}
+	return &user, nil
+}
+
+func (r *AuthRepo) InsertEmailChange(ctx context.Context, change *auth.EmailChange) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	_, err := r.db.Exec(ctx, insertEmailChangeQuery,
+		change.ID, change.UserID, change.NewEmail, change.TokenHash, change.ExpiresAt)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to insert email change: %w", err), http.StatusInternalServerError)
+		return err
+	}
+
+	return nil
+}
+
+func (r *AuthRepo) GetEmailChange(ctx context.Context, tokenHash string) (*auth.EmailChange, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	var change auth.EmailChange
+	err := r.db.QueryRow(ctx, getEmailChangeQuery, tokenHash).Scan(
+		&change.ID, &change.UserID, &change.Email, &change.NewEmail, &change.TokenHash, &change.ExpiresAt, &change.UsedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, auth.ErrInvalidEmailToken
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get email change: %w", err), http.StatusInternalServerError)
+		return nil, err
+	}
+
+	return &change, nil
+}
+
+// CompleteEmailChange гасит запрос и ставит пользователю новый email. Если запрос
+// уже использован или истек, возвращает auth.ErrInvalidEmailToken, если email
+// успели занять — auth.ErrEmailTaken.
+func (r *AuthRepo) CompleteEmailChange(ctx context.Context, changeID strfmt.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	tag, err := r.db.Exec(ctx, completeEmailChangeQuery, changeID)
+	if isUniqueViolation(err, emailUniqueConstraint) {
+		return auth.ErrEmailTaken
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to complete email change: %w", err), http.StatusInternalServerError)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return auth.ErrInvalidEmailToken
+	}
+
+	return nil
+}
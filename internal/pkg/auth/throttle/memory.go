@@ -0,0 +1,78 @@
+package throttle
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Раз в столько записей MemoryStore выбрасывает забытые счетчики,
+// чтобы перебор с множества IP не раздувал память.
+const pruneEvery = 1024
+
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]*Record
+	writes  int
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{records: make(map[string]*Record)}
+}
+
+func (s *MemoryStore) Get(ctx context.Context, key string) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.records[key]
+	if !ok {
+		return nil, nil
+	}
+	copied := *record
+	return &copied, nil
+}
+
+func (s *MemoryStore) Fail(ctx context.Context, key string, now time.Time, resetAfter time.Duration) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.writes++
+	if s.writes%pruneEvery == 0 {
+		s.prune(now, resetAfter)
+	}
+
+	record, ok := s.records[key]
+	if !ok || now.Sub(record.LastFailure) > resetAfter {
+		record = &Record{}
+		s.records[key] = record
+	}
+	record.Failures++
+	record.LastFailure = now
+	return record.Failures, nil
+}
+
+func (s *MemoryStore) Lock(ctx context.Context, key string, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if record, ok := s.records[key]; ok {
+		record.LockedUntil = until
+	}
+	return nil
+}
+
+func (s *MemoryStore) Reset(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, key)
+	return nil
+}
+
+func (s *MemoryStore) prune(now time.Time, resetAfter time.Duration) {
+	for key, record := range s.records {
+		if now.Sub(record.LastFailure) > resetAfter && now.After(record.LockedUntil) {
+			delete(s.records, key)
+		}
+	}
+}
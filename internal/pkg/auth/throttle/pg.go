@@ -0,0 +1,81 @@
+package throttle
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgtype/pgxtype"
+	"github.com/jackc/pgx/v4"
+)
+
+const (
+	getAttemptsQuery = `SELECT failures, last_failure_at, COALESCE(locked_until, 'epoch') FROM login_attempts WHERE key = $1`
+	// Счетчик увеличивается одним запросом, чтобы параллельные попытки не терялись.
+	failAttemptQuery = `
+		INSERT INTO login_attempts (key, failures, last_failure_at)
+		VALUES ($1, 1, $2)
+		ON CONFLICT (key) DO UPDATE SET
+			failures = CASE WHEN login_attempts.last_failure_at < $3 THEN 1 ELSE login_attempts.failures + 1 END,
+			last_failure_at = $2
+		RETURNING failures`
+	lockAttemptsQuery  = `UPDATE login_attempts SET locked_until = $2 WHERE key = $1`
+	resetAttemptsQuery = `DELETE FROM login_attempts WHERE key = $1`
+	// Забытые счетчики удаляются при записи, отдельная фоновая задача не нужна.
+	pruneAttemptsQuery = `
+		DELETE FROM login_attempts
+		WHERE last_failure_at < $1 AND (locked_until IS NULL OR locked_until < $2)`
+)
+
+type PgStore struct {
+	db pgxtype.Querier
+
+	mu     sync.Mutex
+	writes int
+}
+
+func NewPgStore(db pgxtype.Querier) *PgStore {
+	return &PgStore{db: db}
+}
+
+func (s *PgStore) Get(ctx context.Context, key string) (*Record, error) {
+	var record Record
+	err := s.db.QueryRow(ctx, getAttemptsQuery, key).Scan(&record.Failures, &record.LastFailure, &record.LockedUntil)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+func (s *PgStore) Fail(ctx context.Context, key string, now time.Time, resetAfter time.Duration) (int, error) {
+	if s.shouldPrune() {
+		if _, err := s.db.Exec(ctx, pruneAttemptsQuery, now.Add(-resetAfter), now); err != nil {
+			return 0, err
+		}
+	}
+
+	var failures int
+	err := s.db.QueryRow(ctx, failAttemptQuery, key, now, now.Add(-resetAfter)).Scan(&failures)
+	return failures, err
+}
+
+func (s *PgStore) Lock(ctx context.Context, key string, until time.Time) error {
+	_, err := s.db.Exec(ctx, lockAttemptsQuery, key, until)
+	return err
+}
+
+func (s *PgStore) Reset(ctx context.Context, key string) error {
+	_, err := s.db.Exec(ctx, resetAttemptsQuery, key)
+	return err
+}
+
+func (s *PgStore) shouldPrune() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.writes++
+	return s.writes%pruneEvery == 0
+}
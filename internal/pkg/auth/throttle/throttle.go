@@ -0,0 +1,185 @@
+// Package throttle защищает вход по паролю от перебора: считает неудачные попытки
+// по аккаунту и по IP и после порога временно блокирует вход, удваивая срок
+// блокировки с каждой следующей неудачей. RequestLimiter на тех же счетчиках
+// ограничивает частоту запросов, например писем для сброса пароля.
+package throttle
+
+import (
+	"context"
+	"strings"
+	"time"
+)
+
+// Record — счетчик неудачных попыток по одному ключу.
+type Record struct {
+	Failures    int
+	LastFailure time.Time
+	LockedUntil time.Time
+}
+
+// Store хранит счетчики. MemoryStore подходит для одного экземпляра сервиса,
+// PgStore — когда экземпляров несколько и счетчики должны быть общими.
+type Store interface {
+	// Get возвращает nil без ошибки, если по ключу неудач не было.
+	Get(ctx context.Context, key string) (*Record, error)
+	// Fail увеличивает счетчик и возвращает новое значение. Если с прошлой
+	// неудачи прошло больше resetAfter, счет начинается заново.
+	Fail(ctx context.Context, key string, now time.Time, resetAfter time.Duration) (int, error)
+	Lock(ctx context.Context, key string, until time.Time) error
+	Reset(ctx context.Context, key string) error
+}
+
+type Policy struct {
+	// Threshold — сколько неудач подряд допускается без блокировки.
+	Threshold int
+	// BaseDelay — блокировка после Threshold-й неудачи, дальше она удваивается до MaxDelay.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// ResetAfter — через столько после последней неудачи счетчик забывается.
+	ResetAfter time.Duration
+}
+
+var (
+	DefaultAccountPolicy = Policy{Threshold: 5, BaseDelay: 30 * time.Second, MaxDelay: 15 * time.Minute, ResetAfter: time.Hour}
+	// С одного IP (NAT, офис) ходит много людей, поэтому порог выше.
+	DefaultIPPolicy = Policy{Threshold: 20, BaseDelay: 30 * time.Second, MaxDelay: 15 * time.Minute, ResetAfter: time.Hour}
+	// Три письма для сброса пароля подряд, дальше не чаще раза в 15 минут.
+	DefaultResetPolicy = Policy{Threshold: 3, BaseDelay: 15 * time.Minute, MaxDelay: time.Hour, ResetAfter: time.Hour}
+)
+
+// Delay возвращает срок блокировки после failures неудач подряд.
+func (p Policy) Delay(failures int) time.Duration {
+	if failures < p.Threshold {
+		return 0
+	}
+	delay := p.BaseDelay
+	for i := p.Threshold; i < failures && delay < p.MaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxDelay {
+		delay = p.MaxDelay
+	}
+	return delay
+}
+
+// Limiter реализует auth.LoginThrottler.
+type Limiter struct {
+	store   Store
+	account Policy
+	ip      Policy
+	now     func() time.Time
+}
+
+func NewLimiter(store Store, account, ip Policy) *Limiter {
+	return &Limiter{store: store, account: account, ip: ip, now: time.Now}
+}
+
+func accountKey(email string) string {
+	return "account:" + strings.ToLower(email)
+}
+
+func ipKey(ip string) string {
+	return "ip:" + ip
+}
+
+type limit struct {
+	key    string
+	policy Policy
+}
+
+func (l *Limiter) limits(email, ip string) []limit {
+	limits := []limit{{accountKey(email), l.account}}
+	if ip != "" {
+		limits = append(limits, limit{ipKey(ip), l.ip})
+	}
+	return limits
+}
+
+// Check возвращает, сколько еще действует блокировка аккаунта или IP; 0 — вход разрешен.
+func (l *Limiter) Check(ctx context.Context, email, ip string) (time.Duration, error) {
+	now := l.now()
+	var wait time.Duration
+	for _, lim := range l.limits(email, ip) {
+		record, err := l.store.Get(ctx, lim.key)
+		if err != nil {
+			return 0, err
+		}
+		if record != nil && record.LockedUntil.After(now) {
+			wait = max(wait, record.LockedUntil.Sub(now))
+		}
+	}
+	return wait, nil
+}
+
+// Fail учитывает неудачную попытку и возвращает срок наступившей блокировки, если она есть.
+func (l *Limiter) Fail(ctx context.Context, email, ip string) (time.Duration, error) {
+	now := l.now()
+	var wait time.Duration
+	for _, lim := range l.limits(email, ip) {
+		failures, err := l.store.Fail(ctx, lim.key, now, lim.policy.ResetAfter)
+		if err != nil {
+			return 0, err
+		}
+		delay := lim.policy.Delay(failures)
+		if delay == 0 {
+			continue
+		}
+		if err := l.store.Lock(ctx, lim.key, now.Add(delay)); err != nil {
+			return 0, err
+		}
+		wait = max(wait, delay)
+	}
+	return wait, nil
+}
+
+// Succeed сбрасывает счетчик аккаунта. Счетчик IP не сбрасывается: иначе, входя
+// в свой аккаунт, можно было бы бесконечно перебирать пароли чужих.
+func (l *Limiter) Succeed(ctx context.Context, email, ip string) error {
+	return l.store.Reset(ctx, accountKey(email))
+}
+
+// Unlock снимает блокировку аккаунта.
+func (l *Limiter) Unlock(ctx context.Context, email string) error {
+	return l.store.Reset(ctx, accountKey(email))
+}
+
+// RequestLimiter считает каждый запрос как неудачу и после порога блокирует
+// следующие. Реализует auth.ResetThrottler.
+type RequestLimiter struct {
+	store  Store
+	prefix string
+	policy Policy
+	now    func() time.Time
+}
+
+// NewRequestLimiter создает ограничитель; prefix отделяет его ключи от ключей
+// Limiter в том же хранилище.
+func NewRequestLimiter(store Store, prefix string, policy Policy) *RequestLimiter {
+	return &RequestLimiter{store: store, prefix: prefix, policy: policy, now: time.Now}
+}
+
+// Allow учитывает запрос по ключу. Пока действует блокировка, запрос не
+// учитывается и возвращается оставшееся время.
+func (l *RequestLimiter) Allow(ctx context.Context, key string) (time.Duration, error) {
+	now := l.now()
+	key = l.prefix + ":" + strings.ToLower(key)
+
+	record, err := l.store.Get(ctx, key)
+	if err != nil {
+		return 0, err
+	}
+	if record != nil && record.LockedUntil.After(now) {
+		return record.LockedUntil.Sub(now), nil
+	}
+
+	requests, err := l.store.Fail(ctx, key, now, l.policy.ResetAfter)
+	if err != nil {
+		return 0, err
+	}
+	if delay := l.policy.Delay(requests); delay > 0 {
+		if err := l.store.Lock(ctx, key, now.Add(delay)); err != nil {
+			return 0, err
+		}
+	}
+	return 0, nil
+}
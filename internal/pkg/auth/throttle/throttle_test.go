@@ -0,0 +1,191 @@
+package throttle
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicy_Delay(t *testing.T) {
+	policy := Policy{Threshold: 3, BaseDelay: time.Second, MaxDelay: 10 * time.Second}
+
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{0, 0},
+		{2, 0},
+		{3, time.Second},
+		{4, 2 * time.Second},
+		{6, 8 * time.Second},
+		{7, 10 * time.Second},
+		{100, 10 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%d failures", tt.failures), func(t *testing.T) {
+			assert.Equal(t, tt.want, policy.Delay(tt.failures))
+		})
+	}
+}
+
+// clock — управляемое время для Limiter.
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.now = c.now.Add(d)
+}
+
+func newTestLimiter() (*Limiter, *clock) {
+	c := &clock{now: time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)}
+	l := NewLimiter(NewMemoryStore(),
+		Policy{Threshold: 3, BaseDelay: time.Minute, MaxDelay: 10 * time.Minute, ResetAfter: time.Hour},
+		Policy{Threshold: 5, BaseDelay: time.Minute, MaxDelay: 10 * time.Minute, ResetAfter: time.Hour},
+	)
+	l.now = c.Now
+	return l, c
+}
+
+func TestLimiter_AccountLockout(t *testing.T) {
+	l, c := newTestLimiter()
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		wait, err := l.Fail(ctx, "ivan@corp.example", "10.0.0.1")
+		require.NoError(t, err)
+		assert.Zero(t, wait)
+	}
+
+	wait, err := l.Fail(ctx, "Ivan@Corp.example", "10.0.0.2")
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, wait, "email is case-insensitive")
+
+	wait, err = l.Check(ctx, "ivan@corp.example", "10.0.0.3")
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, wait)
+
+	wait, err = l.Check(ctx, "petr@corp.example", "10.0.0.1")
+	require.NoError(t, err)
+	assert.Zero(t, wait, "other accounts are not affected")
+
+	c.Advance(time.Minute)
+	wait, err = l.Check(ctx, "ivan@corp.example", "10.0.0.3")
+	require.NoError(t, err)
+	assert.Zero(t, wait, "lock expires")
+
+	wait, err = l.Fail(ctx, "ivan@corp.example", "10.0.0.3")
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Minute, wait, "backoff doubles")
+
+	require.NoError(t, l.Unlock(ctx, "ivan@corp.example"))
+	wait, err = l.Check(ctx, "ivan@corp.example", "10.0.0.3")
+	require.NoError(t, err)
+	assert.Zero(t, wait)
+}
+
+func TestLimiter_IPLockout(t *testing.T) {
+	l, _ := newTestLimiter()
+	ctx := context.Background()
+
+	var wait time.Duration
+	for i := 0; i < 5; i++ {
+		var err error
+		wait, err = l.Fail(ctx, fmt.Sprintf("user%d@corp.example", i), "10.0.0.1")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, time.Minute, wait)
+
+	wait, err := l.Check(ctx, "new@corp.example", "10.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, wait, "spraying passwords from one IP locks the IP")
+
+	require.NoError(t, l.Succeed(ctx, "new@corp.example", "10.0.0.1"))
+	wait, err = l.Check(ctx, "new@corp.example", "10.0.0.1")
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, wait, "a successful login does not reset the IP counter")
+}
+
+func TestLimiter_ResetAfter(t *testing.T) {
+	l, c := newTestLimiter()
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		_, err := l.Fail(ctx, "ivan@corp.example", "")
+		require.NoError(t, err)
+	}
+	c.Advance(2 * time.Hour)
+
+	wait, err := l.Fail(ctx, "ivan@corp.example", "")
+	require.NoError(t, err)
+	assert.Zero(t, wait, "old failures are forgotten")
+}
+
+func TestRequestLimiter_Allow(t *testing.T) {
+	c := &clock{now: time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)}
+	store := NewMemoryStore()
+	l := NewRequestLimiter(store, "reset", Policy{Threshold: 2, BaseDelay: time.Minute, MaxDelay: 10 * time.Minute, ResetAfter: time.Hour})
+	l.now = c.Now
+	ctx := context.Background()
+
+	for i := 0; i < 2; i++ {
+		wait, err := l.Allow(ctx, "ivan@corp.example")
+		require.NoError(t, err)
+		assert.Zero(t, wait, "requests below the limit pass")
+	}
+
+	wait, err := l.Allow(ctx, "Ivan@Corp.example")
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, wait, "key is case-insensitive")
+
+	wait, err = l.Allow(ctx, "petr@corp.example")
+	require.NoError(t, err)
+	assert.Zero(t, wait, "other keys are not affected")
+
+	c.Advance(time.Minute)
+	wait, err = l.Allow(ctx, "ivan@corp.example")
+	require.NoError(t, err)
+	assert.Zero(t, wait, "lock expires")
+
+	wait, err = l.Allow(ctx, "ivan@corp.example")
+	require.NoError(t, err)
+	assert.Equal(t, 2*time.Minute, wait, "backoff doubles")
+
+	record, err := store.Get(ctx, accountKey("ivan@corp.example"))
+	require.NoError(t, err)
+	assert.Nil(t, record, "login counters are separate")
+}
+
+func TestMemoryStore_Prune(t *testing.T) {
+	s := NewMemoryStore()
+	ctx := context.Background()
+	start := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	_, err := s.Fail(ctx, "old", start, time.Hour)
+	require.NoError(t, err)
+	_, err = s.Fail(ctx, "locked", start, time.Hour)
+	require.NoError(t, err)
+	require.NoError(t, s.Lock(ctx, "locked", start.Add(3*time.Hour)))
+
+	later := start.Add(2 * time.Hour)
+	for i := 0; i < pruneEvery; i++ {
+		_, err := s.Fail(ctx, "fresh", later, time.Hour)
+		require.NoError(t, err)
+	}
+
+	old, err := s.Get(ctx, "old")
+	require.NoError(t, err)
+	assert.Nil(t, old)
+	locked, err := s.Get(ctx, "locked")
+	require.NoError(t, err)
+	assert.NotNil(t, locked, "active locks survive pruning")
+}
@@ -0,0 +1,178 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/gofrs/uuid"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/internal/pkg/utils/log"
+	"github.com/totorialman/go-task-avito/models"
+)
+
+const (
+	// APIKeyScheme — начало каждого ключа, по нему ключ легко найти в логах и конфигах.
+	APIKeyScheme = "pvz"
+	// APIKeyTouchInterval — last_used_at обновляется не чаще, чтобы каждый запрос
+	// робота не был записью в базу.
+	APIKeyTouchInterval = time.Minute
+)
+
+// CreateAPIKey выпускает ключ вида pvz_<prefix>_<secret>. Полный ключ возвращается
+// только здесь, в базе хранится хеш секретной части. У каждого ключа есть автор:
+// без учетной записи ключ выпустить нельзя.
+func (uc *AuthUsecase) CreateAPIKey(ctx context.Context, actorID strfmt.UUID, name, role string, pvzIDs []strfmt.UUID, ttl time.Duration) (*models.APIKey, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	if err := auth.RequireActor(actorID); err != nil {
+		log.LogHandlerError(logger, err, http.StatusForbidden)
+		return nil, err
+	}
+
+	prefixBytes := make([]byte, 8)
+	if _, err := rand.Read(prefixBytes); err != nil {
+		log.LogHandlerError(logger, auth.ErrGeneratingToken, http.StatusInternalServerError)
+		return nil, auth.ErrGeneratingToken
+	}
+	secret, err := randomToken()
+	if err != nil {
+		log.LogHandlerError(logger, auth.ErrGeneratingToken, http.StatusInternalServerError)
+		return nil, auth.ErrGeneratingToken
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("ошибка генерации UUID: %w", err), http.StatusInternalServerError)
+		return nil, auth.ErrUUID
+	}
+
+	key := &auth.APIKey{
+		ID:         strfmt.UUID(id.String()),
+		Name:       name,
+		Prefix:     hex.EncodeToString(prefixBytes),
+		SecretHash: hashToken(secret),
+		Role:       role,
+		PVZIDs:     pvzIDs,
+		CreatedBy:  actorID,
+	}
+	if ttl > 0 {
+		expiresAt := time.Now().Add(ttl)
+		key.ExpiresAt = &expiresAt
+	}
+
+	if err := uc.authRepo.InsertAPIKey(ctx, key); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to insert api key: %w", err), http.StatusInternalServerError)
+		return nil, auth.ErrDBError
+	}
+
+	logger.Info("API key created", slog.String("key", key.ID.String()), slog.String("role", role),
+		slog.String("by", actorID.String()))
+	result := apiKeyModel(key)
+	result.Key = strings.Join([]string{APIKeyScheme, key.Prefix, secret}, "_")
+	return result, nil
+}
+
+func (uc *AuthUsecase) ListAPIKeys(ctx context.Context) ([]*models.APIKey, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	keys, err := uc.authRepo.ListAPIKeys(ctx)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to list api keys: %w", err), http.StatusInternalServerError)
+		return nil, auth.ErrDBError
+	}
+
+	result := make([]*models.APIKey, 0, len(keys))
+	for _, key := range keys {
+		result = append(result, apiKeyModel(key))
+	}
+	return result, nil
+}
+
+func (uc *AuthUsecase) RevokeAPIKey(ctx context.Context, keyID strfmt.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	err := uc.authRepo.RevokeAPIKey(ctx, keyID)
+	if errors.Is(err, auth.ErrAPIKeyNotFound) {
+		log.LogHandlerError(logger, err, http.StatusNotFound)
+		return err
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to revoke api key: %w", err), http.StatusInternalServerError)
+		return auth.ErrDBError
+	}
+
+	logger.Info("API key revoked", slog.String("key", keyID.String()))
+	return nil
+}
+
+// AuthenticateAPIKey проверяет ключ из X-API-Key и возвращает principal с ролью
+// и ПВЗ ключа. Отозванный, истекший и неизвестный ключ одинаково недействительны.
+func (uc *AuthUsecase) AuthenticateAPIKey(ctx context.Context, rawKey string) (*auth.Principal, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	parts := strings.SplitN(rawKey, "_", 3)
+	if len(parts) != 3 || parts[0] != APIKeyScheme || parts[1] == "" || parts[2] == "" {
+		log.LogHandlerError(logger, auth.ErrInvalidAPIKey, http.StatusUnauthorized)
+		return nil, auth.ErrInvalidAPIKey
+	}
+
+	key, err := uc.authRepo.GetAPIKeyByPrefix(ctx, parts[1])
+	if errors.Is(err, auth.ErrInvalidAPIKey) {
+		log.LogHandlerError(logger, err, http.StatusUnauthorized)
+		return nil, err
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get api key: %w", err), http.StatusInternalServerError)
+		return nil, auth.ErrDBError
+	}
+
+	now := time.Now()
+	if subtle.ConstantTimeCompare([]byte(hashToken(parts[2])), []byte(key.SecretHash)) != 1 ||
+		key.RevokedAt != nil || (key.ExpiresAt != nil && now.After(*key.ExpiresAt)) {
+		log.LogHandlerError(logger, fmt.Errorf("%w: %s", auth.ErrInvalidAPIKey, key.ID), http.StatusUnauthorized)
+		return nil, auth.ErrInvalidAPIKey
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= APIKeyTouchInterval {
+		if err := uc.authRepo.TouchAPIKey(ctx, key.ID, now); err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to update api key usage: %w", err), http.StatusInternalServerError)
+		}
+	}
+
+	return &auth.Principal{Role: key.Role, APIKeyID: key.ID, PVZIDs: key.PVZIDs}, nil
+}
+
+func apiKeyModel(key *auth.APIKey) *models.APIKey {
+	name, prefix, role := key.Name, key.Prefix, key.Role
+	result := &models.APIKey{
+		ID:        key.ID,
+		Name:      &name,
+		Prefix:    &prefix,
+		Role:      &role,
+		PvzIds:    key.PVZIDs,
+		CreatedAt: strfmt.DateTime(key.CreatedAt),
+	}
+	if result.PvzIds == nil {
+		result.PvzIds = []strfmt.UUID{}
+	}
+	result.ExpiresAt = dateTimePtr(key.ExpiresAt)
+	result.LastUsedAt = dateTimePtr(key.LastUsedAt)
+	result.RevokedAt = dateTimePtr(key.RevokedAt)
+	return result
+}
+
+func dateTimePtr(t *time.Time) *strfmt.DateTime {
+	if t == nil {
+		return nil
+	}
+	dt := strfmt.DateTime(*t)
+	return &dt
+}
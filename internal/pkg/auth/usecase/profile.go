@@ -0,0 +1,263 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/gofrs/uuid"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/internal/pkg/utils/log"
+	"github.com/totorialman/go-task-avito/models"
+)
+
+// GetMe возвращает профиль текущего пользователя с назначениями на ПВЗ. У запросов
+// по API-ключу и тестовому токену пользователя нет — auth.ErrUserNotFound.
+func (uc *AuthUsecase) GetMe(ctx context.Context) (*models.User, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok || principal.UserID == "" {
+		log.LogHandlerError(logger, auth.ErrUserNotFound, http.StatusForbidden)
+		return nil, auth.ErrUserNotFound
+	}
+
+	user, err := uc.authRepo.GetProfile(ctx, principal.UserID)
+	if errors.Is(err, auth.ErrUserNotFound) {
+		log.LogHandlerError(logger, err, http.StatusForbidden)
+		return nil, err
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get profile: %w", err), http.StatusInternalServerError)
+		return nil, auth.ErrDBError
+	}
+
+	assignments, err := uc.authRepo.ListPVZAssignments(ctx, principal.UserID)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to list pvz assignments: %w", err), http.StatusInternalServerError)
+		return nil, auth.ErrDBError
+	}
+	user.PvzAssignments = assignments
+
+	return user, nil
+}
+
+// UpdateMe меняет пароль и запрашивает смену email текущего пользователя и
+// возвращает профиль после изменений. Любое изменение требует текущий пароль:
+// по украденному access-токену нельзя увести email, а затем и аккаунт через сброс
+// пароля. Оба изменения проверяются до того, как применяется хотя бы одно.
+func (uc *AuthUsecase) UpdateMe(ctx context.Context, patch *models.MePatch) (*models.User, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok || principal.UserID == "" {
+		log.LogHandlerError(logger, auth.ErrUserNotFound, http.StatusForbidden)
+		return nil, auth.ErrUserNotFound
+	}
+
+	currentHash, err := uc.authRepo.GetPasswordHash(ctx, principal.UserID)
+	if errors.Is(err, auth.ErrUserNotFound) {
+		log.LogHandlerError(logger, err, http.StatusForbidden)
+		return nil, err
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get password hash: %w", err), http.StatusInternalServerError)
+		return nil, auth.ErrDBError
+	}
+	if ok, _, _ := uc.hasher.Verify(currentHash, patch.OldPassword); !ok {
+		log.LogHandlerError(logger, auth.ErrInvalidPassword, http.StatusUnauthorized)
+		return nil, auth.ErrInvalidPassword
+	}
+
+	var hashedPassword string
+	if patch.NewPassword != "" {
+		if patch.OldPassword == patch.NewPassword {
+			log.LogHandlerError(logger, auth.ErrSamePassword, http.StatusBadRequest)
+			return nil, auth.ErrSamePassword
+		}
+		hashedPassword, err = uc.hashNewPassword(patch.NewPassword, principal.Email)
+		if err != nil {
+			log.LogHandlerError(logger, err, http.StatusBadRequest)
+			return nil, err
+		}
+	}
+
+	var change *auth.EmailChange
+	var token string
+	if patch.Email != nil {
+		change, token, err = uc.prepareEmailChange(ctx, principal.UserID, string(*patch.Email))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Письмо отправляется раньше смены пароля: если оно не ушло, не меняется ничего
+	if change != nil {
+		if err := uc.sendEmailChange(ctx, change, token); err != nil {
+			return nil, err
+		}
+	}
+	if hashedPassword != "" {
+		if err := uc.authRepo.UpdatePasswordHash(ctx, principal.UserID, hashedPassword); err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to update password hash: %w", err), http.StatusInternalServerError)
+			return nil, auth.ErrDBError
+		}
+		if err := uc.authRepo.RevokeOtherSessions(ctx, principal.UserID, principal.SessionID); err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to revoke other sessions: %w", err), http.StatusInternalServerError)
+			return nil, auth.ErrDBError
+		}
+		logger.Info("Password changed", slog.String("user", principal.UserID.String()))
+	}
+
+	return uc.GetMe(ctx)
+}
+
+// prepareEmailChange проверяет, что на newEmail можно перейти, и готовит запрос на
+// смену вместе с токеном для письма. Ограничение частоты проверяется последним,
+// чтобы отклоненные запросы его не расходовали.
+func (uc *AuthUsecase) prepareEmailChange(ctx context.Context, userID strfmt.UUID, newEmail string) (*auth.EmailChange, string, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	if uc.emailMailer == nil {
+		log.LogHandlerError(logger, auth.ErrEmailChangeDisabled, http.StatusBadRequest)
+		return nil, "", auth.ErrEmailChangeDisabled
+	}
+
+	user, err := uc.authRepo.GetUser(ctx, userID)
+	if errors.Is(err, auth.ErrUserNotFound) {
+		log.LogHandlerError(logger, err, http.StatusForbidden)
+		return nil, "", err
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get user: %w", err), http.StatusInternalServerError)
+		return nil, "", auth.ErrDBError
+	}
+	if strings.EqualFold(user.Email.String(), newEmail) {
+		log.LogHandlerError(logger, auth.ErrSameEmail, http.StatusBadRequest)
+		return nil, "", auth.ErrSameEmail
+	}
+
+	_, err = uc.authRepo.GetUserCredsByEmail(ctx, newEmail)
+	if err == nil {
+		log.LogHandlerError(logger, auth.ErrEmailTaken, http.StatusConflict)
+		return nil, "", auth.ErrEmailTaken
+	}
+	if !errors.Is(err, auth.ErrUserNotFound) {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get user credentials: %w", err), http.StatusInternalServerError)
+		return nil, "", auth.ErrDBError
+	}
+
+	token, err := randomToken()
+	if err != nil {
+		log.LogHandlerError(logger, auth.ErrGeneratingToken, http.StatusInternalServerError)
+		return nil, "", auth.ErrGeneratingToken
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("ошибка генерации UUID: %w", err), http.StatusInternalServerError)
+		return nil, "", auth.ErrUUID
+	}
+
+	if uc.emailThrottle != nil {
+		wait, err := uc.emailThrottle.Allow(ctx, userID.String())
+		if err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to check email change requests: %w", err), http.StatusInternalServerError)
+			return nil, "", auth.ErrDBError
+		}
+		if wait > 0 {
+			err := &auth.LockoutError{RetryAfter: wait, Err: auth.ErrTooManyEmailChanges}
+			log.LogHandlerError(logger, err, http.StatusTooManyRequests)
+			return nil, "", err
+		}
+	}
+
+	change := &auth.EmailChange{
+		ID:        strfmt.UUID(id.String()),
+		UserID:    userID,
+		Email:     user.Email.String(),
+		NewEmail:  newEmail,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(EmailChangeTTL),
+	}
+	return change, token, nil
+}
+
+// sendEmailChange сохраняет запрос на смену и отправляет на новый email ссылку для
+// подтверждения. Email пользователя меняется только в ConfirmEmail.
+func (uc *AuthUsecase) sendEmailChange(ctx context.Context, change *auth.EmailChange, token string) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	if err := uc.authRepo.InsertEmailChange(ctx, change); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to insert email change: %w", err), http.StatusInternalServerError)
+		return auth.ErrDBError
+	}
+
+	msg := &auth.MailMessage{
+		To:      change.NewEmail,
+		Subject: "Подтверждение email",
+		Body: fmt.Sprintf("Здравствуйте!\n\nЧтобы входить с этим адресом, подтвердите его по ссылке:\n%s\n\n"+
+			"Ссылка действует %d ч. и работает один раз. Если вы не меняли email, просто проигнорируйте это письмо.\n",
+			resetLink(uc.emailVerifyURL, token), int(EmailChangeTTL.Hours())),
+	}
+	if err := uc.emailMailer.Send(ctx, msg); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to send email confirmation: %w", err), http.StatusInternalServerError)
+		return auth.ErrSendingMail
+	}
+
+	logger.Info("Email change requested", slog.String("user", change.UserID.String()))
+	return nil
+}
+
+// ConfirmEmail ставит пользователю новый email по токену из письма и уведомляет
+// прежний адрес. Сессии не отзываются: новый email попадет в токены при их
+// следующем обновлении.
+func (uc *AuthUsecase) ConfirmEmail(ctx context.Context, token string) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	change, err := uc.authRepo.GetEmailChange(ctx, hashToken(token))
+	if errors.Is(err, auth.ErrInvalidEmailToken) {
+		log.LogHandlerError(logger, err, http.StatusBadRequest)
+		return err
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get email change: %w", err), http.StatusInternalServerError)
+		return auth.ErrDBError
+	}
+	if change.UsedAt != nil || time.Now().After(change.ExpiresAt) {
+		log.LogHandlerError(logger, auth.ErrInvalidEmailToken, http.StatusBadRequest)
+		return auth.ErrInvalidEmailToken
+	}
+
+	err = uc.authRepo.CompleteEmailChange(ctx, change.ID)
+	switch {
+	case errors.Is(err, auth.ErrInvalidEmailToken):
+		log.LogHandlerError(logger, err, http.StatusBadRequest)
+		return err
+	case errors.Is(err, auth.ErrEmailTaken):
+		log.LogHandlerError(logger, err, http.StatusConflict)
+		return err
+	case err != nil:
+		log.LogHandlerError(logger, fmt.Errorf("failed to complete email change: %w", err), http.StatusInternalServerError)
+		return auth.ErrDBError
+	}
+
+	if uc.emailMailer != nil {
+		msg := &auth.MailMessage{
+			To:      change.Email,
+			Subject: "Email изменен",
+			Body: fmt.Sprintf("Здравствуйте!\n\nEmail вашей учетной записи изменен на %s.\n"+
+				"Если это сделали не вы, срочно обратитесь к модератору.\n", change.NewEmail),
+		}
+		if err := uc.emailMailer.Send(ctx, msg); err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to send email change notice: %w", err), http.StatusInternalServerError)
+		}
+	}
+
+	logger.Info("Email changed", slog.String("user", change.UserID.String()))
+	return nil
+}
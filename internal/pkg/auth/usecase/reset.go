@@ -0,0 +1,153 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/gofrs/uuid"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/internal/pkg/utils/log"
+)
+
+// ForgotPassword отправляет письмо со ссылкой для сброса пароля. Чтобы по ответу
+// нельзя было узнать, зарегистрирован ли email, для неизвестных, заблокированных
+// и SSO-пользователей (у них нет пароля) и при ошибке отправки возвращается nil.
+func (uc *AuthUsecase) ForgotPassword(ctx context.Context, email string) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	if uc.mailer == nil {
+		log.LogHandlerError(logger, auth.ErrPasswordResetDisabled, http.StatusNotFound)
+		return auth.ErrPasswordResetDisabled
+	}
+
+	if uc.resetThrottle != nil {
+		wait, err := uc.resetThrottle.Allow(ctx, email)
+		if err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to check reset requests: %w", err), http.StatusInternalServerError)
+			return auth.ErrDBError
+		}
+		if wait > 0 {
+			err := &auth.LockoutError{RetryAfter: wait, Err: auth.ErrTooManyResetRequests}
+			log.LogHandlerError(logger, err, http.StatusTooManyRequests)
+			return err
+		}
+	}
+
+	creds, err := uc.authRepo.GetUserCredsByEmail(ctx, email)
+	if errors.Is(err, auth.ErrUserNotFound) {
+		logger.Info("Password reset for unknown email", slog.String("email", email))
+		return nil
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get user credentials: %w", err), http.StatusInternalServerError)
+		return auth.ErrDBError
+	}
+	if creds.Disabled || creds.PasswordHash == "" {
+		logger.Info("Password reset is not available for user", slog.String("user", creds.ID.String()))
+		return nil
+	}
+
+	token, err := randomToken()
+	if err != nil {
+		log.LogHandlerError(logger, auth.ErrGeneratingToken, http.StatusInternalServerError)
+		return auth.ErrGeneratingToken
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("ошибка генерации UUID: %w", err), http.StatusInternalServerError)
+		return auth.ErrUUID
+	}
+
+	reset := &auth.PasswordReset{
+		ID:        strfmt.UUID(id.String()),
+		UserID:    creds.ID,
+		Email:     email,
+		TokenHash: hashToken(token),
+		ExpiresAt: time.Now().Add(PasswordResetTTL),
+	}
+	if err := uc.authRepo.InsertPasswordReset(ctx, reset); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to insert password reset: %w", err), http.StatusInternalServerError)
+		return auth.ErrDBError
+	}
+
+	msg := &auth.MailMessage{
+		To:      email,
+		Subject: "Сброс пароля",
+		Body: fmt.Sprintf("Здравствуйте!\n\nЧтобы задать новый пароль, перейдите по ссылке:\n%s\n\n"+
+			"Ссылка действует %d мин. и работает один раз. Если вы не запрашивали сброс пароля, просто проигнорируйте это письмо.\n",
+			resetLink(uc.resetURL, token), int(PasswordResetTTL.Minutes())),
+	}
+	if err := uc.mailer.Send(ctx, msg); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to send reset mail: %w", err), http.StatusInternalServerError)
+		return nil
+	}
+
+	logger.Info("Password reset mail sent", slog.String("user", creds.ID.String()))
+	return nil
+}
+
+// resetLink добавляет токен к адресу страницы сброса пароля.
+func resetLink(base, token string) string {
+	sep := "?"
+	if strings.Contains(base, "?") {
+		sep = "&"
+	}
+	return base + sep + "token=" + url.QueryEscape(token)
+}
+
+// ResetPassword ставит новый пароль по токену из письма. Все сессии пользователя
+// отзываются, а блокировка входа после неудачных попыток снимается: владелец
+// почты подтвердил, что это его аккаунт.
+func (uc *AuthUsecase) ResetPassword(ctx context.Context, token, newPassword string) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	reset, err := uc.authRepo.GetPasswordReset(ctx, hashToken(token))
+	if errors.Is(err, auth.ErrInvalidResetToken) {
+		log.LogHandlerError(logger, err, http.StatusBadRequest)
+		return err
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get password reset: %w", err), http.StatusInternalServerError)
+		return auth.ErrDBError
+	}
+	if reset.UsedAt != nil || time.Now().After(reset.ExpiresAt) {
+		log.LogHandlerError(logger, auth.ErrInvalidResetToken, http.StatusBadRequest)
+		return auth.ErrInvalidResetToken
+	}
+
+	hashedPassword, err := uc.hashNewPassword(newPassword, reset.Email)
+	if err != nil {
+		log.LogHandlerError(logger, err, http.StatusBadRequest)
+		return err
+	}
+
+	err = uc.authRepo.CompletePasswordReset(ctx, reset.ID, hashedPassword)
+	if errors.Is(err, auth.ErrInvalidResetToken) {
+		log.LogHandlerError(logger, err, http.StatusBadRequest)
+		return err
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to complete password reset: %w", err), http.StatusInternalServerError)
+		return auth.ErrDBError
+	}
+
+	if err := uc.authRepo.RevokeUserSessions(ctx, reset.UserID); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to revoke sessions: %w", err), http.StatusInternalServerError)
+		return auth.ErrDBError
+	}
+	if uc.throttle != nil {
+		if err := uc.throttle.Unlock(ctx, reset.Email); err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to reset login attempts: %w", err), http.StatusInternalServerError)
+		}
+	}
+
+	logger.Info("Password reset", slog.String("user", reset.UserID.String()))
+	return nil
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/internal/pkg/utils/log"
+	"github.com/totorialman/go-task-avito/models"
+)
+
+// Ограничения длины совпадают с размерами колонок таблицы sessions.
+const (
+	maxDeviceLabelLen = 100
+	maxUserAgentLen   = 512
+	maxIPLen          = 64
+)
+
+// ListSessions возвращает активные сессии текущего пользователя, начиная с последней
+// использованной. Сессия, которой не пользовались дольше срока жизни refresh-токена,
+// уже не может быть продолжена и в список не попадает.
+func (uc *AuthUsecase) ListSessions(ctx context.Context) ([]*models.Session, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok || principal.UserID == "" {
+		log.LogHandlerError(logger, auth.ErrUserNotFound, http.StatusForbidden)
+		return nil, auth.ErrUserNotFound
+	}
+
+	sessions, err := uc.authRepo.ListSessions(ctx, principal.UserID, time.Now().Add(-RefreshTokenTTL))
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to list sessions: %w", err), http.StatusInternalServerError)
+		return nil, auth.ErrDBError
+	}
+
+	result := make([]*models.Session, 0, len(sessions))
+	for _, session := range sessions {
+		result = append(result, sessionModel(session, principal.SessionID))
+	}
+	return result, nil
+}
+
+// RevokeSession завершает сессию текущего пользователя. Ее access-токены перестают
+// приниматься сразу, refresh-токены — обмениваться.
+func (uc *AuthUsecase) RevokeSession(ctx context.Context, sessionID strfmt.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok || principal.UserID == "" {
+		log.LogHandlerError(logger, auth.ErrUserNotFound, http.StatusForbidden)
+		return auth.ErrUserNotFound
+	}
+
+	err := uc.authRepo.RevokeSession(ctx, principal.UserID, sessionID)
+	if errors.Is(err, auth.ErrSessionNotFound) {
+		log.LogHandlerError(logger, err, http.StatusNotFound)
+		return err
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to revoke session: %w", err), http.StatusInternalServerError)
+		return auth.ErrDBError
+	}
+
+	logger.Info("Session revoked", slog.String("user", principal.UserID.String()), slog.String("session", sessionID.String()))
+	return nil
+}
+
+// RevokeUserSessions завершает все сессии пользователя, например когда терминал
+// с открытой сессией потерян.
+func (uc *AuthUsecase) RevokeUserSessions(ctx context.Context, actorID, userID strfmt.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	if _, err := uc.GetUser(ctx, userID); err != nil {
+		return err
+	}
+
+	if err := uc.authRepo.RevokeUserSessions(ctx, userID); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to revoke user sessions: %w", err), http.StatusInternalServerError)
+		return auth.ErrDBError
+	}
+
+	logger.Info("User sessions revoked", slog.String("user", userID.String()), slog.String("by", actorID.String()))
+	return nil
+}
+
+// deviceRecord обрезает сведения об устройстве, которые присылает клиент, до
+// размеров колонок.
+func deviceRecord(device auth.Device) auth.Device {
+	return auth.Device{
+		Label:     truncate(device.Label, maxDeviceLabelLen),
+		UserAgent: truncate(device.UserAgent, maxUserAgentLen),
+		IP:        truncate(device.IP, maxIPLen),
+	}
+}
+
+func truncate(s string, limit int) string {
+	runes := []rune(s)
+	if len(runes) <= limit {
+		return s
+	}
+	return string(runes[:limit])
+}
+
+func sessionModel(session *auth.Session, currentID string) *models.Session {
+	createdAt := strfmt.DateTime(session.CreatedAt)
+	lastSeenAt := strfmt.DateTime(session.LastSeenAt)
+	return &models.Session{
+		ID:          &session.ID,
+		DeviceLabel: session.Device.Label,
+		UserAgent:   session.Device.UserAgent,
+		IP:          session.Device.IP,
+		CreatedAt:   &createdAt,
+		LastSeenAt:  &lastSeenAt,
+		Current:     swag.Bool(session.ID.String() == currentID),
+	}
+}
@@ -3,70 +3,229 @@ package usecase
 import (
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
-	"os"
 	"time"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 	"github.com/gofrs/uuid"
 	"github.com/golang-jwt/jwt"
 	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth/password"
 	"github.com/totorialman/go-task-avito/internal/pkg/utils/log"
 	"github.com/totorialman/go-task-avito/models"
-	"golang.org/x/crypto/argon2"
 )
 
-func HashPassword(salt []byte, plainPassword string) string {
-	hashedPass := argon2.IDKey([]byte(plainPassword), salt, 1, 64*1024, 4, 32)
-	return hex.EncodeToString(append(salt, hashedPass...))
+type AuthUsecase struct {
+	authRepo auth.AuthRepo
+	signer   auth.TokenSigner
+	idp      auth.IdentityProvider
+	roles    auth.RoleMapping
+
+	registration   auth.RegistrationPolicy
+	throttle       auth.LoginThrottler
+	hasher         *password.Hasher
+	passwordPolicy password.Policy
+
+	mailer        auth.Mailer
+	resetURL      string
+	resetThrottle auth.ResetThrottler
+
+	emailMailer    auth.Mailer
+	emailVerifyURL string
+	emailThrottle  auth.ResetThrottler
+}
+
+func NewAuthUsecase(authRepo auth.AuthRepo, signer auth.TokenSigner) *AuthUsecase {
+	return &AuthUsecase{
+		authRepo:       authRepo,
+		signer:         signer,
+		hasher:         password.NewHasher(password.DefaultParams),
+		passwordPolicy: password.DefaultPolicy,
+	}
+}
+
+// WithPasswordHashing меняет параметры argon2id для новых хешей. Хеши со старыми
+// параметрами пересчитываются при следующем входе пользователя.
+func (uc *AuthUsecase) WithPasswordHashing(params password.Params) *AuthUsecase {
+	uc.hasher = password.NewHasher(params)
+	return uc
+}
+
+// WithPasswordPolicy задает требования к паролям при регистрации и смене пароля.
+func (uc *AuthUsecase) WithPasswordPolicy(policy password.Policy) *AuthUsecase {
+	uc.passwordPolicy = policy
+	return uc
+}
+
+// WithPasswordReset включает восстановление пароля по email. В письме приходит
+// resetURL с параметром token; throttle ограничивает число писем на один email
+// и может быть nil.
+func (uc *AuthUsecase) WithPasswordReset(mailer auth.Mailer, resetURL string, throttle auth.ResetThrottler) *AuthUsecase {
+	uc.mailer = mailer
+	uc.resetURL = resetURL
+	uc.resetThrottle = throttle
+	return uc
+}
+
+// WithEmailVerification включает смену email через PATCH /me. На новый адрес
+// приходит verifyURL с параметром token; throttle ограничивает число писем на
+// одного пользователя и может быть nil.
+func (uc *AuthUsecase) WithEmailVerification(mailer auth.Mailer, verifyURL string, throttle auth.ResetThrottler) *AuthUsecase {
+	uc.emailMailer = mailer
+	uc.emailVerifyURL = verifyURL
+	uc.emailThrottle = throttle
+	return uc
+}
+
+// WithIdentityProvider включает вход через внешнего провайдера; roles определяет,
+// какие группы провайдера дают роли employee и moderator.
+func (uc *AuthUsecase) WithIdentityProvider(idp auth.IdentityProvider, roles auth.RoleMapping) *AuthUsecase {
+	uc.idp = idp
+	uc.roles = roles
+	return uc
 }
 
-func checkPassword(passHash string, plainPassword string) bool {
-	passHashBytes, err := hex.DecodeString(passHash)
+// WithLoginThrottler включает защиту входа по паролю от перебора.
+func (uc *AuthUsecase) WithLoginThrottler(throttle auth.LoginThrottler) *AuthUsecase {
+	uc.throttle = throttle
+	return uc
+}
+
+// WithRegistrationPolicy ограничивает самостоятельную регистрацию сотрудников.
+func (uc *AuthUsecase) WithRegistrationPolicy(policy auth.RegistrationPolicy) *AuthUsecase {
+	uc.registration = policy
+	return uc
+}
+
+const (
+	AccessTokenTTL  = 15 * time.Minute
+	RefreshTokenTTL = 30 * 24 * time.Hour
+	// DummyTokenTTL оставлен прежним: тестовому токену не выдается refresh-токен.
+	DummyTokenTTL = 24 * time.Hour
+	// DefaultInviteTTL — срок действия приглашения, если модератор его не указал.
+	DefaultInviteTTL = 72 * time.Hour
+	// PasswordResetTTL — срок действия ссылки для сброса пароля.
+	PasswordResetTTL = time.Hour
+	// EmailChangeTTL — срок действия ссылки для подтверждения нового email.
+	EmailChangeTTL = 24 * time.Hour
+)
+
+// generateToken подписывает access-токен для principal. Каждый токен получает свой jti;
+// sid — семейство refresh-токенов, по нему middleware проверяет, не отозвана ли сессия.
+// У тестовых токенов нет ни пользователя, ни сессии, поэтому sub, email и sid не пишутся.
+func generateToken(signer auth.TokenSigner, principal auth.Principal, ttl time.Duration) (string, error) {
+	jti, err := uuid.NewV4()
 	if err != nil {
-		return false
+		return "", auth.ErrGeneratingToken
 	}
 
-	salt := make([]byte, 8)
-	copy(salt, passHashBytes[:8])
+	now := time.Now()
+	claims := jwt.MapClaims{
+		"role": principal.Role,
+		"jti":  jti.String(),
+		"iat":  now.Unix(),
+		"exp":  now.Add(ttl).Unix(),
+	}
+	if principal.UserID != "" {
+		claims["sub"] = principal.UserID.String()
+	}
+	if principal.Email != "" {
+		claims["email"] = principal.Email
+	}
+	if principal.SessionID != "" {
+		claims["sid"] = principal.SessionID
+	}
 
-	userPassHash := HashPassword(salt, plainPassword)
+	return signer.Sign(claims)
+}
 
-	return userPassHash == passHash
+// hashToken — в БД refresh-токены и коды приглашений хранятся только хешами.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
 }
 
-type AuthUsecase struct {
-	authRepo auth.AuthRepo
+// randomToken возвращает 32 случайных байта в base64url: refresh-токены, state, nonce и PKCE verifier.
+func randomToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
 }
 
-func NewAuthUsecase(authRepo auth.AuthRepo) *AuthUsecase {
-	return &AuthUsecase{
-		authRepo: authRepo,
+// issueTokens выпускает access-токен и очередной refresh-токен семейства principal.SessionID.
+func (uc *AuthUsecase) issueTokens(ctx context.Context, principal auth.Principal) (*auth.Tokens, error) {
+	now := time.Now()
+
+	access, err := generateToken(uc.signer, principal, AccessTokenTTL)
+	if err != nil {
+		return nil, auth.ErrGeneratingToken
 	}
+
+	refresh, err := randomToken()
+	if err != nil {
+		return nil, auth.ErrGeneratingToken
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, auth.ErrUUID
+	}
+
+	record := &auth.RefreshToken{
+		ID:        strfmt.UUID(id.String()),
+		FamilyID:  strfmt.UUID(principal.SessionID),
+		UserID:    principal.UserID,
+		Email:     principal.Email,
+		Role:      principal.Role,
+		TokenHash: hashToken(refresh),
+		ExpiresAt: now.Add(RefreshTokenTTL),
+	}
+	if err := uc.authRepo.InsertRefreshToken(ctx, record); err != nil {
+		return nil, auth.ErrGeneratingToken
+	}
+
+	return &auth.Tokens{
+		Access:           access,
+		AccessExpiresAt:  now.Add(AccessTokenTTL),
+		Refresh:          refresh,
+		RefreshExpiresAt: record.ExpiresAt,
+	}, nil
 }
 
-func generateToken(role string) (string, error) {
-	secret := os.Getenv("JWT_SECRET")
-	if secret == "" {
-		return "", auth.ErrGeneratingToken
+// startSession открывает сессию на устройстве из контекста запроса и выпускает
+// первые токены ее семейства.
+func (uc *AuthUsecase) startSession(ctx context.Context, principal auth.Principal) (*auth.Tokens, error) {
+	familyID, err := uuid.NewV4()
+	if err != nil {
+		return nil, auth.ErrUUID
 	}
 
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
-		"role": role,
-		"exp":  time.Now().Add(24 * time.Hour).Unix(),
-	})
+	session := &auth.Session{
+		ID:     strfmt.UUID(familyID.String()),
+		UserID: principal.UserID,
+		Device: deviceRecord(auth.DeviceFromContext(ctx)),
+	}
+	if err := uc.authRepo.InsertSession(ctx, session); err != nil {
+		return nil, auth.ErrGeneratingToken
+	}
 
-	return token.SignedString([]byte(secret))
+	principal.SessionID = session.ID.String()
+	return uc.issueTokens(ctx, principal)
 }
 
 func (u *AuthUsecase) GenerateDummyToken(ctx context.Context, role string) (string, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
 
-	token, err := generateToken(role)
+	token, err := generateToken(u.signer, auth.Principal{Role: role}, DummyTokenTTL)
 	if err != nil {
 		log.LogHandlerError(logger, auth.ErrGeneratingToken, http.StatusInternalServerError)
 		return "", auth.ErrGeneratingToken
@@ -75,24 +234,59 @@ func (u *AuthUsecase) GenerateDummyToken(ctx context.Context, role string) (stri
 	return token, nil
 }
 
-func (uc *AuthUsecase) Login(ctx context.Context, email, password string) (*models.User, string, error) {
+// Login проверяет пароль. Пока вход заблокирован после неудачных попыток, пароль
+// даже не проверяется, чтобы перебор не нагружал argon2.
+func (uc *AuthUsecase) Login(ctx context.Context, email, plainPassword, clientIP string) (*models.User, *auth.Tokens, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
 
-	userID, role, passwordHash, err := uc.authRepo.GetUserCredsByEmail(ctx, email)
+	if uc.throttle != nil {
+		wait, err := uc.throttle.Check(ctx, email, clientIP)
+		if err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to check login attempts: %w", err), http.StatusInternalServerError)
+			return nil, nil, auth.ErrDBError
+		}
+		if wait > 0 {
+			err := &auth.LockoutError{RetryAfter: wait}
+			log.LogHandlerError(logger, err, http.StatusTooManyRequests)
+			return nil, nil, err
+		}
+	}
+
+	creds, err := uc.authRepo.GetUserCredsByEmail(ctx, email)
 	if err != nil {
 		log.LogHandlerError(logger, auth.ErrInvalidLogin, http.StatusUnauthorized)
-		return nil, "", auth.ErrInvalidLogin
+		return nil, nil, uc.loginFailed(ctx, email, clientIP, auth.ErrInvalidLogin)
 	}
 
-	if !checkPassword(passwordHash, password) {
+	ok, needsRehash, err := uc.hasher.Verify(creds.PasswordHash, plainPassword)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to verify password hash: %w", err), http.StatusUnauthorized)
+	}
+	if !ok {
 		log.LogHandlerError(logger, auth.ErrInvalidPassword, http.StatusUnauthorized)
-		return nil, "", auth.ErrInvalidPassword
+		return nil, nil, uc.loginFailed(ctx, email, clientIP, auth.ErrInvalidPassword)
+	}
+	if needsRehash {
+		uc.rehashPassword(ctx, creds.ID, plainPassword)
 	}
 
-	token, err := generateToken(role)
+	if uc.throttle != nil {
+		if err := uc.throttle.Succeed(ctx, email, clientIP); err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to reset login attempts: %w", err), http.StatusInternalServerError)
+		}
+	}
+
+	// Блокировку проверяем после пароля, чтобы не раскрывать ее по одному email.
+	if creds.Disabled {
+		log.LogHandlerError(logger, auth.ErrUserDisabled, http.StatusUnauthorized)
+		return nil, nil, auth.ErrUserDisabled
+	}
+
+	role := creds.Role
+	tokens, err := uc.startSession(ctx, auth.Principal{UserID: creds.ID, Email: email, Role: role})
 	if err != nil {
-		log.LogHandlerError(logger, auth.ErrGeneratingToken, http.StatusInternalServerError)
-		return nil, "", auth.ErrGeneratingToken
+		log.LogHandlerError(logger, err, http.StatusInternalServerError)
+		return nil, nil, err
 	}
 
 	var emailFmt strfmt.Email
@@ -100,33 +294,174 @@ func (uc *AuthUsecase) Login(ctx context.Context, email, password string) (*mode
 
 	user := &models.User{
 		Email: &emailFmt,
-		ID:    strfmt.UUID(userID.String()),
+		ID:    creds.ID,
 		Role:  &role,
 	}
 
-	return user, token, nil
+	return user, tokens, nil
+}
+
+// loginFailed учитывает неудачную попытку. Если она включила блокировку,
+// клиент сразу получает LockoutError вместо cause.
+func (uc *AuthUsecase) loginFailed(ctx context.Context, email, clientIP string, cause error) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	if uc.throttle == nil {
+		return cause
+	}
+	wait, err := uc.throttle.Fail(ctx, email, clientIP)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to record login attempt: %w", err), http.StatusInternalServerError)
+		return cause
+	}
+	if wait > 0 {
+		logger.Warn("Login locked", slog.String("email", email), slog.String("ip", clientIP), slog.Duration("for", wait))
+		return &auth.LockoutError{RetryAfter: wait}
+	}
+	return cause
 }
 
 var (
 	ErrGeneratingSalt = errors.New("ошибка генерации соли")
 )
 
-func (uc *AuthUsecase) SignUp(ctx context.Context, email string, password string, role string) (*models.User, string, error) {
+// SignUp регистрирует пользователя. Сотрудник может зарегистрироваться сам, если его
+// email разрешен политикой регистрации; модератора создает только другой модератор
+// или приглашение. Пользователю, которого создал модератор, сессия не открывается,
+// чтобы не подменить сессию самого модератора.
+func (uc *AuthUsecase) SignUp(ctx context.Context, email, plainPassword, role, inviteCode string) (*models.User, *auth.Tokens, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	actor, ok := auth.PrincipalFromContext(ctx)
+	// У тестовых токенов /dummyLogin нет пользователя, модератором они не считаются.
+	byModerator := ok && actor.Role == models.UserRoleModerator && actor.UserID != ""
+
+	switch {
+	case inviteCode != "", byModerator:
+	case role == models.UserRoleModerator:
+		log.LogHandlerError(logger, auth.ErrModeratorSignUp, http.StatusForbidden)
+		return nil, nil, auth.ErrModeratorSignUp
+	case !uc.registration.AllowsEmail(email):
+		log.LogHandlerError(logger, auth.ErrEmailDomainNotAllowed, http.StatusForbidden)
+		return nil, nil, auth.ErrEmailDomainNotAllowed
+	}
+
+	var newUser *models.User
+	var err error
+	if inviteCode != "" {
+		newUser, err = uc.createInvitedUser(ctx, email, plainPassword, role, inviteCode)
+	} else {
+		newUser, err = uc.createUser(ctx, email, plainPassword, role)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if byModerator {
+		logger.Info("User created by moderator", slog.String("user", newUser.ID.String()),
+			slog.String("role", *newUser.Role), slog.String("by", actor.UserID.String()))
+		return newUser, nil, nil
+	}
+
+	tokens, err := uc.startSession(ctx, auth.Principal{UserID: newUser.ID, Email: email, Role: *newUser.Role})
+	if err != nil {
+		log.LogHandlerError(logger, err, http.StatusInternalServerError)
+		return nil, nil, err
+	}
+
+	return newUser, tokens, nil
+}
+
+// hashNewPassword проверяет новый пароль на соответствие политике и хеширует его.
+func (uc *AuthUsecase) hashNewPassword(plainPassword, email string) (string, error) {
+	if err := uc.passwordPolicy.Validate(plainPassword, email); err != nil {
+		return "", err
+	}
+	hash, err := uc.hasher.Hash(plainPassword)
+	if err != nil {
+		return "", ErrGeneratingSalt
+	}
+	return hash, nil
+}
+
+// rehashPassword пересчитывает хеш пароля с текущими параметрами. Ошибка не мешает
+// входу: хеш пересчитается при следующем.
+func (uc *AuthUsecase) rehashPassword(ctx context.Context, userID strfmt.UUID, plainPassword string) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
 
-	salt := make([]byte, 8)
-	_, err := rand.Read(salt)
+	hash, err := uc.hasher.Hash(plainPassword)
+	if err == nil {
+		err = uc.authRepo.UpdatePasswordHash(ctx, userID, hash)
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to rehash password: %w", err), http.StatusInternalServerError)
+		return
+	}
+	logger.Info("Password rehashed", slog.String("user", userID.String()))
+}
+
+// ChangePassword меняет пароль текущего пользователя. Остальные его сессии
+// отзываются, текущая остается открытой.
+func (uc *AuthUsecase) ChangePassword(ctx context.Context, oldPassword, newPassword string) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	principal, ok := auth.PrincipalFromContext(ctx)
+	if !ok || principal.UserID == "" {
+		log.LogHandlerError(logger, auth.ErrUserNotFound, http.StatusUnauthorized)
+		return auth.ErrUserNotFound
+	}
+
+	currentHash, err := uc.authRepo.GetPasswordHash(ctx, principal.UserID)
+	if errors.Is(err, auth.ErrUserNotFound) {
+		log.LogHandlerError(logger, err, http.StatusUnauthorized)
+		return err
+	}
 	if err != nil {
-		log.LogHandlerError(logger, ErrGeneratingSalt, http.StatusInternalServerError)
-		return nil, "", ErrGeneratingSalt
+		log.LogHandlerError(logger, fmt.Errorf("failed to get password hash: %w", err), http.StatusInternalServerError)
+		return auth.ErrDBError
 	}
 
-	hashedPassword := HashPassword(salt, password)
+	if ok, _, _ := uc.hasher.Verify(currentHash, oldPassword); !ok {
+		log.LogHandlerError(logger, auth.ErrInvalidPassword, http.StatusUnauthorized)
+		return auth.ErrInvalidPassword
+	}
+	if oldPassword == newPassword {
+		log.LogHandlerError(logger, auth.ErrSamePassword, http.StatusBadRequest)
+		return auth.ErrSamePassword
+	}
+
+	hashedPassword, err := uc.hashNewPassword(newPassword, principal.Email)
+	if err != nil {
+		log.LogHandlerError(logger, err, http.StatusBadRequest)
+		return err
+	}
+
+	if err := uc.authRepo.UpdatePasswordHash(ctx, principal.UserID, hashedPassword); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to update password hash: %w", err), http.StatusInternalServerError)
+		return auth.ErrDBError
+	}
+	if err := uc.authRepo.RevokeOtherSessions(ctx, principal.UserID, principal.SessionID); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to revoke other sessions: %w", err), http.StatusInternalServerError)
+		return auth.ErrDBError
+	}
+
+	logger.Info("Password changed", slog.String("user", principal.UserID.String()))
+	return nil
+}
+
+func (uc *AuthUsecase) createUser(ctx context.Context, email, plainPassword, role string) (*models.User, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	hashedPassword, err := uc.hashNewPassword(plainPassword, email)
+	if err != nil {
+		log.LogHandlerError(logger, err, http.StatusBadRequest)
+		return nil, err
+	}
 
 	userID, err := uuid.NewV4()
 	if err != nil {
 		log.LogHandlerError(logger, fmt.Errorf("ошибка генерации UUID: %w", err), http.StatusInternalServerError)
-		return nil, "", err
+		return nil, err
 	}
 
 	emailFmt := strfmt.Email(email)
@@ -139,14 +474,451 @@ func (uc *AuthUsecase) SignUp(ctx context.Context, email string, password string
 	err = uc.authRepo.InsertUser(ctx, newUser.ID, email, hashedPassword, role)
 	if err != nil {
 		log.LogHandlerError(logger, auth.ErrCreatingUser, http.StatusInternalServerError)
-		return nil, "", auth.ErrCreatingUser
+		return nil, auth.ErrCreatingUser
+	}
+
+	return newUser, nil
+}
+
+// createInvitedUser создает пользователя с ролью из приглашения и гасит приглашение.
+func (uc *AuthUsecase) createInvitedUser(ctx context.Context, email, plainPassword, role, inviteCode string) (*models.User, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	hashedPassword, err := uc.hashNewPassword(plainPassword, email)
+	if err != nil {
+		log.LogHandlerError(logger, err, http.StatusBadRequest)
+		return nil, err
+	}
+
+	userID, err := uuid.NewV4()
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("ошибка генерации UUID: %w", err), http.StatusInternalServerError)
+		return nil, err
+	}
+
+	id := strfmt.UUID(userID.String())
+	role, err = uc.authRepo.InsertUserWithInvite(ctx, id, email, hashedPassword, role, hashToken(inviteCode))
+	if errors.Is(err, auth.ErrInvalidInvite) {
+		log.LogHandlerError(logger, err, http.StatusBadRequest)
+		return nil, err
+	}
+	if err != nil {
+		log.LogHandlerError(logger, auth.ErrCreatingUser, http.StatusInternalServerError)
+		return nil, auth.ErrCreatingUser
+	}
+
+	emailFmt := strfmt.Email(email)
+	return &models.User{ID: id, Email: &emailFmt, Role: &role}, nil
+}
+
+// Bootstrap создает первого модератора. Он нужен, чтобы было кому выдавать
+// приглашения; если модератор уже есть, команда ничего не делает.
+func (uc *AuthUsecase) Bootstrap(ctx context.Context, email, plainPassword string) (*models.User, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	moderator := models.UserRoleModerator
+	existing, err := uc.authRepo.ListUsers(ctx, auth.UserFilter{Role: &moderator}, 1, 1)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to count moderators: %w", err), http.StatusInternalServerError)
+		return nil, auth.ErrDBError
+	}
+	if existing.Total > 0 {
+		return nil, auth.ErrAlreadyBootstrapped
+	}
+
+	return uc.createUser(ctx, email, plainPassword, moderator)
+}
+
+// CreateInvite выпускает одноразовое приглашение. Код возвращается только здесь,
+// в базе хранится его хеш. Приглашение может выдать только модератор с учетной
+// записью, иначе тестовый токен превращался бы в настоящего модератора.
+func (uc *AuthUsecase) CreateInvite(ctx context.Context, actorID strfmt.UUID, role, email string, ttl time.Duration) (*models.Invite, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	if err := auth.RequireActor(actorID); err != nil {
+		log.LogHandlerError(logger, err, http.StatusForbidden)
+		return nil, err
+	}
+	if ttl <= 0 {
+		ttl = DefaultInviteTTL
 	}
 
-	token, err := generateToken(role)
+	code, err := randomToken()
 	if err != nil {
 		log.LogHandlerError(logger, auth.ErrGeneratingToken, http.StatusInternalServerError)
-		return nil, "", auth.ErrGeneratingToken
+		return nil, auth.ErrGeneratingToken
+	}
+
+	id, err := uuid.NewV4()
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("ошибка генерации UUID: %w", err), http.StatusInternalServerError)
+		return nil, auth.ErrUUID
+	}
+
+	invite := &auth.Invite{
+		ID:        strfmt.UUID(id.String()),
+		CodeHash:  hashToken(code),
+		Role:      role,
+		Email:     email,
+		CreatedBy: actorID,
+		ExpiresAt: time.Now().Add(ttl),
+	}
+	if err := uc.authRepo.InsertInvite(ctx, invite); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to insert invite: %w", err), http.StatusInternalServerError)
+		return nil, auth.ErrDBError
+	}
+
+	logger.Info("Invite created", slog.String("invite", invite.ID.String()), slog.String("role", role),
+		slog.String("by", actorID.String()))
+
+	expiresAt := strfmt.DateTime(invite.ExpiresAt)
+	return &models.Invite{
+		ID:        invite.ID,
+		Code:      code,
+		Role:      &invite.Role,
+		Email:     strfmt.Email(email),
+		ExpiresAt: &expiresAt,
+	}, nil
+}
+
+// Refresh меняет refresh-токен на новую пару. Предъявление уже использованного
+// токена означает, что он утек: все семейство отзывается.
+func (uc *AuthUsecase) Refresh(ctx context.Context, refreshToken string) (*auth.Tokens, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	if refreshToken == "" {
+		log.LogHandlerError(logger, auth.ErrInvalidRefreshToken, http.StatusUnauthorized)
+		return nil, auth.ErrInvalidRefreshToken
+	}
+
+	stored, err := uc.authRepo.GetRefreshToken(ctx, hashToken(refreshToken))
+	if err != nil {
+		log.LogHandlerError(logger, auth.ErrInvalidRefreshToken, http.StatusUnauthorized)
+		return nil, auth.ErrInvalidRefreshToken
+	}
+
+	if stored.RevokedAt != nil || time.Now().After(stored.ExpiresAt) {
+		log.LogHandlerError(logger, auth.ErrInvalidRefreshToken, http.StatusUnauthorized)
+		return nil, auth.ErrInvalidRefreshToken
+	}
+
+	fresh := stored.UsedAt == nil
+	if fresh {
+		fresh, err = uc.authRepo.MarkRefreshTokenUsed(ctx, stored.ID)
+		if err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to rotate refresh token: %w", err), http.StatusInternalServerError)
+			return nil, auth.ErrGeneratingToken
+		}
+	}
+	if !fresh {
+		if err := uc.authRepo.RevokeRefreshFamily(ctx, stored.FamilyID); err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to revoke refresh token family: %w", err), http.StatusInternalServerError)
+		}
+		log.LogHandlerError(logger, auth.ErrRefreshTokenReuse, http.StatusUnauthorized)
+		return nil, auth.ErrRefreshTokenReuse
+	}
+
+	tokens, err := uc.issueTokens(ctx, auth.Principal{
+		UserID:    stored.UserID,
+		Email:     stored.Email,
+		Role:      stored.Role,
+		SessionID: stored.FamilyID.String(),
+	})
+	if err != nil {
+		log.LogHandlerError(logger, err, http.StatusInternalServerError)
+		return nil, err
+	}
+
+	if err := uc.authRepo.TouchSession(ctx, stored.FamilyID, time.Now()); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to update session: %w", err), http.StatusInternalServerError)
+	}
+
+	return tokens, nil
+}
+
+// Logout отзывает семейство refresh-токенов, а вместе с ним и выданные по нему access-токены.
+func (uc *AuthUsecase) Logout(ctx context.Context, refreshToken string) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	if refreshToken == "" {
+		return nil
+	}
+
+	stored, err := uc.authRepo.GetRefreshToken(ctx, hashToken(refreshToken))
+	if errors.Is(err, auth.ErrInvalidRefreshToken) {
+		return nil
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get refresh token: %w", err), http.StatusInternalServerError)
+		return err
+	}
+
+	if err := uc.authRepo.RevokeRefreshFamily(ctx, stored.FamilyID); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to revoke refresh token family: %w", err), http.StatusInternalServerError)
+		return err
+	}
+
+	return nil
+}
+
+// PublicKeys отдает ключи, которыми партнерские сервисы могут проверять наши токены без обращения к нам.
+func (uc *AuthUsecase) PublicKeys(ctx context.Context) *models.JWKS {
+	return uc.signer.JWKS()
+}
+
+// StartExternalLogin готовит переход к провайдеру: state защищает callback от CSRF,
+// nonce привязывает ID-токен к этому входу, verifier — секрет PKCE.
+func (uc *AuthUsecase) StartExternalLogin(ctx context.Context) (*auth.ExternalLogin, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	if uc.idp == nil {
+		return nil, auth.ErrSSODisabled
+	}
+
+	login := &auth.ExternalLogin{}
+	for _, dst := range []*string{&login.State, &login.Nonce, &login.Verifier} {
+		value, err := randomToken()
+		if err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to generate oauth params: %w", err), http.StatusInternalServerError)
+			return nil, auth.ErrExternalLogin
+		}
+		*dst = value
+	}
+
+	url, err := uc.idp.AuthCodeURL(ctx, login.State, login.Nonce, login.Verifier)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to build auth url: %w", err), http.StatusBadGateway)
+		return nil, auth.ErrExternalLogin
+	}
+	login.URL = url
+
+	return login, nil
+}
+
+// CompleteExternalLogin обменивает код на подтвержденного пользователя и открывает сессию.
+// Роль каждый раз берется из групп провайдера: там, а не у нас, ведется доступ сотрудников.
+func (uc *AuthUsecase) CompleteExternalLogin(ctx context.Context, code, verifier, nonce string) (*models.User, *auth.Tokens, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	if uc.idp == nil {
+		return nil, nil, auth.ErrSSODisabled
+	}
+
+	identity, err := uc.idp.Exchange(ctx, code, verifier, nonce)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("oauth exchange failed: %w", err), http.StatusUnauthorized)
+		return nil, nil, auth.ErrExternalLogin
+	}
+	if identity.Email == "" {
+		log.LogHandlerError(logger, errors.New("provider returned no email"), http.StatusUnauthorized)
+		return nil, nil, auth.ErrExternalLogin
+	}
+
+	role := uc.roles.RoleFor(identity.Groups)
+	if role == "" {
+		log.LogHandlerError(logger, auth.ErrNoRoleForGroups, http.StatusForbidden)
+		return nil, nil, auth.ErrNoRoleForGroups
+	}
+
+	user, err := uc.externalUser(ctx, identity, role)
+	if err != nil {
+		log.LogHandlerError(logger, err, http.StatusUnauthorized)
+		return nil, nil, err
+	}
+
+	tokens, err := uc.startSession(ctx, auth.Principal{UserID: user.ID, Email: user.Email.String(), Role: role})
+	if err != nil {
+		log.LogHandlerError(logger, err, http.StatusInternalServerError)
+		return nil, nil, err
+	}
+
+	return user, tokens, nil
+}
+
+// externalUser находит пользователя по внешней учетной записи. При первом входе
+// учетная запись привязывается к пользователю с тем же email, но только если
+// провайдер подтвердил email, иначе создается новый пользователь без пароля.
+func (uc *AuthUsecase) externalUser(ctx context.Context, identity *auth.ExternalIdentity, role string) (*models.User, error) {
+	user, err := uc.authRepo.GetUserByIdentity(ctx, identity.Provider, identity.Subject)
+	if err == nil {
+		if swag.BoolValue(user.Disabled) {
+			return nil, auth.ErrUserDisabled
+		}
+		if swag.StringValue(user.Role) != role {
+			return uc.setRole(ctx, user.ID, role)
+		}
+		return user, nil
+	}
+	if !errors.Is(err, auth.ErrIdentityNotFound) {
+		return nil, auth.ErrDBError
+	}
+
+	email := strfmt.Email(identity.Email)
+	user = &models.User{Email: &email, Role: &role, Disabled: swag.Bool(false)}
+
+	creds, err := uc.authRepo.GetUserCredsByEmail(ctx, identity.Email)
+	switch {
+	case err == nil:
+		if !identity.EmailVerified {
+			return nil, auth.ErrIdentityConflict
+		}
+		if creds.Disabled {
+			return nil, auth.ErrUserDisabled
+		}
+		user.ID = creds.ID
+		if creds.Role != role {
+			if user, err = uc.setRole(ctx, user.ID, role); err != nil {
+				return nil, err
+			}
+		}
+	case errors.Is(err, auth.ErrUserNotFound):
+		userID, err := uuid.NewV4()
+		if err != nil {
+			return nil, auth.ErrUUID
+		}
+		user.ID = strfmt.UUID(userID.String())
+		if err := uc.authRepo.InsertUser(ctx, user.ID, identity.Email, "", role); err != nil {
+			return nil, auth.ErrCreatingUser
+		}
+	default:
+		return nil, auth.ErrDBError
+	}
+
+	if err := uc.authRepo.LinkIdentity(ctx, identity.Provider, identity.Subject, user.ID); err != nil {
+		return nil, auth.ErrDBError
+	}
+
+	return user, nil
+}
+
+func (uc *AuthUsecase) setRole(ctx context.Context, userID strfmt.UUID, role string) (*models.User, error) {
+	user, err := uc.authRepo.UpdateUser(ctx, userID, &models.UserPatch{Role: &role})
+	if err != nil {
+		return nil, auth.ErrDBError
+	}
+	return user, nil
+}
+
+func (uc *AuthUsecase) ListUsers(ctx context.Context, filter auth.UserFilter, page, limit int) (*auth.UserPage, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	users, err := uc.authRepo.ListUsers(ctx, filter, page, limit)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to list users: %w", err), http.StatusInternalServerError)
+		return nil, auth.ErrDBError
+	}
+
+	return users, nil
+}
+
+func (uc *AuthUsecase) GetUser(ctx context.Context, userID strfmt.UUID) (*models.User, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	user, err := uc.authRepo.GetUser(ctx, userID)
+	if errors.Is(err, auth.ErrUserNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get user: %w", err), http.StatusInternalServerError)
+		return nil, auth.ErrDBError
+	}
+
+	return user, nil
+}
+
+// UpdateUser меняет роль и блокировку пользователя. Роль зашита в выданные токены,
+// поэтому после смены роли или блокировки все сессии пользователя отзываются.
+// Модератор не может заблокировать или понизить сам себя, чтобы не остаться без модераторов.
+func (uc *AuthUsecase) UpdateUser(ctx context.Context, actorID, userID strfmt.UUID, patch *models.UserPatch) (*models.User, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	if err := auth.RequireActor(actorID); err != nil {
+		log.LogHandlerError(logger, err, http.StatusForbidden)
+		return nil, err
+	}
+
+	current, err := uc.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	disable := swag.BoolValue(patch.Disabled) && !swag.BoolValue(current.Disabled)
+	roleChanged := patch.Role != nil && *patch.Role != swag.StringValue(current.Role)
+
+	if actorID == userID && (disable || roleChanged) {
+		log.LogHandlerError(logger, auth.ErrSelfModification, http.StatusConflict)
+		return nil, auth.ErrSelfModification
+	}
+
+	user, err := uc.authRepo.UpdateUser(ctx, userID, patch)
+	if errors.Is(err, auth.ErrUserNotFound) {
+		return nil, err
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to update user: %w", err), http.StatusInternalServerError)
+		return nil, auth.ErrDBError
+	}
+
+	if disable || roleChanged {
+		if err := uc.authRepo.RevokeUserSessions(ctx, userID); err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to revoke user sessions: %w", err), http.StatusInternalServerError)
+			return nil, auth.ErrDBError
+		}
+	}
+
+	logger.Info("User updated", slog.String("user", userID.String()), slog.String("by", actorID.String()),
+		slog.Bool("roleChanged", roleChanged), slog.Bool("disabled", swag.BoolValue(user.Disabled)))
+	return user, nil
+}
+
+// UnlockUser снимает блокировку входа, наступившую после неудачных попыток.
+func (uc *AuthUsecase) UnlockUser(ctx context.Context, actorID, userID strfmt.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	if err := auth.RequireActor(actorID); err != nil {
+		log.LogHandlerError(logger, err, http.StatusForbidden)
+		return err
+	}
+
+	user, err := uc.GetUser(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if uc.throttle != nil {
+		if err := uc.throttle.Unlock(ctx, user.Email.String()); err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to unlock user: %w", err), http.StatusInternalServerError)
+			return auth.ErrDBError
+		}
+	}
+
+	logger.Info("User login unlocked", slog.String("user", userID.String()), slog.String("by", actorID.String()))
+	return nil
+}
+
+// DeleteUser удаляет пользователя вместе с его сессиями и привязками к SSO.
+func (uc *AuthUsecase) DeleteUser(ctx context.Context, actorID, userID strfmt.UUID) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	if err := auth.RequireActor(actorID); err != nil {
+		log.LogHandlerError(logger, err, http.StatusForbidden)
+		return err
+	}
+
+	if actorID == userID {
+		log.LogHandlerError(logger, auth.ErrSelfModification, http.StatusConflict)
+		return auth.ErrSelfModification
+	}
+
+	err := uc.authRepo.DeleteUser(ctx, userID)
+	if errors.Is(err, auth.ErrUserNotFound) {
+		return err
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to delete user: %w", err), http.StatusInternalServerError)
+		return auth.ErrDBError
 	}
 
-	return newUser, token, nil
+	logger.Info("User deleted", slog.String("user", userID.String()), slog.String("by", actorID.String()))
+	return nil
 }
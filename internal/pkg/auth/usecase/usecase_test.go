@@ -0,0 +1,1736 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/golang-jwt/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth/keys"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth/oidc"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth/oidc/oidctest"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth/password"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth/throttle"
+	"github.com/totorialman/go-task-avito/models"
+)
+
+func newTestSigner(t *testing.T) *keys.KeySet {
+	t.Helper()
+	key, err := keys.Generate("test", keys.AlgEdDSA)
+	require.NoError(t, err)
+	signer, err := keys.NewKeySet("", key)
+	require.NoError(t, err)
+	return signer
+}
+
+// DummyAuthRepo хранит refresh-токены в памяти по хешу, пользователей — по email,
+// внешние учетные записи — по паре provider/subject.
+type DummyAuthRepo struct {
+	auth.AuthRepo
+
+	Tokens     map[string]*auth.RefreshToken
+	Revoked    []strfmt.UUID
+	Users      map[string]*dummyUser
+	Identities map[string]strfmt.UUID
+
+	RevokedUsers []strfmt.UUID
+	Invites      map[string]*auth.Invite
+	Resets       map[string]*auth.PasswordReset
+	APIKeys      map[string]*auth.APIKey
+	Touched      int
+	PVZs         map[strfmt.UUID]bool
+	Assignments  map[strfmt.UUID][]strfmt.UUID
+	Sessions     map[strfmt.UUID]*auth.Session
+	EmailChanges map[string]*auth.EmailChange
+}
+
+type dummyUser struct {
+	ID       strfmt.UUID
+	Role     string
+	Hash     string
+	Disabled bool
+}
+
+func (m *DummyAuthRepo) InsertUser(ctx context.Context, userID strfmt.UUID, email, hashedPassword, role string) error {
+	m.Users[email] = &dummyUser{ID: userID, Role: role, Hash: hashedPassword}
+	return nil
+}
+
+func (m *DummyAuthRepo) GetUserCredsByEmail(ctx context.Context, email string) (*auth.UserCreds, error) {
+	user, ok := m.Users[email]
+	if !ok {
+		return nil, auth.ErrUserNotFound
+	}
+	return &auth.UserCreds{ID: user.ID, Role: user.Role, PasswordHash: user.Hash, Disabled: user.Disabled}, nil
+}
+
+func (m *DummyAuthRepo) GetUser(ctx context.Context, userID strfmt.UUID) (*models.User, error) {
+	for email, user := range m.Users {
+		if user.ID == userID {
+			email, role, disabled := strfmt.Email(email), user.Role, user.Disabled
+			return &models.User{ID: userID, Email: &email, Role: &role, Disabled: &disabled}, nil
+		}
+	}
+	return nil, auth.ErrUserNotFound
+}
+
+func (m *DummyAuthRepo) GetUserByIdentity(ctx context.Context, provider, subject string) (*models.User, error) {
+	id, ok := m.Identities[provider+"|"+subject]
+	if !ok {
+		return nil, auth.ErrIdentityNotFound
+	}
+	return m.GetUser(ctx, id)
+}
+
+func (m *DummyAuthRepo) LinkIdentity(ctx context.Context, provider, subject string, userID strfmt.UUID) error {
+	m.Identities[provider+"|"+subject] = userID
+	return nil
+}
+
+func (m *DummyAuthRepo) UpdateUser(ctx context.Context, userID strfmt.UUID, patch *models.UserPatch) (*models.User, error) {
+	for _, user := range m.Users {
+		if user.ID == userID {
+			if patch.Role != nil {
+				user.Role = *patch.Role
+			}
+			if patch.Disabled != nil {
+				user.Disabled = *patch.Disabled
+			}
+			return m.GetUser(ctx, userID)
+		}
+	}
+	return nil, auth.ErrUserNotFound
+}
+
+func (m *DummyAuthRepo) DeleteUser(ctx context.Context, userID strfmt.UUID) error {
+	for email, user := range m.Users {
+		if user.ID == userID {
+			delete(m.Users, email)
+			return nil
+		}
+	}
+	return auth.ErrUserNotFound
+}
+
+func (m *DummyAuthRepo) RevokeUserSessions(ctx context.Context, userID strfmt.UUID) error {
+	now := time.Now()
+	for _, session := range m.Sessions {
+		if session.UserID == userID && session.RevokedAt == nil {
+			session.RevokedAt = &now
+		}
+	}
+	for _, token := range m.Tokens {
+		if token.UserID == userID && token.RevokedAt == nil {
+			token.RevokedAt = &now
+		}
+	}
+	m.RevokedUsers = append(m.RevokedUsers, userID)
+	return nil
+}
+
+func (m *DummyAuthRepo) RevokeOtherSessions(ctx context.Context, userID strfmt.UUID, keepFamilyID string) error {
+	now := time.Now()
+	for _, token := range m.Tokens {
+		if token.UserID == userID && token.FamilyID.String() != keepFamilyID && token.RevokedAt == nil {
+			token.RevokedAt = &now
+		}
+	}
+	return nil
+}
+
+func (m *DummyAuthRepo) GetPasswordHash(ctx context.Context, userID strfmt.UUID) (string, error) {
+	for _, user := range m.Users {
+		if user.ID == userID {
+			return user.Hash, nil
+		}
+	}
+	return "", auth.ErrUserNotFound
+}
+
+func (m *DummyAuthRepo) UpdatePasswordHash(ctx context.Context, userID strfmt.UUID, hashedPassword string) error {
+	for _, user := range m.Users {
+		if user.ID == userID {
+			user.Hash = hashedPassword
+			return nil
+		}
+	}
+	return auth.ErrUserNotFound
+}
+
+func (m *DummyAuthRepo) ListUsers(ctx context.Context, filter auth.UserFilter, page, limit int) (*auth.UserPage, error) {
+	result := &auth.UserPage{}
+	for _, user := range m.Users {
+		if filter.Role == nil || *filter.Role == user.Role {
+			result.Total++
+		}
+	}
+	return result, nil
+}
+
+func (m *DummyAuthRepo) InsertInvite(ctx context.Context, invite *auth.Invite) error {
+	m.Invites[invite.CodeHash] = invite
+	return nil
+}
+
+// InsertUserWithInvite повторяет условия запроса: приглашение действует, email и роль совпадают.
+func (m *DummyAuthRepo) InsertUserWithInvite(ctx context.Context, userID strfmt.UUID, email, hashedPassword, role, codeHash string) (string, error) {
+	invite, ok := m.Invites[codeHash]
+	if !ok || time.Now().After(invite.ExpiresAt) ||
+		(invite.Email != "" && invite.Email != email) || (role != "" && role != invite.Role) {
+		return "", auth.ErrInvalidInvite
+	}
+	delete(m.Invites, codeHash)
+	m.Users[email] = &dummyUser{ID: userID, Role: invite.Role, Hash: hashedPassword}
+	return invite.Role, nil
+}
+
+func (m *DummyAuthRepo) InsertPasswordReset(ctx context.Context, reset *auth.PasswordReset) error {
+	m.Resets[reset.TokenHash] = reset
+	return nil
+}
+
+func (m *DummyAuthRepo) GetPasswordReset(ctx context.Context, tokenHash string) (*auth.PasswordReset, error) {
+	reset, ok := m.Resets[tokenHash]
+	if !ok {
+		return nil, auth.ErrInvalidResetToken
+	}
+	copied := *reset
+	return &copied, nil
+}
+
+// CompletePasswordReset повторяет запрос: гасит токен и остальные токены пользователя.
+func (m *DummyAuthRepo) CompletePasswordReset(ctx context.Context, resetID strfmt.UUID, hashedPassword string) error {
+	now := time.Now()
+	var userID strfmt.UUID
+	for _, reset := range m.Resets {
+		if reset.ID == resetID && reset.UsedAt == nil && now.Before(reset.ExpiresAt) {
+			userID = reset.UserID
+		}
+	}
+	if userID == "" {
+		return auth.ErrInvalidResetToken
+	}
+	for _, reset := range m.Resets {
+		if reset.UserID == userID && reset.UsedAt == nil {
+			reset.UsedAt = &now
+		}
+	}
+	return m.UpdatePasswordHash(ctx, userID, hashedPassword)
+}
+
+func (m *DummyAuthRepo) InsertAPIKey(ctx context.Context, key *auth.APIKey) error {
+	key.CreatedAt = time.Now()
+	m.APIKeys[key.Prefix] = key
+	return nil
+}
+
+func (m *DummyAuthRepo) GetAPIKeyByPrefix(ctx context.Context, prefix string) (*auth.APIKey, error) {
+	key, ok := m.APIKeys[prefix]
+	if !ok {
+		return nil, auth.ErrInvalidAPIKey
+	}
+	return key, nil
+}
+
+func (m *DummyAuthRepo) RevokeAPIKey(ctx context.Context, keyID strfmt.UUID) error {
+	for _, key := range m.APIKeys {
+		if key.ID == keyID && key.RevokedAt == nil {
+			now := time.Now()
+			key.RevokedAt = &now
+			return nil
+		}
+	}
+	return auth.ErrAPIKeyNotFound
+}
+
+func (m *DummyAuthRepo) TouchAPIKey(ctx context.Context, keyID strfmt.UUID, usedAt time.Time) error {
+	for _, key := range m.APIKeys {
+		if key.ID == keyID {
+			key.LastUsedAt = &usedAt
+			m.Touched++
+		}
+	}
+	return nil
+}
+
+func (m *DummyAuthRepo) AssignPVZ(ctx context.Context, userID, pvzID, actorID strfmt.UUID) error {
+	if !m.PVZs[pvzID] {
+		return auth.ErrPVZNotFound
+	}
+	for _, id := range m.Assignments[userID] {
+		if id == pvzID {
+			return nil
+		}
+	}
+	m.Assignments[userID] = append(m.Assignments[userID], pvzID)
+	return nil
+}
+
+func (m *DummyAuthRepo) UnassignPVZ(ctx context.Context, userID, pvzID strfmt.UUID) error {
+	for i, id := range m.Assignments[userID] {
+		if id == pvzID {
+			m.Assignments[userID] = append(m.Assignments[userID][:i], m.Assignments[userID][i+1:]...)
+			return nil
+		}
+	}
+	return auth.ErrAssignmentNotFound
+}
+
+func (m *DummyAuthRepo) IsAssignedToPVZ(ctx context.Context, userID, pvzID strfmt.UUID) (bool, error) {
+	for _, id := range m.Assignments[userID] {
+		if id == pvzID {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *DummyAuthRepo) ListPVZAssignments(ctx context.Context, userID strfmt.UUID) ([]*models.PVZAssignment, error) {
+	assignments := []*models.PVZAssignment{}
+	for _, id := range m.Assignments[userID] {
+		pvzID := id
+		assignments = append(assignments, &models.PVZAssignment{PvzID: &pvzID})
+	}
+	return assignments, nil
+}
+
+func (m *DummyAuthRepo) GetProfile(ctx context.Context, userID strfmt.UUID) (*models.User, error) {
+	user, err := m.GetUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, change := range m.EmailChanges {
+		if change.UserID == userID && change.UsedAt == nil && time.Now().Before(change.ExpiresAt) {
+			user.PendingEmail = strfmt.Email(change.NewEmail)
+		}
+	}
+	return user, nil
+}
+
+// InsertEmailChange повторяет запрос: гасит прежние запросы пользователя.
+func (m *DummyAuthRepo) InsertEmailChange(ctx context.Context, change *auth.EmailChange) error {
+	now := time.Now()
+	for _, previous := range m.EmailChanges {
+		if previous.UserID == change.UserID && previous.UsedAt == nil {
+			previous.UsedAt = &now
+		}
+	}
+	m.EmailChanges[change.TokenHash] = change
+	return nil
+}
+
+func (m *DummyAuthRepo) GetEmailChange(ctx context.Context, tokenHash string) (*auth.EmailChange, error) {
+	change, ok := m.EmailChanges[tokenHash]
+	if !ok {
+		return nil, auth.ErrInvalidEmailToken
+	}
+	copied := *change
+	return &copied, nil
+}
+
+func (m *DummyAuthRepo) CompleteEmailChange(ctx context.Context, changeID strfmt.UUID) error {
+	now := time.Now()
+	for _, change := range m.EmailChanges {
+		if change.ID != changeID || change.UsedAt != nil || now.After(change.ExpiresAt) {
+			continue
+		}
+		if _, taken := m.Users[change.NewEmail]; taken {
+			return auth.ErrEmailTaken
+		}
+		for email, user := range m.Users {
+			if user.ID == change.UserID {
+				delete(m.Users, email)
+				m.Users[change.NewEmail] = user
+			}
+		}
+		change.UsedAt = &now
+		return nil
+	}
+	return auth.ErrInvalidEmailToken
+}
+
+// DummyMailer запоминает отправленные письма.
+type DummyMailer struct {
+	Sent []*auth.MailMessage
+	Err  error
+}
+
+func (m *DummyMailer) Send(ctx context.Context, msg *auth.MailMessage) error {
+	m.Sent = append(m.Sent, msg)
+	return m.Err
+}
+
+func (m *DummyAuthRepo) InsertRefreshToken(ctx context.Context, token *auth.RefreshToken) error {
+	m.Tokens[token.TokenHash] = token
+	return nil
+}
+
+func (m *DummyAuthRepo) GetRefreshToken(ctx context.Context, tokenHash string) (*auth.RefreshToken, error) {
+	token, ok := m.Tokens[tokenHash]
+	if !ok {
+		return nil, auth.ErrInvalidRefreshToken
+	}
+	copied := *token
+	return &copied, nil
+}
+
+func (m *DummyAuthRepo) MarkRefreshTokenUsed(ctx context.Context, id strfmt.UUID) (bool, error) {
+	for _, token := range m.Tokens {
+		if token.ID == id && token.UsedAt == nil && token.RevokedAt == nil {
+			now := time.Now()
+			token.UsedAt = &now
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *DummyAuthRepo) InsertSession(ctx context.Context, session *auth.Session) error {
+	if m.Sessions == nil {
+		m.Sessions = map[strfmt.UUID]*auth.Session{}
+	}
+	session.CreatedAt = time.Now()
+	session.LastSeenAt = session.CreatedAt
+	copied := *session
+	m.Sessions[session.ID] = &copied
+	return nil
+}
+
+func (m *DummyAuthRepo) ListSessions(ctx context.Context, userID strfmt.UUID, activeSince time.Time) ([]*auth.Session, error) {
+	sessions := []*auth.Session{}
+	for _, session := range m.Sessions {
+		if session.UserID == userID && session.RevokedAt == nil && session.LastSeenAt.After(activeSince) {
+			copied := *session
+			sessions = append(sessions, &copied)
+		}
+	}
+	return sessions, nil
+}
+
+func (m *DummyAuthRepo) TouchSession(ctx context.Context, sessionID strfmt.UUID, seenAt time.Time) error {
+	if session, ok := m.Sessions[sessionID]; ok {
+		session.LastSeenAt = seenAt
+	}
+	return nil
+}
+
+func (m *DummyAuthRepo) RevokeSession(ctx context.Context, userID, sessionID strfmt.UUID) error {
+	session, ok := m.Sessions[sessionID]
+	if !ok || session.UserID != userID || session.RevokedAt != nil {
+		return auth.ErrSessionNotFound
+	}
+	return m.RevokeRefreshFamily(ctx, sessionID)
+}
+
+func (m *DummyAuthRepo) RevokeRefreshFamily(ctx context.Context, familyID strfmt.UUID) error {
+	m.Revoked = append(m.Revoked, familyID)
+	now := time.Now()
+	if session, ok := m.Sessions[familyID]; ok {
+		session.RevokedAt = &now
+	}
+	for _, token := range m.Tokens {
+		if token.FamilyID == familyID {
+			token.RevokedAt = &now
+		}
+	}
+	return nil
+}
+
+func TestAuthUsecase_Refresh(t *testing.T) {
+	signer := newTestSigner(t)
+
+	userID := strfmt.UUID("11111111-1111-1111-1111-111111111111")
+	repo := &DummyAuthRepo{Tokens: map[string]*auth.RefreshToken{}}
+	uc := NewAuthUsecase(repo, signer)
+	ctx := context.Background()
+
+	first, err := uc.startSession(ctx, auth.Principal{UserID: userID, Email: "employee@example.com", Role: "employee"})
+	require.NoError(t, err)
+	require.NotEmpty(t, first.Refresh)
+	assert.NotContains(t, repo.Tokens, first.Refresh, "refresh token must be stored hashed")
+
+	second, err := uc.Refresh(ctx, first.Refresh)
+	require.NoError(t, err)
+	assert.NotEqual(t, first.Refresh, second.Refresh)
+	assert.NotEmpty(t, second.Access)
+
+	_, err = uc.Refresh(ctx, first.Refresh)
+	assert.ErrorIs(t, err, auth.ErrRefreshTokenReuse)
+	require.Len(t, repo.Revoked, 1)
+
+	_, err = uc.Refresh(ctx, second.Refresh)
+	assert.ErrorIs(t, err, auth.ErrInvalidRefreshToken, "whole family is revoked after reuse")
+}
+
+func TestAuthUsecase_RefreshInvalid(t *testing.T) {
+	signer := newTestSigner(t)
+
+	expired := "expired-token"
+	repo := &DummyAuthRepo{Tokens: map[string]*auth.RefreshToken{
+		hashToken(expired): {
+			ID:        strfmt.UUID("22222222-2222-2222-2222-222222222222"),
+			FamilyID:  strfmt.UUID("33333333-3333-3333-3333-333333333333"),
+			ExpiresAt: time.Now().Add(-time.Minute),
+		},
+	}}
+	uc := NewAuthUsecase(repo, signer)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"Empty token", ""},
+		{"Unknown token", "unknown"},
+		{"Expired token", expired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := uc.Refresh(context.Background(), tt.token)
+			assert.ErrorIs(t, err, auth.ErrInvalidRefreshToken)
+			assert.Empty(t, repo.Revoked)
+		})
+	}
+}
+
+func TestAuthUsecase_Logout(t *testing.T) {
+	signer := newTestSigner(t)
+
+	repo := &DummyAuthRepo{Tokens: map[string]*auth.RefreshToken{}}
+	uc := NewAuthUsecase(repo, signer)
+	ctx := context.Background()
+
+	tokens, err := uc.startSession(ctx, auth.Principal{UserID: strfmt.UUID("11111111-1111-1111-1111-111111111111"), Role: "moderator"})
+	require.NoError(t, err)
+
+	require.NoError(t, uc.Logout(ctx, tokens.Refresh))
+	require.Len(t, repo.Revoked, 1)
+
+	_, err = uc.Refresh(ctx, tokens.Refresh)
+	assert.ErrorIs(t, err, auth.ErrInvalidRefreshToken)
+
+	assert.NoError(t, uc.Logout(ctx, "unknown"))
+}
+
+func TestGenerateToken_Claims(t *testing.T) {
+	signer := newTestSigner(t)
+
+	tests := []struct {
+		name      string
+		principal auth.Principal
+		absent    []string
+	}{
+		{
+			name: "User token carries identity",
+			principal: auth.Principal{
+				UserID:    strfmt.UUID("11111111-1111-1111-1111-111111111111"),
+				Email:     "user@example.com",
+				Role:      "employee",
+				SessionID: "22222222-2222-2222-2222-222222222222",
+			},
+		},
+		{
+			name:      "Dummy token has no identity",
+			principal: auth.Principal{Role: "moderator"},
+			absent:    []string{"sub", "email", "sid"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signed, err := generateToken(signer, tt.principal, AccessTokenTTL)
+			require.NoError(t, err)
+
+			claims := jwt.MapClaims{}
+			token, err := signer.Parse(signed, claims)
+			require.NoError(t, err)
+			assert.Equal(t, "test", token.Header["kid"])
+
+			assert.Equal(t, tt.principal.Role, claims["role"])
+			assert.NotEmpty(t, claims["jti"])
+			for _, name := range tt.absent {
+				assert.NotContains(t, claims, name)
+			}
+			if tt.principal.UserID != "" {
+				assert.Equal(t, tt.principal.UserID.String(), claims["sub"])
+				assert.Equal(t, tt.principal.Email, claims["email"])
+				assert.Equal(t, tt.principal.SessionID, claims["sid"])
+			}
+		})
+	}
+
+	first, err := generateToken(signer, auth.Principal{Role: "employee"}, AccessTokenTTL)
+	require.NoError(t, err)
+	second, err := generateToken(signer, auth.Principal{Role: "employee"}, AccessTokenTTL)
+	require.NoError(t, err)
+	assert.NotEqual(t, first, second, "every token gets its own jti")
+}
+
+func TestAuthUsecase_ExternalLogin(t *testing.T) {
+	const existingID = strfmt.UUID("33333333-3333-3333-3333-333333333333")
+
+	tests := []struct {
+		name        string
+		user        oidctest.User
+		users       map[string]*dummyUser
+		identities  map[string]strfmt.UUID
+		expectRole  string
+		expectID    strfmt.UUID
+		expectError error
+	}{
+		{
+			name:       "First login creates a user without password",
+			user:       oidctest.User{Subject: "emp-1", Email: "new@corp.example", EmailVerified: true, Groups: []string{"pvz-staff"}},
+			expectRole: "employee",
+		},
+		{
+			name:       "Moderator group wins over employee group",
+			user:       oidctest.User{Subject: "emp-1", Email: "lead@corp.example", Groups: []string{"pvz-staff", "pvz-leads"}},
+			expectRole: "moderator",
+		},
+		{
+			name:       "Known identity follows group changes",
+			user:       oidctest.User{Subject: "emp-2", Email: "old@corp.example", Groups: []string{"pvz-leads"}},
+			users:      map[string]*dummyUser{"old@corp.example": {ID: existingID, Role: "employee"}},
+			identities: map[string]strfmt.UUID{"oidc|emp-2": existingID},
+			expectRole: "moderator",
+			expectID:   existingID,
+		},
+		{
+			name:       "Verified email links an existing password account",
+			user:       oidctest.User{Subject: "emp-3", Email: "pass@corp.example", EmailVerified: true, Groups: []string{"pvz-staff"}},
+			users:      map[string]*dummyUser{"pass@corp.example": {ID: existingID, Role: "employee", Hash: "abc"}},
+			expectRole: "employee",
+			expectID:   existingID,
+		},
+		{
+			name:        "Unverified email does not take over an existing account",
+			user:        oidctest.User{Subject: "emp-3", Email: "pass@corp.example", Groups: []string{"pvz-staff"}},
+			users:       map[string]*dummyUser{"pass@corp.example": {ID: existingID, Role: "moderator", Hash: "abc"}},
+			expectError: auth.ErrIdentityConflict,
+		},
+		{
+			name:        "No mapped group",
+			user:        oidctest.User{Subject: "emp-4", Email: "guest@corp.example", Groups: []string{"accounting"}},
+			expectError: auth.ErrNoRoleForGroups,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, err := oidctest.NewServer("avito-pvz", "s3cret")
+			require.NoError(t, err)
+			defer srv.Close()
+			srv.User = tt.user
+
+			repo := &DummyAuthRepo{
+				Tokens:     map[string]*auth.RefreshToken{},
+				Users:      map[string]*dummyUser{},
+				Identities: map[string]strfmt.UUID{},
+			}
+			for k, v := range tt.users {
+				repo.Users[k] = v
+			}
+			for k, v := range tt.identities {
+				repo.Identities[k] = v
+			}
+
+			signer := newTestSigner(t)
+			provider := oidc.NewProvider(oidc.Config{
+				Issuer:       srv.Issuer(),
+				ClientID:     "avito-pvz",
+				ClientSecret: "s3cret",
+				RedirectURL:  "http://localhost:8080/oauth/callback",
+			}, srv.Client())
+			uc := NewAuthUsecase(repo, signer).WithIdentityProvider(provider, auth.RoleMapping{
+				ModeratorGroups: []string{"pvz-leads"},
+				EmployeeGroups:  []string{"pvz-staff"},
+			})
+			ctx := context.Background()
+
+			login, err := uc.StartExternalLogin(ctx)
+			require.NoError(t, err)
+			code, state, err := srv.Authorize(login.URL)
+			require.NoError(t, err)
+			require.Equal(t, login.State, state)
+
+			user, tokens, err := uc.CompleteExternalLogin(ctx, code, login.Verifier, login.Nonce)
+			if tt.expectError != nil {
+				assert.ErrorIs(t, err, tt.expectError)
+				assert.Empty(t, repo.Tokens, "no session is opened")
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectRole, *user.Role)
+			if tt.expectID != "" {
+				assert.Equal(t, tt.expectID, user.ID)
+			}
+			stored := repo.Users[tt.user.Email]
+			require.NotNil(t, stored)
+			assert.Equal(t, tt.expectRole, stored.Role)
+			assert.Equal(t, user.ID, repo.Identities["oidc|"+tt.user.Subject])
+
+			claims := jwt.MapClaims{}
+			_, err = signer.Parse(tokens.Access, claims)
+			require.NoError(t, err)
+			assert.Equal(t, user.ID.String(), claims["sub"])
+			assert.Equal(t, tt.expectRole, claims["role"])
+		})
+	}
+}
+
+func TestAuthUsecase_ExternalLoginDisabled(t *testing.T) {
+	uc := NewAuthUsecase(&DummyAuthRepo{}, newTestSigner(t))
+
+	_, err := uc.StartExternalLogin(context.Background())
+	assert.ErrorIs(t, err, auth.ErrSSODisabled)
+	_, _, err = uc.CompleteExternalLogin(context.Background(), "code", "verifier", "nonce")
+	assert.ErrorIs(t, err, auth.ErrSSODisabled)
+}
+
+func TestAuthUsecase_LoginDisabled(t *testing.T) {
+	const userID = strfmt.UUID("44444444-4444-4444-4444-444444444444")
+
+	repo := &DummyAuthRepo{
+		Tokens: map[string]*auth.RefreshToken{},
+		Users: map[string]*dummyUser{"blocked@corp.example": {
+			ID:       userID,
+			Role:     "employee",
+			Hash:     password.LegacyHash([]byte("saltsalt"), "secret"),
+			Disabled: true,
+		}},
+	}
+	uc := NewAuthUsecase(repo, newTestSigner(t))
+
+	_, _, err := uc.Login(context.Background(), "blocked@corp.example", "wrong", "")
+	assert.ErrorIs(t, err, auth.ErrInvalidPassword, "wrong password does not reveal the block")
+
+	_, _, err = uc.Login(context.Background(), "blocked@corp.example", "secret", "")
+	assert.ErrorIs(t, err, auth.ErrUserDisabled)
+	assert.Empty(t, repo.Tokens)
+}
+
+func TestAuthUsecase_UpdateUser(t *testing.T) {
+	const (
+		actorID = strfmt.UUID("55555555-5555-5555-5555-555555555555")
+		userID  = strfmt.UUID("66666666-6666-6666-6666-666666666666")
+	)
+
+	tests := []struct {
+		name          string
+		actor         strfmt.UUID
+		target        strfmt.UUID
+		disabled      bool
+		patch         *models.UserPatch
+		expectError   error
+		expectRole    string
+		expectRevoked bool
+	}{
+		{
+			name:          "Role change revokes sessions",
+			actor:         actorID,
+			target:        userID,
+			patch:         &models.UserPatch{Role: swag.String(models.UserRoleModerator)},
+			expectRole:    models.UserRoleModerator,
+			expectRevoked: true,
+		},
+		{
+			name:          "Disabling revokes sessions",
+			actor:         actorID,
+			target:        userID,
+			patch:         &models.UserPatch{Disabled: swag.Bool(true)},
+			expectRole:    models.UserRoleEmployee,
+			expectRevoked: true,
+		},
+		{
+			name:       "Enabling keeps sessions",
+			actor:      actorID,
+			target:     userID,
+			disabled:   true,
+			patch:      &models.UserPatch{Disabled: swag.Bool(false)},
+			expectRole: models.UserRoleEmployee,
+		},
+		{
+			name:       "Same role is a no-op",
+			actor:      actorID,
+			target:     userID,
+			patch:      &models.UserPatch{Role: swag.String(models.UserRoleEmployee)},
+			expectRole: models.UserRoleEmployee,
+		},
+		{
+			name:        "Cannot change own role",
+			actor:       userID,
+			target:      userID,
+			patch:       &models.UserPatch{Role: swag.String(models.UserRoleModerator)},
+			expectError: auth.ErrSelfModification,
+		},
+		{
+			name:        "Moderator cannot disable themselves",
+			actor:       userID,
+			target:      userID,
+			patch:       &models.UserPatch{Disabled: swag.Bool(true)},
+			expectError: auth.ErrSelfModification,
+		},
+		{
+			name:        "Dummy moderator cannot disable others",
+			actor:       "",
+			target:      userID,
+			patch:       &models.UserPatch{Disabled: swag.Bool(true)},
+			expectError: auth.ErrForbidden,
+		},
+		{
+			name:        "Unknown user",
+			actor:       actorID,
+			target:      strfmt.UUID("77777777-7777-7777-7777-777777777777"),
+			patch:       &models.UserPatch{Disabled: swag.Bool(true)},
+			expectError: auth.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &DummyAuthRepo{Users: map[string]*dummyUser{
+				"user@corp.example": {ID: userID, Role: models.UserRoleEmployee, Disabled: tt.disabled},
+			}}
+			uc := NewAuthUsecase(repo, newTestSigner(t))
+
+			user, err := uc.UpdateUser(context.Background(), tt.actor, tt.target, tt.patch)
+			if tt.expectError != nil {
+				assert.ErrorIs(t, err, tt.expectError)
+				assert.Equal(t, models.UserRoleEmployee, repo.Users["user@corp.example"].Role)
+				assert.Empty(t, repo.RevokedUsers)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.expectRole, *user.Role)
+			if tt.expectRevoked {
+				assert.Equal(t, []strfmt.UUID{userID}, repo.RevokedUsers)
+			} else {
+				assert.Empty(t, repo.RevokedUsers)
+			}
+		})
+	}
+}
+
+func TestAuthUsecase_DeleteUser(t *testing.T) {
+	const userID = strfmt.UUID("66666666-6666-6666-6666-666666666666")
+
+	repo := &DummyAuthRepo{Users: map[string]*dummyUser{
+		"user@corp.example": {ID: userID, Role: models.UserRoleModerator},
+	}}
+	uc := NewAuthUsecase(repo, newTestSigner(t))
+	ctx := context.Background()
+
+	assert.ErrorIs(t, uc.DeleteUser(ctx, userID, userID), auth.ErrSelfModification)
+	assert.Contains(t, repo.Users, "user@corp.example")
+	assert.ErrorIs(t, uc.DeleteUser(ctx, "", userID), auth.ErrForbidden)
+	assert.Contains(t, repo.Users, "user@corp.example")
+
+	otherID := strfmt.UUID("55555555-5555-5555-5555-555555555555")
+	assert.NoError(t, uc.DeleteUser(ctx, otherID, userID))
+	assert.Empty(t, repo.Users)
+	assert.ErrorIs(t, uc.DeleteUser(ctx, otherID, userID), auth.ErrUserNotFound)
+}
+
+func TestAuthUsecase_SignUpPolicy(t *testing.T) {
+	moderator := &auth.Principal{UserID: "55555555-5555-5555-5555-555555555555", Role: models.UserRoleModerator}
+	dummyModerator := &auth.Principal{Role: models.UserRoleModerator}
+
+	tests := []struct {
+		name          string
+		actor         *auth.Principal
+		email         string
+		role          string
+		expectError   error
+		expectSession bool
+	}{
+		{
+			name:          "Employee from allowed domain",
+			email:         "ivan@corp.example",
+			role:          models.UserRoleEmployee,
+			expectSession: true,
+		},
+		{
+			name:        "Employee from other domain",
+			email:       "ivan@mail.example",
+			role:        models.UserRoleEmployee,
+			expectError: auth.ErrEmailDomainNotAllowed,
+		},
+		{
+			name:        "Moderator cannot sign up alone",
+			email:       "boss@corp.example",
+			role:        models.UserRoleModerator,
+			expectError: auth.ErrModeratorSignUp,
+		},
+		{
+			name:        "Dummy moderator token is not a moderator",
+			actor:       dummyModerator,
+			email:       "boss@corp.example",
+			role:        models.UserRoleModerator,
+			expectError: auth.ErrModeratorSignUp,
+		},
+		{
+			name:  "Moderator creates a moderator",
+			actor: moderator,
+			email: "boss@corp.example",
+			role:  models.UserRoleModerator,
+		},
+		{
+			name:  "Moderator bypasses the domain list",
+			actor: moderator,
+			email: "contractor@mail.example",
+			role:  models.UserRoleEmployee,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &DummyAuthRepo{Tokens: map[string]*auth.RefreshToken{}, Users: map[string]*dummyUser{}}
+			uc := NewAuthUsecase(repo, newTestSigner(t)).
+				WithRegistrationPolicy(auth.RegistrationPolicy{AllowedDomains: []string{"corp.example"}})
+			ctx := context.Background()
+			if tt.actor != nil {
+				ctx = auth.WithPrincipal(ctx, tt.actor)
+			}
+
+			user, tokens, err := uc.SignUp(ctx, tt.email, "password", tt.role, "")
+			if tt.expectError != nil {
+				assert.ErrorIs(t, err, tt.expectError)
+				assert.Empty(t, repo.Users)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.role, *user.Role)
+			assert.Equal(t, tt.role, repo.Users[tt.email].Role)
+			assert.Equal(t, tt.expectSession, tokens != nil)
+			assert.Equal(t, tt.expectSession, len(repo.Tokens) > 0)
+		})
+	}
+}
+
+func TestAuthUsecase_SignUpWithInvite(t *testing.T) {
+	const moderatorID = strfmt.UUID("55555555-5555-5555-5555-555555555555")
+
+	tests := []struct {
+		name        string
+		inviteEmail string
+		email       string
+		role        string
+		expectError error
+	}{
+		{name: "Role comes from the invite", email: "boss@mail.example"},
+		{name: "Matching role", email: "boss@mail.example", role: models.UserRoleModerator},
+		{name: "Invite for this email", inviteEmail: "boss@mail.example", email: "boss@mail.example"},
+		{
+			name:        "Invite for another email",
+			inviteEmail: "boss@mail.example",
+			email:       "other@mail.example",
+			expectError: auth.ErrInvalidInvite,
+		},
+		{
+			name:        "Role differs from the invite",
+			email:       "boss@mail.example",
+			role:        models.UserRoleEmployee,
+			expectError: auth.ErrInvalidInvite,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &DummyAuthRepo{
+				Tokens:  map[string]*auth.RefreshToken{},
+				Users:   map[string]*dummyUser{},
+				Invites: map[string]*auth.Invite{},
+			}
+			uc := NewAuthUsecase(repo, newTestSigner(t)).
+				WithRegistrationPolicy(auth.RegistrationPolicy{AllowedDomains: []string{"corp.example"}})
+			ctx := context.Background()
+
+			invite, err := uc.CreateInvite(ctx, moderatorID, models.UserRoleModerator, tt.inviteEmail, 0)
+			require.NoError(t, err)
+			require.NotEmpty(t, invite.Code)
+			assert.NotContains(t, repo.Invites, invite.Code, "only the hash is stored")
+			assert.WithinDuration(t, time.Now().Add(DefaultInviteTTL), time.Time(*invite.ExpiresAt), time.Minute)
+
+			user, tokens, err := uc.SignUp(ctx, tt.email, "password", tt.role, invite.Code)
+			if tt.expectError != nil {
+				assert.ErrorIs(t, err, tt.expectError)
+				assert.Empty(t, repo.Users)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, models.UserRoleModerator, *user.Role)
+			assert.NotNil(t, tokens)
+
+			_, _, err = uc.SignUp(ctx, "second@mail.example", "password", "", invite.Code)
+			assert.ErrorIs(t, err, auth.ErrInvalidInvite, "invite is single-use")
+		})
+	}
+}
+
+func TestAuthUsecase_CreateInviteWithoutActor(t *testing.T) {
+	repo := &DummyAuthRepo{Users: map[string]*dummyUser{}, Invites: map[string]*auth.Invite{}}
+	uc := NewAuthUsecase(repo, newTestSigner(t))
+
+	invite, err := uc.CreateInvite(context.Background(), "", models.UserRoleModerator, "", 0)
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+	assert.Nil(t, invite)
+	assert.Empty(t, repo.Invites)
+}
+
+func TestAuthUsecase_SignUpExpiredInvite(t *testing.T) {
+	repo := &DummyAuthRepo{Users: map[string]*dummyUser{}, Invites: map[string]*auth.Invite{}}
+	uc := NewAuthUsecase(repo, newTestSigner(t))
+
+	invite, err := uc.CreateInvite(context.Background(), "55555555-5555-5555-5555-555555555555", models.UserRoleEmployee, "", time.Hour)
+	require.NoError(t, err)
+	for _, stored := range repo.Invites {
+		stored.ExpiresAt = time.Now().Add(-time.Minute)
+	}
+
+	_, _, err = uc.SignUp(context.Background(), "late@corp.example", "password", "", invite.Code)
+	assert.ErrorIs(t, err, auth.ErrInvalidInvite)
+}
+
+func TestAuthUsecase_Bootstrap(t *testing.T) {
+	repo := &DummyAuthRepo{Users: map[string]*dummyUser{
+		"ivan@corp.example": {ID: "66666666-6666-6666-6666-666666666666", Role: models.UserRoleEmployee},
+	}}
+	uc := NewAuthUsecase(repo, nil)
+	ctx := context.Background()
+
+	user, err := uc.Bootstrap(ctx, "admin@corp.example", "password")
+	require.NoError(t, err)
+	assert.Equal(t, models.UserRoleModerator, *user.Role)
+	ok, _, err := password.NewHasher(password.DefaultParams).Verify(repo.Users["admin@corp.example"].Hash, "password")
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	_, err = uc.Bootstrap(ctx, "second@corp.example", "password")
+	assert.ErrorIs(t, err, auth.ErrAlreadyBootstrapped)
+	assert.NotContains(t, repo.Users, "second@corp.example")
+}
+
+func TestAuthUsecase_LoginThrottle(t *testing.T) {
+	const userID = strfmt.UUID("44444444-4444-4444-4444-444444444444")
+
+	repo := &DummyAuthRepo{
+		Tokens: map[string]*auth.RefreshToken{},
+		Users: map[string]*dummyUser{"ivan@corp.example": {
+			ID:   userID,
+			Role: models.UserRoleEmployee,
+			Hash: password.LegacyHash([]byte("saltsalt"), "secret"),
+		}},
+	}
+	policy := throttle.Policy{Threshold: 3, BaseDelay: time.Minute, MaxDelay: time.Hour, ResetAfter: time.Hour}
+	uc := NewAuthUsecase(repo, newTestSigner(t)).
+		WithLoginThrottler(throttle.NewLimiter(throttle.NewMemoryStore(), policy, policy))
+	ctx := context.Background()
+
+	_, _, err := uc.Login(ctx, "ivan@corp.example", "wrong", "10.0.0.1")
+	assert.ErrorIs(t, err, auth.ErrInvalidPassword)
+
+	// Успешный вход сбрасывает счетчик аккаунта.
+	_, _, err = uc.Login(ctx, "ivan@corp.example", "secret", "10.0.0.1")
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		_, _, err = uc.Login(ctx, "ivan@corp.example", "wrong", "10.0.0.2")
+		assert.ErrorIs(t, err, auth.ErrInvalidPassword)
+	}
+	_, _, err = uc.Login(ctx, "ivan@corp.example", "wrong", "10.0.0.3")
+	var lockout *auth.LockoutError
+	require.ErrorAs(t, err, &lockout, "the attempt that trips the limit is already refused")
+	assert.Equal(t, time.Minute, lockout.RetryAfter)
+
+	_, _, err = uc.Login(ctx, "ivan@corp.example", "secret", "10.0.0.4")
+	assert.ErrorIs(t, err, auth.ErrTooManyAttempts, "the right password does not help while locked")
+
+	assert.ErrorIs(t, uc.UnlockUser(ctx, "", userID), auth.ErrForbidden)
+	_, _, err = uc.Login(ctx, "ivan@corp.example", "secret", "10.0.0.4")
+	assert.ErrorIs(t, err, auth.ErrTooManyAttempts, "an unlock without an actor is refused")
+
+	require.NoError(t, uc.UnlockUser(ctx, "55555555-5555-5555-5555-555555555555", userID))
+	_, _, err = uc.Login(ctx, "ivan@corp.example", "secret", "10.0.0.4")
+	assert.NoError(t, err)
+
+	// Несуществующий пользователь считается так же, чтобы перебор email тоже упирался в блокировку.
+	for i := 0; i < 2; i++ {
+		_, _, err = uc.Login(ctx, "ghost@corp.example", "secret", "10.0.0.5")
+		assert.ErrorIs(t, err, auth.ErrInvalidLogin)
+	}
+	_, _, err = uc.Login(ctx, "ghost@corp.example", "secret", "10.0.0.5")
+	assert.ErrorIs(t, err, auth.ErrTooManyAttempts)
+
+	assert.ErrorIs(t, uc.UnlockUser(ctx, "55555555-5555-5555-5555-555555555555", "77777777-7777-7777-7777-777777777777"), auth.ErrUserNotFound)
+}
+
+func TestAuthUsecase_LoginRehashesLegacyHash(t *testing.T) {
+	const userID = strfmt.UUID("44444444-4444-4444-4444-444444444444")
+
+	repo := &DummyAuthRepo{
+		Tokens: map[string]*auth.RefreshToken{},
+		Users: map[string]*dummyUser{"ivan@corp.example": {
+			ID:   userID,
+			Role: models.UserRoleEmployee,
+			Hash: password.LegacyHash([]byte("saltsalt"), "secret"),
+		}},
+	}
+	uc := NewAuthUsecase(repo, newTestSigner(t))
+	ctx := context.Background()
+
+	_, _, err := uc.Login(ctx, "ivan@corp.example", "secret", "")
+	require.NoError(t, err)
+	rehashed := repo.Users["ivan@corp.example"].Hash
+	assert.True(t, strings.HasPrefix(rehashed, "$argon2id$"), "legacy hash is upgraded to PHC on login")
+
+	_, _, err = uc.Login(ctx, "ivan@corp.example", "secret", "")
+	require.NoError(t, err)
+	assert.Equal(t, rehashed, repo.Users["ivan@corp.example"].Hash, "current hash is left alone")
+}
+
+func TestAuthUsecase_ChangePassword(t *testing.T) {
+	const userID = strfmt.UUID("44444444-4444-4444-4444-444444444444")
+
+	tests := []struct {
+		name        string
+		principal   *auth.Principal
+		oldPassword string
+		newPassword string
+		expectError error
+	}{
+		{
+			name:        "Success",
+			principal:   &auth.Principal{UserID: userID, Email: "ivan@corp.example", SessionID: "current"},
+			oldPassword: "secret",
+			newPassword: "n3w-passw0rd",
+		},
+		{
+			name:        "Wrong old password",
+			principal:   &auth.Principal{UserID: userID, Email: "ivan@corp.example", SessionID: "current"},
+			oldPassword: "wrong",
+			newPassword: "n3w-passw0rd",
+			expectError: auth.ErrInvalidPassword,
+		},
+		{
+			name:        "Same password",
+			principal:   &auth.Principal{UserID: userID, Email: "ivan@corp.example", SessionID: "current"},
+			oldPassword: "secret",
+			newPassword: "secret",
+			expectError: auth.ErrSamePassword,
+		},
+		{
+			name:        "Weak password",
+			principal:   &auth.Principal{UserID: userID, Email: "ivan@corp.example", SessionID: "current"},
+			oldPassword: "secret",
+			newPassword: "ivan1234",
+			expectError: auth.ErrWeakPassword,
+		},
+		{
+			name:        "Dummy token",
+			principal:   &auth.Principal{Role: models.UserRoleEmployee},
+			oldPassword: "secret",
+			newPassword: "n3w-passw0rd",
+			expectError: auth.ErrUserNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			legacy := password.LegacyHash([]byte("saltsalt"), "secret")
+			repo := &DummyAuthRepo{
+				Tokens: map[string]*auth.RefreshToken{
+					"current": {UserID: userID, FamilyID: "current"},
+					"other":   {UserID: userID, FamilyID: "other"},
+				},
+				Users: map[string]*dummyUser{"ivan@corp.example": {ID: userID, Role: models.UserRoleEmployee, Hash: legacy}},
+			}
+			uc := NewAuthUsecase(repo, newTestSigner(t))
+			ctx := auth.WithPrincipal(context.Background(), tt.principal)
+
+			err := uc.ChangePassword(ctx, tt.oldPassword, tt.newPassword)
+			if tt.expectError != nil {
+				assert.ErrorIs(t, err, tt.expectError)
+				assert.Equal(t, legacy, repo.Users["ivan@corp.example"].Hash)
+				assert.Nil(t, repo.Tokens["other"].RevokedAt)
+				return
+			}
+			require.NoError(t, err)
+
+			_, _, err = uc.Login(context.Background(), "ivan@corp.example", tt.newPassword, "")
+			assert.NoError(t, err)
+			assert.Nil(t, repo.Tokens["current"].RevokedAt, "the session that changed the password stays open")
+			assert.NotNil(t, repo.Tokens["other"].RevokedAt)
+		})
+	}
+}
+
+// tokenFromMail достает токен из ссылки в письме.
+func tokenFromMail(t *testing.T, msg *auth.MailMessage) string {
+	t.Helper()
+	match := regexp.MustCompile(`https://pvz\.example/\w+\?token=(\S+)`).FindStringSubmatch(msg.Body)
+	require.Len(t, match, 2, "link is in the mail body")
+	token, err := url.QueryUnescape(match[1])
+	require.NoError(t, err)
+	return token
+}
+
+func newResetRepo() *DummyAuthRepo {
+	return &DummyAuthRepo{
+		Tokens: map[string]*auth.RefreshToken{},
+		Resets: map[string]*auth.PasswordReset{},
+		Users: map[string]*dummyUser{
+			"ivan@corp.example": {
+				ID:   "44444444-4444-4444-4444-444444444444",
+				Role: models.UserRoleEmployee,
+				Hash: password.LegacyHash([]byte("saltsalt"), "secret"),
+			},
+			"blocked@corp.example": {
+				ID:       "55555555-5555-5555-5555-555555555555",
+				Role:     models.UserRoleEmployee,
+				Hash:     password.LegacyHash([]byte("saltsalt"), "secret"),
+				Disabled: true,
+			},
+			"sso@corp.example": {ID: "66666666-6666-6666-6666-666666666666", Role: models.UserRoleEmployee},
+		},
+	}
+}
+
+func TestAuthUsecase_PasswordReset(t *testing.T) {
+	repo := newResetRepo()
+	mailer := &DummyMailer{}
+	uc := NewAuthUsecase(repo, newTestSigner(t)).WithPasswordReset(mailer, "https://pvz.example/reset", nil)
+	ctx := context.Background()
+
+	require.NoError(t, uc.ForgotPassword(ctx, "ivan@corp.example"))
+	require.Len(t, mailer.Sent, 1)
+	assert.Equal(t, "ivan@corp.example", mailer.Sent[0].To)
+	token := tokenFromMail(t, mailer.Sent[0])
+	assert.NotContains(t, repo.Resets, token, "only the hash is stored")
+
+	err := uc.ResetPassword(ctx, token, "ivan-2024")
+	assert.ErrorIs(t, err, auth.ErrWeakPassword)
+
+	require.NoError(t, uc.ResetPassword(ctx, token, "n3w-passw0rd"))
+	assert.Equal(t, []strfmt.UUID{"44444444-4444-4444-4444-444444444444"}, repo.RevokedUsers)
+	_, _, err = uc.Login(ctx, "ivan@corp.example", "n3w-passw0rd", "")
+	assert.NoError(t, err)
+	_, _, err = uc.Login(ctx, "ivan@corp.example", "secret", "")
+	assert.ErrorIs(t, err, auth.ErrInvalidPassword)
+
+	err = uc.ResetPassword(ctx, token, "an0ther-passw0rd")
+	assert.ErrorIs(t, err, auth.ErrInvalidResetToken, "token is single-use")
+	err = uc.ResetPassword(ctx, "made-up", "an0ther-passw0rd")
+	assert.ErrorIs(t, err, auth.ErrInvalidResetToken)
+}
+
+func TestAuthUsecase_PasswordResetExpired(t *testing.T) {
+	repo := newResetRepo()
+	mailer := &DummyMailer{}
+	uc := NewAuthUsecase(repo, newTestSigner(t)).WithPasswordReset(mailer, "https://pvz.example/reset", nil)
+	ctx := context.Background()
+
+	require.NoError(t, uc.ForgotPassword(ctx, "ivan@corp.example"))
+	for _, reset := range repo.Resets {
+		reset.ExpiresAt = time.Now().Add(-time.Minute)
+	}
+
+	err := uc.ResetPassword(ctx, tokenFromMail(t, mailer.Sent[0]), "n3w-passw0rd")
+	assert.ErrorIs(t, err, auth.ErrInvalidResetToken)
+	assert.Empty(t, repo.RevokedUsers)
+}
+
+func TestAuthUsecase_ForgotPasswordSilent(t *testing.T) {
+	tests := []struct {
+		name  string
+		email string
+	}{
+		{"Unknown email", "ghost@corp.example"},
+		{"Disabled user", "blocked@corp.example"},
+		{"SSO user without password", "sso@corp.example"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newResetRepo()
+			mailer := &DummyMailer{}
+			uc := NewAuthUsecase(repo, newTestSigner(t)).WithPasswordReset(mailer, "https://pvz.example/reset", nil)
+
+			assert.NoError(t, uc.ForgotPassword(context.Background(), tt.email), "response does not reveal the account")
+			assert.Empty(t, mailer.Sent)
+			assert.Empty(t, repo.Resets)
+		})
+	}
+}
+
+func TestAuthUsecase_ForgotPasswordLimits(t *testing.T) {
+	ctx := context.Background()
+
+	uc := NewAuthUsecase(newResetRepo(), newTestSigner(t))
+	assert.ErrorIs(t, uc.ForgotPassword(ctx, "ivan@corp.example"), auth.ErrPasswordResetDisabled)
+
+	mailer := &DummyMailer{}
+	policy := throttle.Policy{Threshold: 2, BaseDelay: time.Minute, MaxDelay: time.Hour, ResetAfter: time.Hour}
+	uc = NewAuthUsecase(newResetRepo(), newTestSigner(t)).WithPasswordReset(mailer, "https://pvz.example/reset",
+		throttle.NewRequestLimiter(throttle.NewMemoryStore(), "reset", policy))
+
+	for i := 0; i < 2; i++ {
+		require.NoError(t, uc.ForgotPassword(ctx, "ivan@corp.example"))
+	}
+	err := uc.ForgotPassword(ctx, "ivan@corp.example")
+	var lockout *auth.LockoutError
+	require.ErrorAs(t, err, &lockout)
+	assert.ErrorIs(t, err, auth.ErrTooManyResetRequests)
+	assert.NotErrorIs(t, err, auth.ErrTooManyAttempts)
+	assert.Len(t, mailer.Sent, 2)
+
+	assert.NoError(t, uc.ForgotPassword(ctx, "ghost@corp.example"), "limits are per email")
+}
+
+func TestAuthUsecase_APIKey(t *testing.T) {
+	ctx := context.Background()
+	pvzID := strfmt.UUID("33333333-3333-3333-3333-333333333333")
+
+	repo := &DummyAuthRepo{APIKeys: map[string]*auth.APIKey{}}
+	uc := NewAuthUsecase(repo, newTestSigner(t))
+
+	created, err := uc.CreateAPIKey(ctx, "11111111-1111-1111-1111-111111111111", "scanner",
+		models.UserRoleEmployee, []strfmt.UUID{pvzID}, time.Hour)
+	require.NoError(t, err)
+	assert.Regexp(t, `^pvz_[0-9a-f]{16}_`, created.Key)
+	assert.NotNil(t, created.ExpiresAt)
+	for _, key := range repo.APIKeys {
+		assert.NotContains(t, created.Key, key.SecretHash, "only the hash is stored")
+	}
+
+	principal, err := uc.AuthenticateAPIKey(ctx, created.Key)
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, principal.APIKeyID)
+	assert.Equal(t, models.UserRoleEmployee, principal.Role)
+	assert.Empty(t, principal.UserID)
+	assert.True(t, principal.CanAccessPVZ(pvzID))
+	assert.False(t, principal.CanAccessPVZ("44444444-4444-4444-4444-444444444444"))
+
+	_, err = uc.AuthenticateAPIKey(ctx, created.Key)
+	require.NoError(t, err)
+	assert.Equal(t, 1, repo.Touched, "last_used_at is throttled")
+
+	_, err = uc.AuthenticateAPIKey(ctx, created.Key+"x")
+	assert.ErrorIs(t, err, auth.ErrInvalidAPIKey)
+	_, err = uc.AuthenticateAPIKey(ctx, "Bearer "+created.Key)
+	assert.ErrorIs(t, err, auth.ErrInvalidAPIKey)
+
+	require.NoError(t, uc.RevokeAPIKey(ctx, created.ID))
+	_, err = uc.AuthenticateAPIKey(ctx, created.Key)
+	assert.ErrorIs(t, err, auth.ErrInvalidAPIKey)
+	assert.ErrorIs(t, uc.RevokeAPIKey(ctx, created.ID), auth.ErrAPIKeyNotFound)
+
+	_, err = uc.CreateAPIKey(ctx, "", "orphan", models.UserRoleModerator, nil, 0)
+	assert.ErrorIs(t, err, auth.ErrForbidden)
+	assert.Len(t, repo.APIKeys, 1)
+}
+
+func TestAuthUsecase_APIKeyExpired(t *testing.T) {
+	ctx := context.Background()
+
+	repo := &DummyAuthRepo{APIKeys: map[string]*auth.APIKey{}}
+	uc := NewAuthUsecase(repo, newTestSigner(t))
+
+	created, err := uc.CreateAPIKey(ctx, "11111111-1111-1111-1111-111111111111", "exporter", models.UserRoleModerator, nil, time.Hour)
+	require.NoError(t, err)
+	for _, key := range repo.APIKeys {
+		expired := time.Now().Add(-time.Second)
+		key.ExpiresAt = &expired
+	}
+
+	_, err = uc.AuthenticateAPIKey(ctx, created.Key)
+	assert.ErrorIs(t, err, auth.ErrInvalidAPIKey)
+	assert.Zero(t, repo.Touched)
+}
+
+func TestAuthUsecase_AssignPVZ(t *testing.T) {
+	ctx := context.Background()
+	pvzID := strfmt.UUID("33333333-3333-3333-3333-333333333333")
+	employee := &dummyUser{ID: "22222222-2222-2222-2222-222222222222", Role: models.UserRoleEmployee}
+	moderator := &dummyUser{ID: "11111111-1111-1111-1111-111111111111", Role: models.UserRoleModerator}
+
+	repo := &DummyAuthRepo{
+		Users:       map[string]*dummyUser{"ivan@corp.example": employee, "anna@corp.example": moderator},
+		PVZs:        map[strfmt.UUID]bool{pvzID: true},
+		Assignments: map[strfmt.UUID][]strfmt.UUID{},
+	}
+	uc := NewAuthUsecase(repo, newTestSigner(t))
+
+	assert.ErrorIs(t, uc.AssignPVZ(ctx, "", employee.ID, pvzID), auth.ErrForbidden)
+	assert.Empty(t, repo.Assignments[employee.ID])
+	assert.ErrorIs(t, uc.AssignPVZ(ctx, moderator.ID, moderator.ID, pvzID), auth.ErrNotEmployee)
+	assert.ErrorIs(t, uc.AssignPVZ(ctx, moderator.ID, employee.ID, "44444444-4444-4444-4444-444444444444"), auth.ErrPVZNotFound)
+	assert.ErrorIs(t, uc.AssignPVZ(ctx, moderator.ID, "55555555-5555-5555-5555-555555555555", pvzID), auth.ErrUserNotFound)
+
+	require.NoError(t, uc.AssignPVZ(ctx, moderator.ID, employee.ID, pvzID))
+	require.NoError(t, uc.AssignPVZ(ctx, moderator.ID, employee.ID, pvzID), "assignment is idempotent")
+	assert.Len(t, repo.Assignments[employee.ID], 1)
+
+	assert.ErrorIs(t, uc.UnassignPVZ(ctx, "", employee.ID, pvzID), auth.ErrForbidden)
+	require.NoError(t, uc.UnassignPVZ(ctx, moderator.ID, employee.ID, pvzID))
+	assert.ErrorIs(t, uc.UnassignPVZ(ctx, moderator.ID, employee.ID, pvzID), auth.ErrAssignmentNotFound)
+}
+
+func TestAuthUsecase_AuthorizePVZ(t *testing.T) {
+	assigned := strfmt.UUID("33333333-3333-3333-3333-333333333333")
+	other := strfmt.UUID("44444444-4444-4444-4444-444444444444")
+	employeeID := strfmt.UUID("22222222-2222-2222-2222-222222222222")
+
+	tests := []struct {
+		name      string
+		principal *auth.Principal
+		pvzID     strfmt.UUID
+		expected  error
+	}{
+		{"Assigned employee", &auth.Principal{UserID: employeeID, Role: models.UserRoleEmployee}, assigned, nil},
+		{"Employee at another PVZ", &auth.Principal{UserID: employeeID, Role: models.UserRoleEmployee}, other, auth.ErrPVZForbidden},
+		{"Unassigned employee", &auth.Principal{UserID: "55555555-5555-5555-5555-555555555555", Role: models.UserRoleEmployee}, assigned, auth.ErrPVZForbidden},
+		{"Moderator", &auth.Principal{UserID: "11111111-1111-1111-1111-111111111111", Role: models.UserRoleModerator}, other, nil},
+		{"Scoped API key", &auth.Principal{APIKeyID: "66666666-6666-6666-6666-666666666666", Role: models.UserRoleEmployee, PVZIDs: []strfmt.UUID{other}}, other, nil},
+		{"API key out of scope", &auth.Principal{APIKeyID: "66666666-6666-6666-6666-666666666666", Role: models.UserRoleEmployee, PVZIDs: []strfmt.UUID{other}}, assigned, auth.ErrPVZForbidden},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &DummyAuthRepo{Assignments: map[strfmt.UUID][]strfmt.UUID{employeeID: {assigned}}}
+			uc := NewAuthUsecase(repo, newTestSigner(t))
+
+			err := uc.AuthorizePVZ(auth.WithPrincipal(context.Background(), tt.principal), tt.pvzID)
+			if tt.expected == nil {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorIs(t, err, tt.expected)
+			}
+		})
+	}
+}
+
+func TestAuthUsecase_Sessions(t *testing.T) {
+	userID := strfmt.UUID("11111111-1111-1111-1111-111111111111")
+	otherID := strfmt.UUID("22222222-2222-2222-2222-222222222222")
+	repo := &DummyAuthRepo{Tokens: map[string]*auth.RefreshToken{}}
+	uc := NewAuthUsecase(repo, newTestSigner(t))
+
+	terminal := auth.WithDevice(context.Background(), auth.Device{Label: "Терминал 3", UserAgent: "TSD-Scanner/2.1", IP: "10.0.0.7"})
+	_, err := uc.startSession(terminal, auth.Principal{UserID: userID, Role: "employee"})
+	require.NoError(t, err)
+	laptop, err := uc.startSession(context.Background(), auth.Principal{UserID: userID, Role: "employee"})
+	require.NoError(t, err)
+	_, err = uc.startSession(context.Background(), auth.Principal{UserID: otherID, Role: "employee"})
+	require.NoError(t, err)
+
+	var terminalID, laptopID strfmt.UUID
+	for _, token := range repo.Tokens {
+		if token.UserID != userID {
+			continue
+		}
+		if token.TokenHash == hashToken(laptop.Refresh) {
+			laptopID = token.FamilyID
+		} else {
+			terminalID = token.FamilyID
+		}
+	}
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: userID, Role: "employee", SessionID: laptopID.String()})
+
+	sessions, err := uc.ListSessions(ctx)
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	for _, session := range sessions {
+		assert.Equal(t, *session.ID == laptopID, *session.Current)
+		if *session.ID == terminalID {
+			assert.Equal(t, "Терминал 3", session.DeviceLabel)
+			assert.Equal(t, "10.0.0.7", session.IP)
+		}
+	}
+
+	t.Run("Revoke own session", func(t *testing.T) {
+		require.NoError(t, uc.RevokeSession(ctx, terminalID))
+		assert.NotNil(t, repo.Sessions[terminalID].RevokedAt)
+		assert.ErrorIs(t, uc.RevokeSession(ctx, terminalID), auth.ErrSessionNotFound)
+
+		sessions, err := uc.ListSessions(ctx)
+		require.NoError(t, err)
+		assert.Len(t, sessions, 1)
+	})
+
+	t.Run("Cannot revoke another user's session", func(t *testing.T) {
+		var otherSession strfmt.UUID
+		for id, session := range repo.Sessions {
+			if session.UserID == otherID {
+				otherSession = id
+			}
+		}
+		assert.ErrorIs(t, uc.RevokeSession(ctx, otherSession), auth.ErrSessionNotFound)
+		assert.Nil(t, repo.Sessions[otherSession].RevokedAt)
+	})
+
+	t.Run("Refresh updates last seen", func(t *testing.T) {
+		repo.Sessions[laptopID].LastSeenAt = time.Now().Add(-time.Hour)
+		_, err := uc.Refresh(context.Background(), laptop.Refresh)
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now(), repo.Sessions[laptopID].LastSeenAt, time.Minute)
+	})
+
+	t.Run("Without user", func(t *testing.T) {
+		keyCtx := auth.WithPrincipal(context.Background(), &auth.Principal{Role: "employee", APIKeyID: "key"})
+		_, err := uc.ListSessions(keyCtx)
+		assert.ErrorIs(t, err, auth.ErrUserNotFound)
+		assert.ErrorIs(t, uc.RevokeSession(keyCtx, laptopID), auth.ErrUserNotFound)
+	})
+}
+
+func TestAuthUsecase_RevokeUserSessions(t *testing.T) {
+	userID := strfmt.UUID("11111111-1111-1111-1111-111111111111")
+	moderatorID := strfmt.UUID("33333333-3333-3333-3333-333333333333")
+	repo := &DummyAuthRepo{
+		Tokens: map[string]*auth.RefreshToken{},
+		Users:  map[string]*dummyUser{"employee@example.com": {ID: userID, Role: "employee"}},
+	}
+	uc := NewAuthUsecase(repo, newTestSigner(t))
+
+	tokens, err := uc.startSession(context.Background(), auth.Principal{UserID: userID, Role: "employee"})
+	require.NoError(t, err)
+
+	require.NoError(t, uc.RevokeUserSessions(context.Background(), moderatorID, userID))
+	for _, session := range repo.Sessions {
+		assert.NotNil(t, session.RevokedAt)
+	}
+	_, err = uc.Refresh(context.Background(), tokens.Refresh)
+	assert.ErrorIs(t, err, auth.ErrInvalidRefreshToken)
+
+	err = uc.RevokeUserSessions(context.Background(), moderatorID, "44444444-4444-4444-4444-444444444444")
+	assert.ErrorIs(t, err, auth.ErrUserNotFound)
+}
+
+func TestAuthUsecase_GetMe(t *testing.T) {
+	userID := strfmt.UUID("22222222-2222-2222-2222-222222222222")
+	pvzID := strfmt.UUID("33333333-3333-3333-3333-333333333333")
+	repo := &DummyAuthRepo{
+		Users:       map[string]*dummyUser{"ivan@corp.example": {ID: userID, Role: models.UserRoleEmployee}},
+		Assignments: map[strfmt.UUID][]strfmt.UUID{userID: {pvzID}},
+	}
+	uc := NewAuthUsecase(repo, newTestSigner(t))
+
+	user, err := uc.GetMe(auth.WithPrincipal(context.Background(), &auth.Principal{UserID: userID, Role: models.UserRoleEmployee}))
+	require.NoError(t, err)
+	assert.Equal(t, "ivan@corp.example", user.Email.String())
+	require.Len(t, user.PvzAssignments, 1)
+	assert.Equal(t, pvzID, *user.PvzAssignments[0].PvzID)
+
+	_, err = uc.GetMe(auth.WithPrincipal(context.Background(), &auth.Principal{Role: models.UserRoleEmployee, APIKeyID: "key"}))
+	assert.ErrorIs(t, err, auth.ErrUserNotFound)
+	_, err = uc.GetMe(auth.WithPrincipal(context.Background(), &auth.Principal{UserID: "44444444-4444-4444-4444-444444444444"}))
+	assert.ErrorIs(t, err, auth.ErrUserNotFound)
+}
+
+func TestAuthUsecase_EmailChange(t *testing.T) {
+	repo := newResetRepo()
+	repo.EmailChanges = map[string]*auth.EmailChange{}
+	mailer := &DummyMailer{}
+	uc := NewAuthUsecase(repo, newTestSigner(t)).WithEmailVerification(mailer, "https://pvz.example/confirm", nil)
+	userID := repo.Users["ivan@corp.example"].ID
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: userID, Email: "ivan@corp.example"})
+	email := func(s string) *models.MePatch {
+		e := strfmt.Email(s)
+		return &models.MePatch{Email: &e, OldPassword: "secret"}
+	}
+
+	_, err := uc.UpdateMe(ctx, email("IVAN@corp.example"))
+	assert.ErrorIs(t, err, auth.ErrSameEmail)
+	_, err = uc.UpdateMe(ctx, email("sso@corp.example"))
+	assert.ErrorIs(t, err, auth.ErrEmailTaken)
+	assert.Empty(t, mailer.Sent)
+
+	user, err := uc.UpdateMe(ctx, email("old-pending@corp.example"))
+	require.NoError(t, err)
+	assert.Equal(t, strfmt.Email("old-pending@corp.example"), user.PendingEmail)
+	staleToken := tokenFromMail(t, mailer.Sent[0])
+
+	user, err = uc.UpdateMe(ctx, email("ivan.petrov@corp.example"))
+	require.NoError(t, err)
+	assert.Equal(t, "ivan@corp.example", user.Email.String(), "email changes only after confirmation")
+	assert.Equal(t, strfmt.Email("ivan.petrov@corp.example"), user.PendingEmail)
+	require.Len(t, mailer.Sent, 2)
+	assert.Equal(t, "ivan.petrov@corp.example", mailer.Sent[1].To)
+	token := tokenFromMail(t, mailer.Sent[1])
+	assert.NotContains(t, repo.EmailChanges, token, "only the hash is stored")
+
+	assert.ErrorIs(t, uc.ConfirmEmail(context.Background(), staleToken), auth.ErrInvalidEmailToken, "a new request cancels the previous one")
+
+	require.NoError(t, uc.ConfirmEmail(context.Background(), token))
+	assert.Contains(t, repo.Users, "ivan.petrov@corp.example")
+	assert.NotContains(t, repo.Users, "ivan@corp.example")
+	require.Len(t, mailer.Sent, 3)
+	assert.Equal(t, "ivan@corp.example", mailer.Sent[2].To, "the old address is notified")
+
+	_, _, err = uc.Login(context.Background(), "ivan.petrov@corp.example", "secret", "")
+	assert.NoError(t, err)
+	assert.ErrorIs(t, uc.ConfirmEmail(context.Background(), token), auth.ErrInvalidEmailToken, "token is single-use")
+	assert.ErrorIs(t, uc.ConfirmEmail(context.Background(), "made-up"), auth.ErrInvalidEmailToken)
+}
+
+func TestAuthUsecase_EmailChangeTaken(t *testing.T) {
+	repo := newResetRepo()
+	repo.EmailChanges = map[string]*auth.EmailChange{}
+	mailer := &DummyMailer{}
+	uc := NewAuthUsecase(repo, newTestSigner(t)).WithEmailVerification(mailer, "https://pvz.example/confirm", nil)
+	ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: repo.Users["ivan@corp.example"].ID})
+
+	newEmail := strfmt.Email("ivan.petrov@corp.example")
+	_, err := uc.UpdateMe(ctx, &models.MePatch{Email: &newEmail, OldPassword: "secret"})
+	require.NoError(t, err)
+	repo.Users["ivan.petrov@corp.example"] = &dummyUser{ID: "77777777-7777-7777-7777-777777777777", Role: models.UserRoleEmployee}
+
+	err = uc.ConfirmEmail(context.Background(), tokenFromMail(t, mailer.Sent[0]))
+	assert.ErrorIs(t, err, auth.ErrEmailTaken)
+	assert.Contains(t, repo.Users, "ivan@corp.example")
+	assert.Len(t, mailer.Sent, 1)
+}
+
+func TestAuthUsecase_UpdateMe(t *testing.T) {
+	newEmail := strfmt.Email("ivan.petrov@corp.example")
+	policy := throttle.Policy{Threshold: 1, BaseDelay: time.Minute, MaxDelay: time.Hour, ResetAfter: time.Hour}
+
+	tests := []struct {
+		name            string
+		principal       *auth.Principal
+		patch           *models.MePatch
+		verify          bool
+		expectError     error
+		expectMails     int
+		expectNewSecret bool
+	}{
+		{
+			name:            "Password change",
+			principal:       &auth.Principal{UserID: "44444444-4444-4444-4444-444444444444", Email: "ivan@corp.example"},
+			patch:           &models.MePatch{OldPassword: "secret", NewPassword: "n3w-passw0rd"},
+			expectNewSecret: true,
+		},
+		{
+			name:        "Wrong password stops email change",
+			principal:   &auth.Principal{UserID: "44444444-4444-4444-4444-444444444444", Email: "ivan@corp.example"},
+			patch:       &models.MePatch{Email: &newEmail, OldPassword: "wrong", NewPassword: "n3w-passw0rd"},
+			verify:      true,
+			expectError: auth.ErrInvalidPassword,
+		},
+		{
+			name:        "Email change requires password",
+			principal:   &auth.Principal{UserID: "44444444-4444-4444-4444-444444444444", Email: "ivan@corp.example"},
+			patch:       &models.MePatch{Email: &newEmail},
+			verify:      true,
+			expectError: auth.ErrInvalidPassword,
+		},
+		{
+			name:        "Email change disabled",
+			principal:   &auth.Principal{UserID: "44444444-4444-4444-4444-444444444444", Email: "ivan@corp.example"},
+			patch:       &models.MePatch{Email: &newEmail, OldPassword: "secret"},
+			expectError: auth.ErrEmailChangeDisabled,
+		},
+		{
+			name:        "Taken email keeps old password",
+			principal:   &auth.Principal{UserID: "44444444-4444-4444-4444-444444444444", Email: "ivan@corp.example"},
+			patch:       &models.MePatch{Email: emailPtr("sso@corp.example"), OldPassword: "secret", NewPassword: "n3w-passw0rd"},
+			verify:      true,
+			expectError: auth.ErrEmailTaken,
+		},
+		{
+			name:        "Weak password stops email change",
+			principal:   &auth.Principal{UserID: "44444444-4444-4444-4444-444444444444", Email: "ivan@corp.example"},
+			patch:       &models.MePatch{Email: &newEmail, OldPassword: "secret", NewPassword: "ivan1234"},
+			verify:      true,
+			expectError: auth.ErrWeakPassword,
+		},
+		{
+			name:        "API key",
+			principal:   &auth.Principal{Role: models.UserRoleEmployee, APIKeyID: "key"},
+			patch:       &models.MePatch{Email: &newEmail, OldPassword: "secret"},
+			verify:      true,
+			expectError: auth.ErrUserNotFound,
+		},
+		{
+			name:            "Password and email",
+			principal:       &auth.Principal{UserID: "44444444-4444-4444-4444-444444444444", Email: "ivan@corp.example"},
+			patch:           &models.MePatch{Email: &newEmail, OldPassword: "secret", NewPassword: "n3w-passw0rd"},
+			verify:          true,
+			expectMails:     1,
+			expectNewSecret: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := newResetRepo()
+			repo.EmailChanges = map[string]*auth.EmailChange{}
+			mailer := &DummyMailer{}
+			uc := NewAuthUsecase(repo, newTestSigner(t))
+			if tt.verify {
+				uc.WithEmailVerification(mailer, "https://pvz.example/confirm", nil)
+			}
+
+			_, err := uc.UpdateMe(auth.WithPrincipal(context.Background(), tt.principal), tt.patch)
+			if tt.expectError != nil {
+				assert.ErrorIs(t, err, tt.expectError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Len(t, mailer.Sent, tt.expectMails)
+			ok, _, _ := uc.hasher.Verify(repo.Users["ivan@corp.example"].Hash, "n3w-passw0rd")
+			assert.Equal(t, tt.expectNewSecret, ok)
+		})
+	}
+
+	t.Run("Email changes are limited per user", func(t *testing.T) {
+		repo := newResetRepo()
+		repo.EmailChanges = map[string]*auth.EmailChange{}
+		mailer := &DummyMailer{}
+		uc := NewAuthUsecase(repo, newTestSigner(t)).WithEmailVerification(mailer, "https://pvz.example/confirm",
+			throttle.NewRequestLimiter(throttle.NewMemoryStore(), "email", policy))
+		ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: repo.Users["ivan@corp.example"].ID})
+
+		_, err := uc.UpdateMe(ctx, &models.MePatch{Email: &newEmail, OldPassword: "secret"})
+		require.NoError(t, err)
+		_, err = uc.UpdateMe(ctx, &models.MePatch{Email: &newEmail, OldPassword: "wrong"})
+		assert.ErrorIs(t, err, auth.ErrInvalidPassword, "rejected requests do not use up the limit")
+		_, err = uc.UpdateMe(ctx, &models.MePatch{Email: &newEmail, OldPassword: "secret"})
+		var lockout *auth.LockoutError
+		require.ErrorAs(t, err, &lockout)
+		assert.ErrorIs(t, err, auth.ErrTooManyEmailChanges)
+		assert.Len(t, mailer.Sent, 1)
+	})
+
+	t.Run("Mail error", func(t *testing.T) {
+		repo := newResetRepo()
+		repo.EmailChanges = map[string]*auth.EmailChange{}
+		mailer := &DummyMailer{Err: errors.New("smtp down")}
+		uc := NewAuthUsecase(repo, newTestSigner(t)).WithEmailVerification(mailer, "https://pvz.example/confirm", nil)
+		ctx := auth.WithPrincipal(context.Background(), &auth.Principal{UserID: repo.Users["ivan@corp.example"].ID})
+
+		_, err := uc.UpdateMe(ctx, &models.MePatch{Email: &newEmail, OldPassword: "secret", NewPassword: "n3w-passw0rd"})
+		assert.ErrorIs(t, err, auth.ErrSendingMail)
+		ok, _, _ := uc.hasher.Verify(repo.Users["ivan@corp.example"].Hash, "secret")
+		assert.True(t, ok, "password is not changed when the mail is not sent")
+	})
+}
+
+func emailPtr(s string) *strfmt.Email {
+	email := strfmt.Email(s)
+	return &email
+}
@@ -0,0 +1,28 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type AuthMetrics struct {
+	FailedLogins *prometheus.CounterVec
+}
+
+func NewAuthMetrics() (*AuthMetrics, error) {
+	var metr AuthMetrics
+	metr.FailedLogins = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "failed_logins_total",
+			Help: "Number of failed password logins by reason.",
+		},
+		[]string{"reason"},
+	)
+	if err := prometheus.Register(metr.FailedLogins); err != nil {
+		return nil, err
+	}
+	return &metr, nil
+}
+
+func (m *AuthMetrics) IncreaseFailedLogins(reason string) {
+	m.FailedLogins.WithLabelValues(reason).Inc()
+}
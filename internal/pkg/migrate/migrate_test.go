@@ -0,0 +1,100 @@
+package migrate
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadMigrations(t *testing.T) {
+	tests := []struct {
+		name          string
+		files         fstest.MapFS
+		expectedErr   error
+		expectedNames []string
+	}{
+		{
+			name: "Should sort migrations by version",
+			files: fstest.MapFS{
+				"m/0002_second.up.sql":   {Data: []byte("SELECT 2;")},
+				"m/0002_second.down.sql": {Data: []byte("SELECT -2;")},
+				"m/0001_first.up.sql":    {Data: []byte("SELECT 1;")},
+				"m/0010_tenth.up.sql":    {Data: []byte("SELECT 10;")},
+			},
+			expectedNames: []string{"first", "second", "tenth"},
+		},
+		{
+			name: "Should reject invalid file name",
+			files: fstest.MapFS{
+				"m/first.sql": {Data: []byte("SELECT 1;")},
+			},
+			expectedErr: ErrInvalidFileName,
+		},
+		{
+			name: "Should reject duplicate version",
+			files: fstest.MapFS{
+				"m/0001_first.up.sql": {Data: []byte("SELECT 1;")},
+				"m/0001_other.up.sql": {Data: []byte("SELECT 1;")},
+			},
+			expectedErr: ErrDuplicateVersion,
+		},
+		{
+			name: "Should reject migration without up script",
+			files: fstest.MapFS{
+				"m/0001_first.down.sql": {Data: []byte("SELECT 1;")},
+			},
+			expectedErr: ErrMissingUp,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			migrations, err := LoadMigrations(tt.files, "m")
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var names []string
+			for _, m := range migrations {
+				names = append(names, m.Name)
+			}
+			assert.Equal(t, tt.expectedNames, names)
+		})
+	}
+}
+
+func TestLoadMigrationsChecksum(t *testing.T) {
+	files := fstest.MapFS{
+		"m/0001_first.up.sql": {Data: []byte("SELECT 1;")},
+	}
+	changed := fstest.MapFS{
+		"m/0001_first.up.sql": {Data: []byte("SELECT 2;")},
+	}
+
+	first, err := LoadMigrations(files, "m")
+	require.NoError(t, err)
+	again, err := LoadMigrations(files, "m")
+	require.NoError(t, err)
+	other, err := LoadMigrations(changed, "m")
+	require.NoError(t, err)
+
+	assert.Equal(t, first[0].Checksum, again[0].Checksum)
+	assert.NotEqual(t, first[0].Checksum, other[0].Checksum)
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	migrations, err := LoadMigrations(embeddedMigrations, "migrations")
+	require.NoError(t, err)
+	require.NotEmpty(t, migrations)
+
+	for i, m := range migrations {
+		assert.NotEmpty(t, m.Down, "migration %d_%s has no down script", m.Version, m.Name)
+		if i > 0 {
+			assert.Greater(t, m.Version, migrations[i-1].Version)
+		}
+	}
+}
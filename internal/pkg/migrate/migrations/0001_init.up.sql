@@ -1,27 +1,26 @@
 CREATE EXTENSION IF NOT EXISTS "uuid-ossp";
 
-CREATE TABLE users (
+CREATE TABLE IF NOT EXISTS users (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     email VARCHAR(255) NOT NULL UNIQUE,
     password_hash VARCHAR(255) NOT NULL,
     role VARCHAR(20) NOT NULL CHECK (role IN ('employee', 'moderator'))
 );
 
-CREATE TABLE pvz (
+CREATE TABLE IF NOT EXISTS pvz (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     city VARCHAR(100) NOT NULL CHECK (city IN ('Москва', 'Санкт-Петербург', 'Казань')),
     registration_date TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
 );
 
-
-CREATE TABLE receptions (
+CREATE TABLE IF NOT EXISTS receptions (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     pvz_id UUID NOT NULL REFERENCES pvz(id),
     date_time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
     status VARCHAR(20) NOT NULL CHECK (status IN ('in_progress', 'closed')) DEFAULT 'in_progress'
 );
 
-CREATE TABLE products (
+CREATE TABLE IF NOT EXISTS products (
     id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
     reception_id UUID NOT NULL REFERENCES receptions(id),
     type VARCHAR(50) NOT NULL CHECK (type IN ('электроника', 'одежда', 'обувь')),
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS pvz_registration_date_id_idx ON pvz (registration_date, id);
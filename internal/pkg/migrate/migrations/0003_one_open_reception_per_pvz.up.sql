@@ -0,0 +1,15 @@
+-- Гонка в старом коде могла оставить несколько открытых приемок на ПВЗ:
+-- оставляем открытой только самую свежую, иначе индекс не построится.
+UPDATE receptions
+SET status = 'closed'
+WHERE status = 'in_progress'
+  AND id NOT IN (
+    SELECT DISTINCT ON (pvz_id) id
+    FROM receptions
+    WHERE status = 'in_progress'
+    ORDER BY pvz_id, date_time DESC, id DESC
+  );
+
+CREATE UNIQUE INDEX IF NOT EXISTS receptions_one_in_progress_per_pvz
+    ON receptions (pvz_id)
+    WHERE status = 'in_progress';
@@ -0,0 +1,2 @@
+ALTER TABLE products DROP CONSTRAINT IF EXISTS products_reception_sequence_key;
+ALTER TABLE products DROP COLUMN IF EXISTS sequence;
@@ -0,0 +1,14 @@
+ALTER TABLE products ADD COLUMN IF NOT EXISTS sequence INTEGER;
+
+UPDATE products p
+SET sequence = numbered.seq
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY reception_id ORDER BY date_time, id) AS seq
+    FROM products
+) AS numbered
+WHERE p.id = numbered.id;
+
+ALTER TABLE products ALTER COLUMN sequence SET NOT NULL;
+
+ALTER TABLE products
+    ADD CONSTRAINT products_reception_sequence_key UNIQUE (reception_id, sequence);
@@ -0,0 +1,5 @@
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS closed_at TIMESTAMP;
+
+-- До этой миграции закрытие перезаписывало date_time временем закрытия,
+-- поэтому для уже закрытых приемок это лучшее, что известно о closed_at.
+UPDATE receptions SET closed_at = date_time WHERE status = 'closed' AND closed_at IS NULL;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS receptions_pvz_closed_at_idx;
+DROP INDEX IF EXISTS receptions_pvz_opened_at_idx;
+ALTER TABLE receptions DROP COLUMN IF EXISTS closed_by;
+ALTER TABLE receptions DROP COLUMN IF EXISTS opened_at;
@@ -0,0 +1,14 @@
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS opened_at TIMESTAMP;
+
+-- У закрытых до 0005 приемок date_time уже перезаписан временем закрытия,
+-- восстановить настоящее время открытия для них нельзя.
+UPDATE receptions SET opened_at = date_time WHERE opened_at IS NULL;
+
+ALTER TABLE receptions
+    ALTER COLUMN opened_at SET NOT NULL,
+    ALTER COLUMN opened_at SET DEFAULT CURRENT_TIMESTAMP;
+
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS closed_by UUID REFERENCES users(id);
+
+CREATE INDEX IF NOT EXISTS receptions_pvz_opened_at_idx ON receptions (pvz_id, opened_at);
+CREATE INDEX IF NOT EXISTS receptions_pvz_closed_at_idx ON receptions (pvz_id, closed_at);
@@ -0,0 +1,2 @@
+ALTER TABLE receptions DROP COLUMN IF EXISTS created_by;
+ALTER TABLE pvz DROP COLUMN IF EXISTS created_by;
@@ -0,0 +1,2 @@
+ALTER TABLE pvz ADD COLUMN IF NOT EXISTS created_by UUID REFERENCES users(id);
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS created_by UUID REFERENCES users(id);
@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS user_identities;
+
+-- Пустой хеш не совпадает ни с одним паролем, SSO-пользователи просто не смогут войти.
+UPDATE users SET password_hash = '' WHERE password_hash IS NULL;
+ALTER TABLE users ALTER COLUMN password_hash SET NOT NULL;
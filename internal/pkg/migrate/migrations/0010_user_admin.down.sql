@@ -0,0 +1,15 @@
+ALTER TABLE products
+    DROP CONSTRAINT IF EXISTS products_added_by_fkey,
+    ADD CONSTRAINT products_added_by_fkey FOREIGN KEY (added_by) REFERENCES users(id);
+
+ALTER TABLE receptions
+    DROP CONSTRAINT IF EXISTS receptions_closed_by_fkey,
+    ADD CONSTRAINT receptions_closed_by_fkey FOREIGN KEY (closed_by) REFERENCES users(id),
+    DROP CONSTRAINT IF EXISTS receptions_created_by_fkey,
+    ADD CONSTRAINT receptions_created_by_fkey FOREIGN KEY (created_by) REFERENCES users(id);
+
+ALTER TABLE pvz
+    DROP CONSTRAINT IF EXISTS pvz_created_by_fkey,
+    ADD CONSTRAINT pvz_created_by_fkey FOREIGN KEY (created_by) REFERENCES users(id);
+
+ALTER TABLE users DROP COLUMN IF EXISTS disabled;
@@ -0,0 +1,17 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS disabled BOOLEAN NOT NULL DEFAULT false;
+
+-- Удаление пользователя не должно удалять или блокировать созданные им записи:
+-- ссылка на автора просто обнуляется.
+ALTER TABLE pvz
+    DROP CONSTRAINT IF EXISTS pvz_created_by_fkey,
+    ADD CONSTRAINT pvz_created_by_fkey FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE SET NULL;
+
+ALTER TABLE receptions
+    DROP CONSTRAINT IF EXISTS receptions_created_by_fkey,
+    ADD CONSTRAINT receptions_created_by_fkey FOREIGN KEY (created_by) REFERENCES users(id) ON DELETE SET NULL,
+    DROP CONSTRAINT IF EXISTS receptions_closed_by_fkey,
+    ADD CONSTRAINT receptions_closed_by_fkey FOREIGN KEY (closed_by) REFERENCES users(id) ON DELETE SET NULL;
+
+ALTER TABLE products
+    DROP CONSTRAINT IF EXISTS products_added_by_fkey,
+    ADD CONSTRAINT products_added_by_fkey FOREIGN KEY (added_by) REFERENCES users(id) ON DELETE SET NULL;
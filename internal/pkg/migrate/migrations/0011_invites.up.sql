@@ -0,0 +1,13 @@
+-- Одноразовые приглашения на регистрацию. Как и refresh-токены, код хранится
+-- только в виде sha256-хеша.
+CREATE TABLE IF NOT EXISTS invites (
+    id UUID PRIMARY KEY,
+    code_hash VARCHAR(64) NOT NULL UNIQUE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('employee', 'moderator')),
+    email VARCHAR(255),
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP,
+    used_by UUID REFERENCES users(id) ON DELETE SET NULL
+);
@@ -0,0 +1,8 @@
+-- Счетчики неудачных входов для защиты от перебора паролей. key — "account:<email>"
+-- или "ip:<адрес>".
+CREATE TABLE IF NOT EXISTS login_attempts (
+    key VARCHAR(320) PRIMARY KEY,
+    failures INTEGER NOT NULL,
+    last_failure_at TIMESTAMPTZ NOT NULL,
+    locked_until TIMESTAMPTZ
+);
@@ -0,0 +1,16 @@
+-- API-ключи интеграций (роботы сортировочной линии и т. п.). Ключ имеет вид
+-- pvz_<prefix>_<secret>: prefix открыт и служит для поиска, от secret хранится
+-- только sha256-хеш. Пустой pvz_ids разрешает любые ПВЗ.
+CREATE TABLE IF NOT EXISTS api_keys (
+    id UUID PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(16) NOT NULL UNIQUE,
+    secret_hash VARCHAR(64) NOT NULL,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('employee', 'moderator')),
+    pvz_ids UUID[] NOT NULL DEFAULT '{}',
+    created_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP,
+    last_used_at TIMESTAMP,
+    revoked_at TIMESTAMP
+);
@@ -0,0 +1,25 @@
+-- Сотрудник работает с приемками только в ПВЗ, на которые его назначил модератор.
+CREATE TABLE IF NOT EXISTS user_pvz_assignments (
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    pvz_id UUID NOT NULL REFERENCES pvz(id) ON DELETE CASCADE,
+    assigned_by UUID REFERENCES users(id) ON DELETE SET NULL,
+    assigned_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (user_id, pvz_id)
+);
+
+CREATE INDEX IF NOT EXISTS user_pvz_assignments_pvz_id_idx ON user_pvz_assignments (pvz_id);
+
+-- Чтобы после выката сотрудники не потеряли доступ, назначаем их на ПВЗ,
+-- где они уже открывали приемки или добавляли товары.
+INSERT INTO user_pvz_assignments (user_id, pvz_id)
+SELECT DISTINCT u.id, r.pvz_id
+FROM users u
+JOIN receptions r ON r.created_by = u.id
+WHERE u.role = 'employee'
+UNION
+SELECT DISTINCT u.id, r.pvz_id
+FROM users u
+JOIN products p ON p.added_by = u.id
+JOIN receptions r ON r.id = p.reception_id
+WHERE u.role = 'employee'
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS casbin_rule;
+DROP FUNCTION IF EXISTS casbin_rule_notify();
@@ -0,0 +1,62 @@
+-- Правила casbin в базе: p — роль, путь (keyMatch2) и метод; g — роль и
+-- родительская роль. Пустые поля хранятся как '', чтобы работал UNIQUE.
+CREATE TABLE IF NOT EXISTS casbin_rule (
+    id BIGSERIAL PRIMARY KEY,
+    ptype VARCHAR(10) NOT NULL,
+    v0 VARCHAR(256) NOT NULL DEFAULT '',
+    v1 VARCHAR(256) NOT NULL DEFAULT '',
+    v2 VARCHAR(256) NOT NULL DEFAULT '',
+    v3 VARCHAR(256) NOT NULL DEFAULT '',
+    v4 VARCHAR(256) NOT NULL DEFAULT '',
+    v5 VARCHAR(256) NOT NULL DEFAULT '',
+    UNIQUE (ptype, v0, v1, v2, v3, v4, v5)
+);
+
+-- Любое изменение правил, в том числе руками через psql, рассылает
+-- уведомление, по которому все экземпляры сервиса перечитывают политику.
+CREATE OR REPLACE FUNCTION casbin_rule_notify() RETURNS trigger AS $$
+BEGIN
+    PERFORM pg_notify('casbin_rule_changed', '');
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TRIGGER IF EXISTS casbin_rule_changed ON casbin_rule;
+CREATE TRIGGER casbin_rule_changed
+    AFTER INSERT OR UPDATE OR DELETE OR TRUNCATE ON casbin_rule
+    FOR EACH STATEMENT EXECUTE FUNCTION casbin_rule_notify();
+
+-- Правила, которые раньше лежали в internal/middleware/acl/policy.csv.
+INSERT INTO casbin_rule (ptype, v0, v1, v2) VALUES
+    ('p', 'moderator', '/pvz', 'POST'),
+    ('p', 'employee', '/receptions', 'POST'),
+    ('p', 'employee', '/products', 'POST'),
+    ('p', 'employee', '/pvz/:pvzId/delete_last_product', 'POST'),
+    ('p', 'employee', '/pvz/:pvzId/close_last_reception', 'POST'),
+    ('p', 'moderator', '/pvz/:pvzId', 'GET'),
+    ('p', 'employee', '/pvz/:pvzId', 'GET'),
+    ('p', 'moderator', '/receptions/:receptionId', 'GET'),
+    ('p', 'employee', '/receptions/:receptionId', 'GET'),
+    ('p', 'moderator', '/receptions/:receptionId/products', 'GET'),
+    ('p', 'employee', '/receptions/:receptionId/products', 'GET'),
+    ('p', 'moderator', '/users', 'GET'),
+    ('p', 'moderator', '/users/:userId', 'GET'),
+    ('p', 'moderator', '/users/:userId', 'PATCH'),
+    ('p', 'moderator', '/users/:userId', 'DELETE'),
+    ('p', 'moderator', '/invites', 'POST'),
+    ('p', 'moderator', '/users/:userId/unlock', 'POST'),
+    ('p', 'moderator', '/users/:userId/pvz', 'GET'),
+    ('p', 'moderator', '/users/:userId/pvz/:pvzId', 'PUT'),
+    ('p', 'moderator', '/users/:userId/pvz/:pvzId', 'DELETE'),
+    ('p', 'employee', '/me/password', 'POST'),
+    ('p', 'moderator', '/me/password', 'POST'),
+    ('p', 'moderator', '/api-keys', 'GET'),
+    ('p', 'moderator', '/api-keys', 'POST'),
+    ('p', 'moderator', '/api-keys/:keyId', 'DELETE'),
+    ('p', 'moderator', '/acl/policies', 'GET'),
+    ('p', 'moderator', '/acl/policies', 'POST'),
+    ('p', 'moderator', '/acl/policies', 'DELETE'),
+    ('p', 'moderator', '/acl/roles', 'GET'),
+    ('p', 'moderator', '/acl/roles', 'POST'),
+    ('p', 'moderator', '/acl/roles', 'DELETE')
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,35 @@
+-- Сессия — один вход пользователя с устройства. Ее id совпадает с family_id
+-- refresh-токенов, выпущенных ротацией из этого входа, и с claim sid access-токенов.
+CREATE TABLE IF NOT EXISTS sessions (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    device_label VARCHAR(100) NOT NULL DEFAULT '',
+    user_agent VARCHAR(512) NOT NULL DEFAULT '',
+    ip VARCHAR(64) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_seen_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    revoked_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS sessions_user_id_idx ON sessions (user_id);
+
+-- Сессии, открытые до выката, восстанавливаем из семейств refresh-токенов:
+-- сведений об устройстве у них нет.
+INSERT INTO sessions (id, user_id, created_at, last_seen_at, revoked_at)
+SELECT family_id, (array_agg(user_id))[1], min(created_at), max(created_at),
+       CASE WHEN bool_and(revoked_at IS NULL) THEN NULL ELSE max(revoked_at) END
+FROM refresh_tokens
+GROUP BY family_id
+ON CONFLICT DO NOTHING;
+
+ALTER TABLE refresh_tokens
+    ADD CONSTRAINT refresh_tokens_family_id_fkey
+    FOREIGN KEY (family_id) REFERENCES sessions(id) ON DELETE CASCADE;
+
+INSERT INTO casbin_rule (ptype, v0, v1, v2) VALUES
+    ('p', 'employee', '/me/sessions', 'GET'),
+    ('p', 'moderator', '/me/sessions', 'GET'),
+    ('p', 'employee', '/me/sessions/:sessionId', 'DELETE'),
+    ('p', 'moderator', '/me/sessions/:sessionId', 'DELETE'),
+    ('p', 'moderator', '/users/:userId/sessions', 'DELETE')
+ON CONFLICT DO NOTHING;
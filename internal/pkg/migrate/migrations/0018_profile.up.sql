@@ -0,0 +1,29 @@
+ALTER TABLE users ADD COLUMN IF NOT EXISTS created_at TIMESTAMP;
+
+-- Для пользователей, созданных до выката, точного времени регистрации нет: берем
+-- время первого известного входа, у остальных дата остается пустой.
+UPDATE users u SET created_at = s.first_login
+FROM (SELECT user_id, min(created_at) AS first_login FROM sessions GROUP BY user_id) s
+WHERE s.user_id = u.id AND u.created_at IS NULL;
+
+ALTER TABLE users ALTER COLUMN created_at SET DEFAULT CURRENT_TIMESTAMP;
+
+-- Запрос на смену email. Сам токен из письма не хранится, только его хеш.
+CREATE TABLE IF NOT EXISTS email_changes (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    new_email VARCHAR(255) NOT NULL,
+    token_hash VARCHAR(64) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL,
+    used_at TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS email_changes_user_id_idx ON email_changes (user_id);
+
+INSERT INTO casbin_rule (ptype, v0, v1, v2) VALUES
+    ('p', 'employee', '/me', 'GET'),
+    ('p', 'moderator', '/me', 'GET'),
+    ('p', 'employee', '/me', 'PATCH'),
+    ('p', 'moderator', '/me', 'PATCH')
+ON CONFLICT DO NOTHING;
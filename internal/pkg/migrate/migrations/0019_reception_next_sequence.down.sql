@@ -0,0 +1 @@
+ALTER TABLE receptions DROP COLUMN IF EXISTS next_sequence;
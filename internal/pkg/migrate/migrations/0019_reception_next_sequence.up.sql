@@ -0,0 +1,7 @@
+-- Следующий номер товара хранится в приемке, чтобы номер удаленного товара не
+-- доставался следующему.
+ALTER TABLE receptions ADD COLUMN IF NOT EXISTS next_sequence INTEGER NOT NULL DEFAULT 1;
+
+UPDATE receptions r SET next_sequence = p.max_sequence + 1
+FROM (SELECT reception_id, max(sequence) AS max_sequence FROM products GROUP BY reception_id) p
+WHERE p.reception_id = r.id;
@@ -0,0 +1,139 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/swag"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/internal/pkg/policy"
+	"github.com/totorialman/go-task-avito/internal/pkg/utils/log"
+	"github.com/totorialman/go-task-avito/models"
+	"github.com/totorialman/go-task-avito/restapi/operations"
+)
+
+type PolicyHandler struct {
+	usecase policy.PolicyUsecase
+}
+
+func NewPolicyHandler(uc policy.PolicyUsecase) *PolicyHandler {
+	return &PolicyHandler{usecase: uc}
+}
+
+func (h *PolicyHandler) HandleListPolicies(params operations.GetACLPoliciesParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	policies, err := h.usecase.ListPolicies(params.HTTPRequest.Context())
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("ListPolicies error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewGetACLPoliciesOK().WithPayload(policies)
+}
+
+func (h *PolicyHandler) HandleAddPolicy(params operations.PostACLPoliciesParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+	ctx := params.HTTPRequest.Context()
+
+	err := h.usecase.AddPolicy(ctx, auth.UserIDFromContext(ctx), params.Body)
+	switch {
+	case errors.Is(err, auth.ErrForbidden):
+		log.LogHandlerError(logger, fmt.Errorf("AddPolicy error: %w", err), http.StatusForbidden)
+		return operations.NewPostACLPoliciesForbidden().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, policy.ErrInvalidRule):
+		log.LogHandlerError(logger, fmt.Errorf("AddPolicy error: %w", err), http.StatusBadRequest)
+		return operations.NewPostACLPoliciesBadRequest().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, policy.ErrRuleExists):
+		log.LogHandlerError(logger, fmt.Errorf("AddPolicy error: %w", err), http.StatusConflict)
+		return operations.NewPostACLPoliciesConflict().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case err != nil:
+		log.LogHandlerError(logger, fmt.Errorf("AddPolicy error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewPostACLPoliciesCreated().WithPayload(params.Body)
+}
+
+func (h *PolicyHandler) HandleRemovePolicy(params operations.DeleteACLPoliciesParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+	ctx := params.HTTPRequest.Context()
+
+	p := &models.Policy{Role: swag.String(params.Role), Path: swag.String(params.Path), Method: swag.String(params.Method)}
+	err := h.usecase.RemovePolicy(ctx, auth.UserIDFromContext(ctx), p)
+	switch {
+	case errors.Is(err, auth.ErrForbidden):
+		log.LogHandlerError(logger, fmt.Errorf("RemovePolicy error: %w", err), http.StatusForbidden)
+		return operations.NewDeleteACLPoliciesForbidden().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, policy.ErrRuleNotFound), errors.Is(err, policy.ErrInvalidRule):
+		log.LogHandlerError(logger, fmt.Errorf("RemovePolicy error: %w", err), http.StatusNotFound)
+		return operations.NewDeleteACLPoliciesNotFound().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, policy.ErrProtectedPolicy):
+		log.LogHandlerError(logger, fmt.Errorf("RemovePolicy error: %w", err), http.StatusConflict)
+		return operations.NewDeleteACLPoliciesConflict().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case err != nil:
+		log.LogHandlerError(logger, fmt.Errorf("RemovePolicy error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewDeleteACLPoliciesNoContent()
+}
+
+func (h *PolicyHandler) HandleListRoles(params operations.GetACLRolesParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	roles, err := h.usecase.ListRoles(params.HTTPRequest.Context())
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("ListRoles error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewGetACLRolesOK().WithPayload(roles)
+}
+
+func (h *PolicyHandler) HandleAddRole(params operations.PostACLRolesParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+	ctx := params.HTTPRequest.Context()
+
+	err := h.usecase.AddRole(ctx, auth.UserIDFromContext(ctx), params.Body)
+	switch {
+	case errors.Is(err, auth.ErrForbidden):
+		log.LogHandlerError(logger, fmt.Errorf("AddRole error: %w", err), http.StatusForbidden)
+		return operations.NewPostACLRolesForbidden().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, policy.ErrInvalidRule):
+		log.LogHandlerError(logger, fmt.Errorf("AddRole error: %w", err), http.StatusBadRequest)
+		return operations.NewPostACLRolesBadRequest().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, policy.ErrRuleExists):
+		log.LogHandlerError(logger, fmt.Errorf("AddRole error: %w", err), http.StatusConflict)
+		return operations.NewPostACLRolesConflict().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case err != nil:
+		log.LogHandlerError(logger, fmt.Errorf("AddRole error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewPostACLRolesCreated().WithPayload(params.Body)
+}
+
+func (h *PolicyHandler) HandleRemoveRole(params operations.DeleteACLRolesParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+	ctx := params.HTTPRequest.Context()
+
+	link := &models.RoleInheritance{Role: swag.String(params.Role), Parent: swag.String(params.Parent)}
+	err := h.usecase.RemoveRole(ctx, auth.UserIDFromContext(ctx), link)
+	switch {
+	case errors.Is(err, auth.ErrForbidden):
+		log.LogHandlerError(logger, fmt.Errorf("RemoveRole error: %w", err), http.StatusForbidden)
+		return operations.NewDeleteACLRolesForbidden().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case errors.Is(err, policy.ErrRuleNotFound), errors.Is(err, policy.ErrInvalidRule):
+		log.LogHandlerError(logger, fmt.Errorf("RemoveRole error: %w", err), http.StatusNotFound)
+		return operations.NewDeleteACLRolesNotFound().WithPayload(&models.Error{Message: swag.String(err.Error())})
+	case err != nil:
+		log.LogHandlerError(logger, fmt.Errorf("RemoveRole error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+
+	return operations.NewDeleteACLRolesNoContent()
+}
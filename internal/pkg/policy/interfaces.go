@@ -0,0 +1,50 @@
+package policy
+
+import (
+	"context"
+	"errors"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/totorialman/go-task-avito/models"
+)
+
+var (
+	ErrInvalidRule     = errors.New("Некорректное правило доступа")
+	ErrRuleExists      = errors.New("Такое правило уже есть")
+	ErrRuleNotFound    = errors.New("Правило не найдено")
+	ErrProtectedPolicy = errors.New("Нельзя отнять у модераторов управление правилами доступа")
+	ErrDBError         = errors.New("Ошибка БД")
+)
+
+const (
+	// PTypePolicy — правило «роль, путь, метод».
+	PTypePolicy = "p"
+	// PTypeRole — наследование «роль, родительская роль».
+	PTypeRole = "g"
+)
+
+// Rule — строка таблицы casbin_rule: тип правила и его непустые поля.
+type Rule struct {
+	PType  string
+	Values []string
+}
+
+type PolicyRepo interface {
+	ListRules(ctx context.Context) ([]Rule, error)
+	InsertRule(ctx context.Context, rule Rule) error
+	DeleteRule(ctx context.Context, rule Rule) error
+}
+
+// Reloader перечитывает правила в энфорсер этого экземпляра сервиса.
+type Reloader interface {
+	Reload() error
+}
+
+type PolicyUsecase interface {
+	ListPolicies(ctx context.Context) ([]*models.Policy, error)
+	AddPolicy(ctx context.Context, actorID strfmt.UUID, p *models.Policy) error
+	RemovePolicy(ctx context.Context, actorID strfmt.UUID, p *models.Policy) error
+	ListRoles(ctx context.Context) ([]*models.RoleInheritance, error)
+	AddRole(ctx context.Context, actorID strfmt.UUID, link *models.RoleInheritance) error
+	RemoveRole(ctx context.Context, actorID strfmt.UUID, link *models.RoleInheritance) error
+}
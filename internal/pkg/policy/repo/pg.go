@@ -0,0 +1,216 @@
+package repo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/casbin/casbin/model"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/totorialman/go-task-avito/internal/pkg/policy"
+	"github.com/totorialman/go-task-avito/internal/pkg/utils/log"
+)
+
+const (
+	// NotifyChannel — канал, в который триггер на casbin_rule сообщает об изменениях.
+	NotifyChannel = "casbin_rule_changed"
+	// ListenRetryDelay — пауза перед повторной подпиской после обрыва соединения.
+	ListenRetryDelay = 5 * time.Second
+
+	ruleFields = 6
+)
+
+var errNotImplemented = errors.New("not implemented")
+
+const (
+	listRulesQuery  = `SELECT ptype, v0, v1, v2, v3, v4, v5 FROM casbin_rule ORDER BY id`
+	insertRuleQuery = `
+		INSERT INTO casbin_rule (ptype, v0, v1, v2, v3, v4, v5)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		ON CONFLICT DO NOTHING`
+	deleteRuleQuery = `
+		DELETE FROM casbin_rule
+		WHERE ptype = $1 AND v0 = $2 AND v1 = $3 AND v2 = $4 AND v3 = $5 AND v4 = $6 AND v5 = $7`
+)
+
+// PolicyRepo хранит правила casbin в Postgres. Он же служит casbin-адаптером,
+// только для чтения: правила меняются через InsertRule и DeleteRule.
+type PolicyRepo struct {
+	db *pgxpool.Pool
+}
+
+func NewPolicyRepo(db *pgxpool.Pool) *PolicyRepo {
+	return &PolicyRepo{db: db}
+}
+
+func ruleArgs(rule policy.Rule) ([]interface{}, error) {
+	if len(rule.Values) > ruleFields {
+		return nil, policy.ErrInvalidRule
+	}
+	args := []interface{}{rule.PType}
+	for i := 0; i < ruleFields; i++ {
+		value := ""
+		if i < len(rule.Values) {
+			value = rule.Values[i]
+		}
+		args = append(args, value)
+	}
+	return args, nil
+}
+
+func (r *PolicyRepo) ListRules(ctx context.Context) ([]policy.Rule, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	rows, err := r.db.Query(ctx, listRulesQuery)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to list casbin rules: %w", err), http.StatusInternalServerError)
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := []policy.Rule{}
+	for rows.Next() {
+		var rule policy.Rule
+		values := make([]string, ruleFields)
+		if err := rows.Scan(&rule.PType, &values[0], &values[1], &values[2], &values[3], &values[4], &values[5]); err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to scan casbin rule: %w", err), http.StatusInternalServerError)
+			return nil, err
+		}
+		// Пустые поля в конце — это поля, которых у правила нет
+		for len(values) > 0 && values[len(values)-1] == "" {
+			values = values[:len(values)-1]
+		}
+		rule.Values = values
+		rules = append(rules, rule)
+	}
+	if err := rows.Err(); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("casbin rule iteration error: %w", err), http.StatusInternalServerError)
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+func (r *PolicyRepo) InsertRule(ctx context.Context, rule policy.Rule) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	args, err := ruleArgs(rule)
+	if err != nil {
+		return err
+	}
+	tag, err := r.db.Exec(ctx, insertRuleQuery, args...)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to insert casbin rule: %w", err), http.StatusInternalServerError)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return policy.ErrRuleExists
+	}
+
+	return nil
+}
+
+func (r *PolicyRepo) DeleteRule(ctx context.Context, rule policy.Rule) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	args, err := ruleArgs(rule)
+	if err != nil {
+		return err
+	}
+	tag, err := r.db.Exec(ctx, deleteRuleQuery, args...)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to delete casbin rule: %w", err), http.StatusInternalServerError)
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return policy.ErrRuleNotFound
+	}
+
+	return nil
+}
+
+// LoadPolicy загружает правила в модель casbin. Правила типов, которых нет
+// в модели, пропускаются.
+func (r *PolicyRepo) LoadPolicy(m model.Model) error {
+	rules, err := r.ListRules(context.Background())
+	if err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		if rule.PType == "" {
+			continue
+		}
+		assertion, ok := m[rule.PType[:1]][rule.PType]
+		if !ok {
+			slog.Warn("unknown casbin rule type", slog.String("ptype", rule.PType))
+			continue
+		}
+		assertion.Policy = append(assertion.Policy, rule.Values)
+	}
+	return nil
+}
+
+func (r *PolicyRepo) SavePolicy(m model.Model) error {
+	return errNotImplemented
+}
+
+func (r *PolicyRepo) AddPolicy(sec string, ptype string, rule []string) error {
+	return errNotImplemented
+}
+
+func (r *PolicyRepo) RemovePolicy(sec string, ptype string, rule []string) error {
+	return errNotImplemented
+}
+
+func (r *PolicyRepo) RemoveFilteredPolicy(sec string, ptype string, fieldIndex int, fieldValues ...string) error {
+	return errNotImplemented
+}
+
+// Listen подписывается на NotifyChannel и вызывает onChange на каждое изменение
+// правил, пока не отменен ctx. После (пере)подключения onChange вызывается
+// сразу: уведомления, пришедшие во время обрыва, потеряны.
+func (r *PolicyRepo) Listen(ctx context.Context, onChange func()) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	for {
+		err := r.listen(ctx, onChange)
+		if ctx.Err() != nil {
+			return
+		}
+		log.LogHandlerError(logger, fmt.Errorf("policy listener stopped: %w", err), http.StatusInternalServerError)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(ListenRetryDelay):
+		}
+	}
+}
+
+func (r *PolicyRepo) listen(ctx context.Context, onChange func()) error {
+	conn, err := r.db.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// Соединение возвращается в пул, подписка ему больше не нужна
+		conn.Exec(context.Background(), "UNLISTEN *")
+		conn.Release()
+	}()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+NotifyChannel); err != nil {
+		return err
+	}
+	onChange()
+
+	for {
+		if _, err := conn.Conn().WaitForNotification(ctx); err != nil {
+			return err
+		}
+		onChange()
+	}
+}
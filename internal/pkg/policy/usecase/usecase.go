@@ -0,0 +1,213 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/internal/pkg/policy"
+	"github.com/totorialman/go-task-avito/internal/pkg/utils/log"
+	"github.com/totorialman/go-task-avito/models"
+)
+
+// protectedRole и protectedPrefix описывают правила, без которых управлять
+// доступом станет некому: их можно вернуть только правкой базы.
+const (
+	protectedRole   = models.UserRoleModerator
+	protectedPrefix = "/acl/"
+)
+
+var methods = map[string]bool{
+	models.PolicyMethodGET:    true,
+	models.PolicyMethodPOST:   true,
+	models.PolicyMethodPUT:    true,
+	models.PolicyMethodPATCH:  true,
+	models.PolicyMethodDELETE: true,
+}
+
+type PolicyUsecase struct {
+	repo     policy.PolicyRepo
+	reloader policy.Reloader
+}
+
+func NewPolicyUsecase(repo policy.PolicyRepo, reloader policy.Reloader) *PolicyUsecase {
+	return &PolicyUsecase{repo: repo, reloader: reloader}
+}
+
+func (uc *PolicyUsecase) ListPolicies(ctx context.Context) ([]*models.Policy, error) {
+	rules, err := uc.listRules(ctx, policy.PTypePolicy, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*models.Policy, 0, len(rules))
+	for _, values := range rules {
+		result = append(result, &models.Policy{
+			Role:   swag.String(values[0]),
+			Path:   swag.String(values[1]),
+			Method: swag.String(values[2]),
+		})
+	}
+	return result, nil
+}
+
+func (uc *PolicyUsecase) AddPolicy(ctx context.Context, actorID strfmt.UUID, p *models.Policy) error {
+	rule, err := policyRule(p)
+	if err != nil {
+		return err
+	}
+	return uc.insert(ctx, actorID, rule)
+}
+
+func (uc *PolicyUsecase) RemovePolicy(ctx context.Context, actorID strfmt.UUID, p *models.Policy) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	rule, err := policyRule(p)
+	if err != nil {
+		return err
+	}
+	if rule.Values[0] == protectedRole && strings.HasPrefix(rule.Values[1], protectedPrefix) {
+		log.LogHandlerError(logger, policy.ErrProtectedPolicy, http.StatusConflict)
+		return policy.ErrProtectedPolicy
+	}
+	return uc.delete(ctx, actorID, rule)
+}
+
+func (uc *PolicyUsecase) ListRoles(ctx context.Context) ([]*models.RoleInheritance, error) {
+	rules, err := uc.listRules(ctx, policy.PTypeRole, 2)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*models.RoleInheritance, 0, len(rules))
+	for _, values := range rules {
+		result = append(result, &models.RoleInheritance{Role: swag.String(values[0]), Parent: swag.String(values[1])})
+	}
+	return result, nil
+}
+
+func (uc *PolicyUsecase) AddRole(ctx context.Context, actorID strfmt.UUID, link *models.RoleInheritance) error {
+	rule, err := roleRule(link)
+	if err != nil {
+		return err
+	}
+	return uc.insert(ctx, actorID, rule)
+}
+
+func (uc *PolicyUsecase) RemoveRole(ctx context.Context, actorID strfmt.UUID, link *models.RoleInheritance) error {
+	rule, err := roleRule(link)
+	if err != nil {
+		return err
+	}
+	return uc.delete(ctx, actorID, rule)
+}
+
+func (uc *PolicyUsecase) listRules(ctx context.Context, ptype string, fields int) ([][]string, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	rules, err := uc.repo.ListRules(ctx)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to list rules: %w", err), http.StatusInternalServerError)
+		return nil, policy.ErrDBError
+	}
+
+	result := [][]string{}
+	for _, rule := range rules {
+		if rule.PType == ptype && len(rule.Values) >= fields {
+			result = append(result, rule.Values)
+		}
+	}
+	return result, nil
+}
+
+// insert и delete — единственный путь изменения правил. Правило меняет только
+// модератор с учетной записью: иначе изменение разошлось бы по всем экземплярам без
+// следа в журнале.
+func (uc *PolicyUsecase) insert(ctx context.Context, actorID strfmt.UUID, rule policy.Rule) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	if err := auth.RequireActor(actorID); err != nil {
+		log.LogHandlerError(logger, err, http.StatusForbidden)
+		return err
+	}
+
+	err := uc.repo.InsertRule(ctx, rule)
+	if errors.Is(err, policy.ErrRuleExists) {
+		log.LogHandlerError(logger, err, http.StatusConflict)
+		return err
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to insert rule: %w", err), http.StatusInternalServerError)
+		return policy.ErrDBError
+	}
+
+	logger.Info("Access rule added", slog.String("ptype", rule.PType),
+		slog.String("rule", strings.Join(rule.Values, ", ")), slog.String("by", actorID.String()))
+	uc.reload(ctx)
+	return nil
+}
+
+func (uc *PolicyUsecase) delete(ctx context.Context, actorID strfmt.UUID, rule policy.Rule) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	if err := auth.RequireActor(actorID); err != nil {
+		log.LogHandlerError(logger, err, http.StatusForbidden)
+		return err
+	}
+
+	err := uc.repo.DeleteRule(ctx, rule)
+	if errors.Is(err, policy.ErrRuleNotFound) {
+		log.LogHandlerError(logger, err, http.StatusNotFound)
+		return err
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to delete rule: %w", err), http.StatusInternalServerError)
+		return policy.ErrDBError
+	}
+
+	logger.Info("Access rule removed", slog.String("ptype", rule.PType),
+		slog.String("rule", strings.Join(rule.Values, ", ")), slog.String("by", actorID.String()))
+	uc.reload(ctx)
+	return nil
+}
+
+// reload применяет изменение на этом экземпляре сразу, не дожидаясь
+// уведомления из базы; остальные экземпляры перечитают правила по уведомлению.
+func (uc *PolicyUsecase) reload(ctx context.Context) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	if err := uc.reloader.Reload(); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to reload policy: %w", err), http.StatusInternalServerError)
+	}
+}
+
+func policyRule(p *models.Policy) (policy.Rule, error) {
+	if p == nil {
+		return policy.Rule{}, policy.ErrInvalidRule
+	}
+	role := strings.TrimSpace(swag.StringValue(p.Role))
+	path := strings.TrimSpace(swag.StringValue(p.Path))
+	method := strings.ToUpper(strings.TrimSpace(swag.StringValue(p.Method)))
+	if role == "" || !strings.HasPrefix(path, "/") || !methods[method] {
+		return policy.Rule{}, policy.ErrInvalidRule
+	}
+	return policy.Rule{PType: policy.PTypePolicy, Values: []string{role, path, method}}, nil
+}
+
+func roleRule(link *models.RoleInheritance) (policy.Rule, error) {
+	if link == nil {
+		return policy.Rule{}, policy.ErrInvalidRule
+	}
+	role := strings.TrimSpace(swag.StringValue(link.Role))
+	parent := strings.TrimSpace(swag.StringValue(link.Parent))
+	if role == "" || parent == "" || role == parent {
+		return policy.Rule{}, policy.ErrInvalidRule
+	}
+	return policy.Rule{PType: policy.PTypeRole, Values: []string{role, parent}}, nil
+}
@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/internal/pkg/policy"
+	"github.com/totorialman/go-task-avito/models"
+)
+
+const actorID = strfmt.UUID("11111111-1111-1111-1111-111111111111")
+
+// DummyPolicyRepo хранит правила в памяти в порядке добавления.
+type DummyPolicyRepo struct {
+	Rules []policy.Rule
+	Err   error
+}
+
+func (r *DummyPolicyRepo) ListRules(ctx context.Context) ([]policy.Rule, error) {
+	return r.Rules, r.Err
+}
+
+func (r *DummyPolicyRepo) find(rule policy.Rule) int {
+	for i, existing := range r.Rules {
+		if existing.PType == rule.PType && reflect.DeepEqual(existing.Values, rule.Values) {
+			return i
+		}
+	}
+	return -1
+}
+
+func (r *DummyPolicyRepo) InsertRule(ctx context.Context, rule policy.Rule) error {
+	if r.Err != nil {
+		return r.Err
+	}
+	if r.find(rule) >= 0 {
+		return policy.ErrRuleExists
+	}
+	r.Rules = append(r.Rules, rule)
+	return nil
+}
+
+func (r *DummyPolicyRepo) DeleteRule(ctx context.Context, rule policy.Rule) error {
+	if r.Err != nil {
+		return r.Err
+	}
+	i := r.find(rule)
+	if i < 0 {
+		return policy.ErrRuleNotFound
+	}
+	r.Rules = append(r.Rules[:i], r.Rules[i+1:]...)
+	return nil
+}
+
+type DummyReloader struct {
+	Calls int
+}
+
+func (r *DummyReloader) Reload() error {
+	r.Calls++
+	return nil
+}
+
+func newPolicy(role, path, method string) *models.Policy {
+	return &models.Policy{Role: swag.String(role), Path: swag.String(path), Method: swag.String(method)}
+}
+
+func TestPolicyUsecase_AddPolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      *models.Policy
+		expectedErr error
+	}{
+		{"Success", newPolicy("employee", "/pvz/:pvzId", "get"), nil},
+		{"Duplicate", newPolicy("employee", "/receptions", "POST"), policy.ErrRuleExists},
+		{"Relative path", newPolicy("employee", "receptions", "POST"), policy.ErrInvalidRule},
+		{"Unknown method", newPolicy("employee", "/receptions", "TRACE"), policy.ErrInvalidRule},
+		{"Empty role", newPolicy(" ", "/receptions", "POST"), policy.ErrInvalidRule},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &DummyPolicyRepo{Rules: []policy.Rule{{PType: "p", Values: []string{"employee", "/receptions", "POST"}}}}
+			reloader := &DummyReloader{}
+			uc := NewPolicyUsecase(repo, reloader)
+
+			err := uc.AddPolicy(context.Background(), actorID, tt.policy)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Zero(t, reloader.Calls)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, 1, reloader.Calls)
+			assert.Equal(t, []string{"employee", "/pvz/:pvzId", "GET"}, repo.Rules[1].Values)
+		})
+	}
+}
+
+func TestPolicyUsecase_RemovePolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      *models.Policy
+		expectedErr error
+	}{
+		{"Success", newPolicy("employee", "/receptions", "POST"), nil},
+		{"Not found", newPolicy("employee", "/products", "POST"), policy.ErrRuleNotFound},
+		{"Protected", newPolicy("moderator", "/acl/policies", "POST"), policy.ErrProtectedPolicy},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &DummyPolicyRepo{Rules: []policy.Rule{
+				{PType: "p", Values: []string{"employee", "/receptions", "POST"}},
+				{PType: "p", Values: []string{"moderator", "/acl/policies", "POST"}},
+			}}
+			reloader := &DummyReloader{}
+			uc := NewPolicyUsecase(repo, reloader)
+
+			err := uc.RemovePolicy(context.Background(), actorID, tt.policy)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Len(t, repo.Rules, 2)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, repo.Rules, 1)
+			assert.Equal(t, 1, reloader.Calls)
+		})
+	}
+}
+
+func TestPolicyUsecase_Roles(t *testing.T) {
+	ctx := context.Background()
+	repo := &DummyPolicyRepo{Rules: []policy.Rule{{PType: "p", Values: []string{"employee", "/receptions", "POST"}}}}
+	uc := NewPolicyUsecase(repo, &DummyReloader{})
+
+	link := &models.RoleInheritance{Role: swag.String("senior"), Parent: swag.String("employee")}
+	require.NoError(t, uc.AddRole(ctx, actorID, link))
+	assert.ErrorIs(t, uc.AddRole(ctx, actorID, link), policy.ErrRuleExists)
+	assert.ErrorIs(t, uc.AddRole(ctx, actorID, &models.RoleInheritance{
+		Role: swag.String("senior"), Parent: swag.String("senior"),
+	}), policy.ErrInvalidRule)
+
+	roles, err := uc.ListRoles(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, []*models.RoleInheritance{link}, roles)
+	policies, err := uc.ListPolicies(ctx)
+	require.NoError(t, err)
+	assert.Len(t, policies, 1, "role links are not listed as policies")
+
+	require.NoError(t, uc.RemoveRole(ctx, actorID, link))
+	assert.ErrorIs(t, uc.RemoveRole(ctx, actorID, link), policy.ErrRuleNotFound)
+}
+
+func TestPolicyUsecase_RequiresActor(t *testing.T) {
+	ctx := context.Background()
+	repo := &DummyPolicyRepo{Rules: []policy.Rule{
+		{PType: "p", Values: []string{"employee", "/receptions", "POST"}},
+		{PType: "g", Values: []string{"senior", "employee"}},
+	}}
+	reloader := &DummyReloader{}
+	uc := NewPolicyUsecase(repo, reloader)
+	link := &models.RoleInheritance{Role: swag.String("senior"), Parent: swag.String("employee")}
+
+	assert.ErrorIs(t, uc.AddPolicy(ctx, "", newPolicy("employee", "/products", "POST")), auth.ErrForbidden)
+	assert.ErrorIs(t, uc.RemovePolicy(ctx, "", newPolicy("employee", "/receptions", "POST")), auth.ErrForbidden)
+	assert.ErrorIs(t, uc.AddRole(ctx, "", &models.RoleInheritance{
+		Role: swag.String("lead"), Parent: swag.String("senior"),
+	}), auth.ErrForbidden)
+	assert.ErrorIs(t, uc.RemoveRole(ctx, "", link), auth.ErrForbidden)
+
+	assert.Len(t, repo.Rules, 2)
+	assert.Zero(t, reloader.Calls)
+}
@@ -0,0 +1,43 @@
+package pvz
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+)
+
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// PVZCursor указывает на последний отданный ПВЗ в порядке (registration_date, id).
+type PVZCursor struct {
+	RegistrationDate time.Time   `json:"r"`
+	ID               strfmt.UUID `json:"i"`
+}
+
+func EncodeCursor(c *PVZCursor) string {
+	if c == nil {
+		return ""
+	}
+	raw, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(raw)
+}
+
+func DecodeCursor(s string) (*PVZCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var c PVZCursor
+	if err := json.Unmarshal(raw, &c); err != nil || c.ID == "" || c.RegistrationDate.IsZero() {
+		return nil, ErrInvalidCursor
+	}
+	if !strfmt.IsUUID(c.ID.String()) {
+		return nil, ErrInvalidCursor
+	}
+
+	return &c, nil
+}
@@ -4,13 +4,16 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
 
 	"log/slog"
 
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/swag"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
 	"github.com/totorialman/go-task-avito/internal/pkg/metrics"
+	"github.com/totorialman/go-task-avito/internal/pkg/pvz"
 	"github.com/totorialman/go-task-avito/internal/pkg/pvz/usecase"
 	"github.com/totorialman/go-task-avito/internal/pkg/utils/log"
 	"github.com/totorialman/go-task-avito/models"
@@ -18,12 +21,13 @@ import (
 )
 
 type PVZHandler struct {
-	usecase *usecase.PVZUsecase
-	mt      *metrics.ProductMetrics
+	usecase    *usecase.PVZUsecase
+	mt         *metrics.ProductMetrics
+	authorizer auth.PVZAuthorizer
 }
 
-func NewPVZHandler(uc *usecase.PVZUsecase, mt *metrics.ProductMetrics) *PVZHandler {
-	return &PVZHandler{usecase: uc, mt: mt}
+func NewPVZHandler(uc *usecase.PVZUsecase, mt *metrics.ProductMetrics, authorizer auth.PVZAuthorizer) *PVZHandler {
+	return &PVZHandler{usecase: uc, mt: mt, authorizer: authorizer}
 }
 
 func (h *PVZHandler) HandleCreatePVZ(params operations.PostPvzParams) middleware.Responder {
@@ -43,7 +47,8 @@ func (h *PVZHandler) HandleCreatePVZ(params operations.PostPvzParams) middleware
 		})
 	}
 
-	pvz, err := h.usecase.CreatePVZ(params.HTTPRequest.Context(), *req.City, req.ID, req.RegistrationDate)
+	pvz, err := h.usecase.CreatePVZ(params.HTTPRequest.Context(), *req.City, req.ID, req.RegistrationDate,
+		auth.UserIDFromContext(params.HTTPRequest.Context()))
 	if err != nil {
 		log.LogHandlerError(logger, fmt.Errorf("CreatePVZ error: %w", err), http.StatusBadRequest)
 		if err.Error() == "only moderators can create PVZ" {
@@ -76,22 +81,24 @@ func (h *PVZHandler) HandleCreateReception(params operations.PostReceptionsParam
 	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
 	req := params.Body
 
-	activeReception, _, err := h.usecase.GetActiveReception(params.HTTPRequest.Context(), *req.PvzID)
-	if err != nil {
-		log.LogHandlerError(logger, fmt.Errorf("GetActiveReception error: %w", err), http.StatusBadRequest)
-		return operations.NewPostReceptionsBadRequest().WithPayload(&models.Error{
-			Message: swag.String("Ошибка при проверке активной приемки"),
+	if err := h.authorizer.AuthorizePVZ(params.HTTPRequest.Context(), *req.PvzID); err != nil {
+		if !errors.Is(err, auth.ErrPVZForbidden) {
+			log.LogHandlerError(logger, fmt.Errorf("AuthorizePVZ error: %w", err), http.StatusInternalServerError)
+			return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+		}
+		log.LogHandlerError(logger, err, http.StatusForbidden)
+		return operations.NewPostReceptionsForbidden().WithPayload(&models.Error{
+			Message: swag.String(err.Error()),
 		})
 	}
 
-	if activeReception {
+	reception, err := h.usecase.CreateReception(params.HTTPRequest.Context(), *req.PvzID, auth.UserIDFromContext(params.HTTPRequest.Context()))
+	if errors.Is(err, pvz.ErrReceptionNotClosed) {
 		log.LogHandlerError(logger, errors.New("previous reception not closed"), http.StatusBadRequest)
 		return operations.NewPostReceptionsBadRequest().WithPayload(&models.Error{
 			Message: swag.String("Невозможно создать новую приемку, так как предыдущая не закрыта"),
 		})
 	}
-
-	reception, err := h.usecase.CreateReception(params.HTTPRequest.Context(), *req.PvzID)
 	if err != nil {
 		log.LogHandlerError(logger, fmt.Errorf("CreateReception error: %w", err), http.StatusBadRequest)
 		return operations.NewPostReceptionsBadRequest().WithPayload(&models.Error{
@@ -110,10 +117,21 @@ func (h *PVZHandler) HandleAddProductToReception(params operations.PostProductsP
 	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
 	req := params.Body
 
+	if err := h.authorizer.AuthorizePVZ(params.HTTPRequest.Context(), *req.PvzID); err != nil {
+		if !errors.Is(err, auth.ErrPVZForbidden) {
+			log.LogHandlerError(logger, fmt.Errorf("AuthorizePVZ error: %w", err), http.StatusInternalServerError)
+			return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+		}
+		log.LogHandlerError(logger, err, http.StatusForbidden)
+		return operations.NewPostProductsForbidden().WithPayload(&models.Error{
+			Message: swag.String(err.Error()),
+		})
+	}
+
 	activeReception, _, err := h.usecase.GetActiveReception(params.HTTPRequest.Context(), *req.PvzID)
 	if err != nil {
-		log.LogHandlerError(logger, fmt.Errorf("GetActiveReception error: %w", err), http.StatusBadRequest)
-		return operations.NewPostProductsBadRequest().WithPayload(&models.Error{
+		log.LogHandlerError(logger, fmt.Errorf("GetActiveReception error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{
 			Message: swag.String("Ошибка при проверке активной приемки"),
 		})
 	}
@@ -130,7 +148,8 @@ func (h *PVZHandler) HandleAddProductToReception(params operations.PostProductsP
 		productType = *req.Type
 	}
 
-	product, err := h.usecase.AddProductToReception(params.HTTPRequest.Context(), *req.PvzID, productType)
+	product, err := h.usecase.AddProductToReception(params.HTTPRequest.Context(), *req.PvzID, productType,
+		auth.UserIDFromContext(params.HTTPRequest.Context()))
 	if err != nil {
 		log.LogHandlerError(logger, fmt.Errorf("AddProductToReception error: %w", err), http.StatusBadRequest)
 		return operations.NewPostProductsBadRequest().WithPayload(&models.Error{
@@ -145,9 +164,77 @@ func (h *PVZHandler) HandleAddProductToReception(params operations.PostProductsP
 	return operations.NewPostProductsCreated().WithPayload(product)
 }
 
+func (h *PVZHandler) HandleGetReception(params operations.GetReceptionsReceptionIDParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	reception, err := h.usecase.GetReception(params.HTTPRequest.Context(), params.ReceptionID)
+	if errors.Is(err, pvz.ErrReceptionNotFound) {
+		log.LogHandlerError(logger, fmt.Errorf("GetReception error: %w", err), http.StatusNotFound)
+		return operations.NewGetReceptionsReceptionIDNotFound().WithPayload(&models.Error{
+			Message: swag.String("Приемка не найдена"),
+		})
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("GetReception error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+	}
+	if !auth.CanAccessPVZ(params.HTTPRequest.Context(), *reception.PvzID) {
+		log.LogHandlerError(logger, auth.ErrPVZForbidden, http.StatusForbidden)
+		return operations.NewGetReceptionsReceptionIDForbidden().WithPayload(&models.Error{
+			Message: swag.String(auth.ErrPVZForbidden.Error()),
+		})
+	}
+
+	return operations.NewGetReceptionsReceptionIDOK().WithPayload(reception)
+}
+
+func (h *PVZHandler) HandleGetReceptionProducts(params operations.GetReceptionsReceptionIDProductsParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	page := int(*params.Page)
+	limit := int(*params.Limit)
+
+	products, err := h.usecase.GetReceptionProducts(params.HTTPRequest.Context(), params.ReceptionID, params.Type, page, limit)
+	switch {
+	case errors.Is(err, pvz.ErrReceptionNotFound):
+		log.LogHandlerError(logger, fmt.Errorf("GetReceptionProducts error: %w", err), http.StatusNotFound)
+		return operations.NewGetReceptionsReceptionIDProductsNotFound().WithPayload(&models.Error{
+			Message: swag.String("Приемка не найдена"),
+		})
+	case errors.Is(err, auth.ErrPVZForbidden):
+		log.LogHandlerError(logger, fmt.Errorf("GetReceptionProducts error: %w", err), http.StatusForbidden)
+		return operations.NewGetReceptionsReceptionIDProductsForbidden().WithPayload(&models.Error{
+			Message: swag.String(err.Error()),
+		})
+	case err != nil:
+		log.LogHandlerError(logger, fmt.Errorf("GetReceptionProducts error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{
+			Message: swag.String("Ошибка при получении товаров приемки"),
+		})
+	}
+
+	resp := operations.NewGetReceptionsReceptionIDProductsOK().WithPayload(products.Items).
+		WithXTotalCount(products.Total)
+	if int64(page)*int64(limit) < products.Total {
+		resp.WithXNextPage(strconv.Itoa(page + 1))
+	}
+	return resp
+}
+
 func (h *PVZHandler) HandleDeleteLastProduct(params operations.PostPvzPvzIDDeleteLastProductParams) middleware.Responder {
 	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
 
+	if err := h.authorizer.AuthorizePVZ(params.HTTPRequest.Context(), params.PvzID); err != nil {
+		if !errors.Is(err, auth.ErrPVZForbidden) {
+			log.LogHandlerError(logger, fmt.Errorf("AuthorizePVZ error: %w", err), http.StatusInternalServerError)
+			return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+		}
+		log.LogHandlerError(logger, err, http.StatusForbidden)
+		return operations.NewPostPvzPvzIDDeleteLastProductForbidden().WithPayload(&models.Error{
+			Message: swag.String(err.Error()),
+		})
+	}
+
 	err := h.usecase.DeleteLastProductFromReception(params.HTTPRequest.Context(), params.PvzID)
 	if err != nil {
 		log.LogHandlerError(logger, fmt.Errorf("DeleteLastProductFromReception error: %w", err), http.StatusBadRequest)
@@ -167,7 +254,18 @@ func (h *PVZHandler) HandleDeleteLastProduct(params operations.PostPvzPvzIDDelet
 func (h *PVZHandler) HandleCloseLastReception(params operations.PostPvzPvzIDCloseLastReceptionParams) middleware.Responder {
 	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
 
-	reception, err := h.usecase.CloseLastReception(params.HTTPRequest.Context(), params.PvzID)
+	if err := h.authorizer.AuthorizePVZ(params.HTTPRequest.Context(), params.PvzID); err != nil {
+		if !errors.Is(err, auth.ErrPVZForbidden) {
+			log.LogHandlerError(logger, fmt.Errorf("AuthorizePVZ error: %w", err), http.StatusInternalServerError)
+			return middleware.Error(http.StatusInternalServerError, &models.Error{Message: swag.String(err.Error())})
+		}
+		log.LogHandlerError(logger, err, http.StatusForbidden)
+		return operations.NewPostPvzPvzIDCloseLastReceptionForbidden().WithPayload(&models.Error{
+			Message: swag.String(err.Error()),
+		})
+	}
+
+	reception, err := h.usecase.CloseLastReception(params.HTTPRequest.Context(), params.PvzID, auth.UserIDFromContext(params.HTTPRequest.Context()))
 	if err != nil {
 		log.LogHandlerError(logger, fmt.Errorf("CloseLastReception error: %w", err), http.StatusBadRequest)
 		if err.Error() == "приемка уже закрыта или отсутствует" {
@@ -183,6 +281,41 @@ func (h *PVZHandler) HandleCloseLastReception(params operations.PostPvzPvzIDClos
 	return operations.NewPostPvzPvzIDCloseLastReceptionOK().WithPayload(reception)
 }
 
+func (h *PVZHandler) HandleGetPVZ(params operations.GetPvzPvzIDParams) middleware.Responder {
+	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
+
+	page := int(*params.Page)
+	limit := int(*params.Limit)
+
+	if !auth.CanAccessPVZ(params.HTTPRequest.Context(), params.PvzID) {
+		log.LogHandlerError(logger, auth.ErrPVZForbidden, http.StatusForbidden)
+		return operations.NewGetPvzPvzIDForbidden().WithPayload(&models.Error{
+			Message: swag.String(auth.ErrPVZForbidden.Error()),
+		})
+	}
+
+	details, err := h.usecase.GetPVZ(params.HTTPRequest.Context(), params.PvzID, page, limit)
+	if errors.Is(err, pvz.ErrPVZNotFound) {
+		log.LogHandlerError(logger, fmt.Errorf("GetPVZ error: %w", err), http.StatusNotFound)
+		return operations.NewGetPvzPvzIDNotFound().WithPayload(&models.Error{
+			Message: swag.String("ПВЗ не найден"),
+		})
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("GetPVZ error: %w", err), http.StatusInternalServerError)
+		return middleware.Error(http.StatusInternalServerError, &models.Error{
+			Message: swag.String("Ошибка при получении ПВЗ"),
+		})
+	}
+
+	resp := operations.NewGetPvzPvzIDOK().WithPayload(toGetPvzPvzIDBody(details)).
+		WithXTotalCount(details.Total)
+	if int64(page)*int64(limit) < details.Total {
+		resp.WithXNextPage(strconv.Itoa(page + 1))
+	}
+	return resp
+}
+
 func (h *PVZHandler) HandleGetPVZs(params operations.GetPvzParams) middleware.Responder {
 	logger := log.GetLoggerFromContext(params.HTTPRequest.Context()).With(slog.String("func", log.GetFuncName()))
 
@@ -206,14 +339,82 @@ func (h *PVZHandler) HandleGetPVZs(params operations.GetPvzParams) middleware.Re
 		endDate = &t
 	}
 
+	period := pvz.ReceptionPeriod{
+		Start: startDate,
+		End:   endDate,
+		Field: pvz.ReceptionTimeField(swag.StringValue(params.DateField)),
+	}
 	page := int(*params.Page)
 	limit := int(*params.Limit)
 
-	pvzs, err := h.usecase.GetPVZs(params.HTTPRequest.Context(), startDate, endDate, page, limit)
+	if params.Cursor != nil {
+		list, err := h.usecase.GetPVZsByCursor(params.HTTPRequest.Context(), period, *params.Cursor, limit)
+		if errors.Is(err, pvz.ErrInvalidCursor) {
+			log.LogHandlerError(logger, fmt.Errorf("GetPVZsByCursor error: %w", err), http.StatusBadRequest)
+			return operations.NewGetPvzBadRequest().WithPayload(&models.Error{
+				Message: swag.String("Некорректный курсор"),
+			})
+		}
+		if err != nil {
+			// Пустой ответ клиент принял бы за последнюю страницу и перестал бы листать
+			log.LogHandlerError(logger, fmt.Errorf("GetPVZsByCursor error: %w", err), http.StatusInternalServerError)
+			return middleware.Error(http.StatusInternalServerError, &models.Error{
+				Message: swag.String("Ошибка при получении списка ПВЗ"),
+			})
+		}
+
+		return operations.NewGetPvzOK().WithPayload(toGetPvzItems(list.Items)).
+			WithXTotalCount(list.Total).
+			WithXNextCursor(list.NextCursor)
+	}
+
+	list, err := h.usecase.GetPVZs(params.HTTPRequest.Context(), period, page, limit)
 	if err != nil {
 		log.LogHandlerError(logger, fmt.Errorf("GetPVZs error: %w", err), http.StatusInternalServerError)
 		return operations.NewGetPvzOK()
 	}
 
-	return operations.NewGetPvzOK().WithPayload(pvzs)
+	resp := operations.NewGetPvzOK().WithPayload(toGetPvzItems(list.Items)).
+		WithXTotalCount(list.Total).
+		WithXNextCursor(list.NextCursor)
+	if int64(page)*int64(limit) < list.Total {
+		resp.WithXNextPage(strconv.Itoa(page + 1))
+	}
+	return resp
+}
+
+func toGetPvzPvzIDBody(details *pvz.PVZDetails) *operations.GetPvzPvzIDOKBody {
+	receptions := make([]*operations.GetPvzPvzIDOKBodyReceptionsItems0, 0, len(details.Receptions))
+	for _, item := range details.Receptions {
+		receptions = append(receptions, &operations.GetPvzPvzIDOKBodyReceptionsItems0{
+			Reception:    item.Reception,
+			ProductCount: item.ProductCount,
+		})
+	}
+
+	return &operations.GetPvzPvzIDOKBody{
+		Pvz:             details.PVZ,
+		ActiveReception: details.ActiveReception,
+		Receptions:      receptions,
+	}
+}
+
+func toGetPvzItems(items []*pvz.PVZWithReceptions) []*operations.GetPvzOKBodyItems0 {
+	result := make([]*operations.GetPvzOKBodyItems0, 0, len(items))
+	for _, item := range items {
+		receptionItems := make([]*operations.GetPvzOKBodyItems0ReceptionsItems0, 0, len(item.Receptions))
+		for _, reception := range item.Receptions {
+			receptionItems = append(receptionItems, &operations.GetPvzOKBodyItems0ReceptionsItems0{
+				Products:  reception.Products,
+				Reception: reception.Reception,
+			})
+		}
+
+		result = append(result, &operations.GetPvzOKBodyItems0{
+			Pvz:        item.PVZ,
+			Receptions: receptionItems,
+		})
+	}
+
+	return result
 }
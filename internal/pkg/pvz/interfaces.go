@@ -2,30 +2,45 @@ package pvz
 
 import (
 	"context"
-	"time"
+	"errors"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/totorialman/go-task-avito/models"
-	"github.com/totorialman/go-task-avito/restapi/operations"
+)
+
+var (
+	ErrReceptionNotClosed = errors.New("невозможно создать новую приемку, так как предыдущая не закрыта")
+	ErrPVZNotFound        = errors.New("ПВЗ не найден")
+	ErrReceptionNotFound  = errors.New("приемка не найдена")
 )
 
 type PVZRepository interface {
+	WithTx(ctx context.Context, fn func(repo PVZRepository) error) error
 	CreatePVZ(ctx context.Context, pvz *models.PVZ) error
+	GetPVZ(ctx context.Context, pvzID strfmt.UUID) (*models.PVZ, error)
+	GetPastReceptions(ctx context.Context, pvzID strfmt.UUID, page, limit int) (*ReceptionPage, error)
 	CreateReception(ctx context.Context, reception *models.Reception) error
+	GetReception(ctx context.Context, receptionID strfmt.UUID) (*models.Reception, error)
+	GetReceptionProducts(ctx context.Context, receptionID strfmt.UUID, productType *string, page, limit int) (*ProductPage, error)
 	GetActiveReception(ctx context.Context, pvzID strfmt.UUID) (bool, *models.Reception, error)
+	GetActiveReceptionForUpdate(ctx context.Context, pvzID strfmt.UUID) (bool, *models.Reception, error)
 	CreateProduct(ctx context.Context, product *models.Product) error
 	DeleteLastProduct(ctx context.Context, receptionID strfmt.UUID) error
-	UpdateReceptionStatus(ctx context.Context, reception models.Reception) error
-	GetCloseReception(ctx context.Context, pvzID strfmt.UUID) (bool, *models.Reception, error)
-	GetPVZsWithReceptions(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]map[string]interface{}, error)
+	UpdateReceptionStatus(ctx context.Context, reception models.Reception) (*models.Reception, error)
+	GetPVZsWithReceptions(ctx context.Context, period ReceptionPeriod, page, limit int) (*PVZPage, error)
+	GetPVZsWithReceptionsAfter(ctx context.Context, period ReceptionPeriod, after *PVZCursor, limit int) (*PVZPage, error)
 }
 
 type PVZUsecase interface {
-	CreatePVZ(ctx context.Context, city string, id strfmt.UUID, date strfmt.DateTime) (*models.PVZ, error) 
+	CreatePVZ(ctx context.Context, city string, id strfmt.UUID, date strfmt.DateTime, createdBy strfmt.UUID) (*models.PVZ, error)
 	CreateReception(ctx context.Context, pvzID, createdBy strfmt.UUID) (*models.Reception, error)
-	GetPVZs(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]*operations.GetPvzOKBodyItems0, error)
-	CloseLastReception(ctx context.Context, pvzID strfmt.UUID) (*models.Reception, error) 
+	GetPVZ(ctx context.Context, pvzID strfmt.UUID, page, limit int) (*PVZDetails, error)
+	GetPVZs(ctx context.Context, period ReceptionPeriod, page, limit int) (*PVZList, error)
+	GetPVZsByCursor(ctx context.Context, period ReceptionPeriod, cursor string, limit int) (*PVZList, error)
+	CloseLastReception(ctx context.Context, pvzID, closedBy strfmt.UUID) (*models.Reception, error)
 	DeleteLastProductFromReception(ctx context.Context, pvzID strfmt.UUID) error 
-	AddProductToReception(ctx context.Context, pvzID strfmt.UUID, productType string) (*models.Product, error)
+	AddProductToReception(ctx context.Context, pvzID strfmt.UUID, productType string, addedBy strfmt.UUID) (*models.Product, error)
 	GetActiveReception(ctx context.Context, pvzID strfmt.UUID) (*models.Reception, error)
+	GetReception(ctx context.Context, receptionID strfmt.UUID) (*models.Reception, error)
+	GetReceptionProducts(ctx context.Context, receptionID strfmt.UUID, productType *string, page, limit int) (*ProductPage, error)
 }
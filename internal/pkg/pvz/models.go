@@ -0,0 +1,71 @@
+package pvz
+
+import (
+	"time"
+
+	"github.com/totorialman/go-task-avito/models"
+)
+
+// ReceptionTimeField — момент жизни приемки, по которому фильтруется период.
+type ReceptionTimeField string
+
+const (
+	ReceptionOpenedAt ReceptionTimeField = "openedAt"
+	ReceptionClosedAt ReceptionTimeField = "closedAt"
+)
+
+// ReceptionPeriod отбирает приемки, открытые (или закрытые) в [Start, End].
+// Пустой Field означает ReceptionOpenedAt.
+type ReceptionPeriod struct {
+	Start *time.Time
+	End   *time.Time
+	Field ReceptionTimeField
+}
+
+type ReceptionWithProducts struct {
+	Reception *models.Reception
+	Products  []*models.Product
+}
+
+type PVZWithReceptions struct {
+	PVZ        *models.PVZ
+	Receptions []*ReceptionWithProducts
+}
+
+type PVZPage struct {
+	Items []*PVZWithReceptions
+	Total int64
+	// Next равен nil на последней странице.
+	Next *PVZCursor
+}
+
+// ReceptionSummary — приемка без списка товаров, только с их количеством.
+type ReceptionSummary struct {
+	Reception    *models.Reception
+	ProductCount int64
+}
+
+type ReceptionPage struct {
+	Items []*ReceptionSummary
+	Total int64
+}
+
+type ProductPage struct {
+	Items []*models.Product
+	Total int64
+}
+
+// PVZDetails — ПВЗ с активной приемкой и страницей прошлых приемок; Total — общее
+// число прошлых приемок для заголовков.
+type PVZDetails struct {
+	PVZ             *models.PVZ
+	ActiveReception *models.Reception
+	Receptions      []*ReceptionSummary
+	Total           int64
+}
+
+type PVZList struct {
+	Items      []*PVZWithReceptions
+	Total      int64
+	NextCursor string
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"time"
@@ -9,24 +10,66 @@ import (
 	"log/slog"
 
 	"github.com/go-openapi/strfmt"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgtype/pgxtype"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/totorialman/go-task-avito/internal/pkg/pvz"
 	"github.com/totorialman/go-task-avito/internal/pkg/utils/log"
 	"github.com/totorialman/go-task-avito/models"
 )
 
+const (
+	uniqueViolationCode     = "23505"
+	openReceptionConstraint = "receptions_one_in_progress_per_pvz"
+)
+
+// receptionColumns — порядок колонок, который ожидает scanReception.
+const receptionColumns = `id, pvz_id, status, date_time, opened_at, closed_at, closed_by, created_by`
+
 type PVZRepo struct {
-	db *pgxpool.Pool
+	db   pgxtype.Querier
+	pool *pgxpool.Pool
 }
 
 func NewPVZRepo(db *pgxpool.Pool) *PVZRepo {
-	return &PVZRepo{db: db}
+	return &PVZRepo{db: db, pool: db}
+}
+
+// WithTx выполняет fn в транзакции: все вызовы repo внутри fn идут через нее.
+// Транзакция фиксируется, если fn вернула nil, и откатывается в противном случае.
+// Вложенный вызов переиспользует уже открытую транзакцию.
+func (r *PVZRepo) WithTx(ctx context.Context, fn func(repo pvz.PVZRepository) error) error {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	if r.pool == nil {
+		return fn(r)
+	}
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to begin transaction: %w", err), http.StatusInternalServerError)
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := fn(&PVZRepo{db: tx}); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to commit transaction: %w", err), http.StatusInternalServerError)
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
 }
 
 func (r *PVZRepo) CreatePVZ(ctx context.Context, pvz *models.PVZ) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
 
-	query := `INSERT INTO pvz (id, city, registration_date) VALUES ($1, $2, $3)`
-	_, err := r.db.Exec(ctx, query, pvz.ID, pvz.City, pvz.RegistrationDate)
+	query := `INSERT INTO pvz (id, city, registration_date, created_by) VALUES ($1, $2, $3, $4)`
+	_, err := r.db.Exec(ctx, query, pvz.ID, pvz.City, pvz.RegistrationDate, pvz.CreatedBy)
 
 	if err != nil {
 		log.LogHandlerError(logger, fmt.Errorf("db exec error: %w", err), http.StatusInternalServerError)
@@ -36,17 +79,91 @@ func (r *PVZRepo) CreatePVZ(ctx context.Context, pvz *models.PVZ) error {
 	return nil
 }
 
+func (r *PVZRepo) GetPVZ(ctx context.Context, pvzID strfmt.UUID) (*models.PVZ, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	var result models.PVZ
+	var registrationDate time.Time
+	err := r.db.QueryRow(ctx, `SELECT id, city, registration_date, created_by FROM pvz WHERE id = $1`, pvzID).
+		Scan(&result.ID, &result.City, &registrationDate, &result.CreatedBy)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, pvz.ErrPVZNotFound
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get pvz: %w", err), http.StatusInternalServerError)
+		return nil, fmt.Errorf("failed to get pvz: %w", err)
+	}
+	result.RegistrationDate = strfmt.DateTime(registrationDate)
+
+	return &result, nil
+}
+
+// GetPastReceptions постранично возвращает закрытые приемки ПВЗ, начиная с последней закрытой.
+func (r *PVZRepo) GetPastReceptions(ctx context.Context, pvzID strfmt.UUID, page, limit int) (*pvz.ReceptionPage, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	var total int64
+	err := r.db.QueryRow(ctx, `
+        SELECT COUNT(*)
+        FROM receptions
+        WHERE pvz_id = $1 AND status = 'closed'`, pvzID).Scan(&total)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("error counting receptions: %w", err), http.StatusInternalServerError)
+		return nil, fmt.Errorf("error counting receptions: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, `
+        SELECT `+receptionColumns+`,
+               (SELECT COUNT(*) FROM products p WHERE p.reception_id = receptions.id)
+        FROM receptions
+        WHERE pvz_id = $1 AND status = 'closed'
+        ORDER BY closed_at DESC NULLS LAST, opened_at DESC, id
+        LIMIT $2 OFFSET $3`, pvzID, limit, (page-1)*limit)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("error selecting receptions: %w", err), http.StatusInternalServerError)
+		return nil, fmt.Errorf("error selecting receptions: %w", err)
+	}
+	defer rows.Close()
+
+	result := &pvz.ReceptionPage{Items: []*pvz.ReceptionSummary{}, Total: total}
+	for rows.Next() {
+		var reception models.Reception
+		var productCount int64
+		if err := rows.Scan(append(receptionDest(&reception), &productCount)...); err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("error scanning reception: %w", err), http.StatusInternalServerError)
+			return nil, fmt.Errorf("error scanning reception: %w", err)
+		}
+		result.Items = append(result.Items, &pvz.ReceptionSummary{Reception: &reception, ProductCount: productCount})
+	}
+	if err := rows.Err(); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("reception iteration error: %w", err), http.StatusInternalServerError)
+		return nil, fmt.Errorf("reception iteration error: %w", err)
+	}
+
+	return result, nil
+}
+
+func isUniqueViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == uniqueViolationCode && pgErr.ConstraintName == constraint
+}
+
 func (r *PVZRepo) CreateReception(ctx context.Context, reception *models.Reception) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
 
 	currentTime := strfmt.DateTime(time.Now())
 	reception.DateTime = &currentTime
+	reception.OpenedAt = currentTime
 
 	_, err := r.db.Exec(ctx, `
-        INSERT INTO receptions (id, pvz_id, status, date_time)
-        VALUES ($1, $2, $3, $4)`,
-		reception.ID, reception.PvzID, reception.Status, reception.DateTime)
+        INSERT INTO receptions (id, pvz_id, status, date_time, opened_at, created_by)
+        VALUES ($1, $2, $3, $4, $4, $5)`,
+		reception.ID, reception.PvzID, reception.Status, reception.DateTime, reception.CreatedBy)
 
+	if isUniqueViolation(err, openReceptionConstraint) {
+		log.LogHandlerError(logger, pvz.ErrReceptionNotClosed, http.StatusBadRequest)
+		return pvz.ErrReceptionNotClosed
+	}
 	if err != nil {
 		log.LogHandlerError(logger, fmt.Errorf("failed to create reception: %w", err), http.StatusInternalServerError)
 		return fmt.Errorf("failed to create reception: %w", err)
@@ -55,19 +172,109 @@ func (r *PVZRepo) CreateReception(ctx context.Context, reception *models.Recepti
 	return nil
 }
 
-func (r *PVZRepo) GetActiveReception(ctx context.Context, pvzID strfmt.UUID) (bool, *models.Reception, error) {
+func (r *PVZRepo) GetReception(ctx context.Context, receptionID strfmt.UUID) (*models.Reception, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
 
 	var reception models.Reception
+	err := scanReception(r.db.QueryRow(ctx, `
+        SELECT `+receptionColumns+`
+        FROM receptions
+        WHERE id = $1`, receptionID), &reception)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, pvz.ErrReceptionNotFound
+	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get reception: %w", err), http.StatusInternalServerError)
+		return nil, fmt.Errorf("failed to get reception: %w", err)
+	}
+
+	return &reception, nil
+}
+
+// GetReceptionProducts постранично возвращает товары приемки в порядке сканирования,
+// при productType != nil — только товары этого типа.
+func (r *PVZRepo) GetReceptionProducts(ctx context.Context, receptionID strfmt.UUID, productType *string, page, limit int) (*pvz.ProductPage, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	var total int64
 	err := r.db.QueryRow(ctx, `
-        SELECT id, pvz_id, status, date_time
+        SELECT COUNT(*)
+        FROM products
+        WHERE reception_id = $1 AND ($2::text IS NULL OR type = $2)`, receptionID, productType).Scan(&total)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("error counting products: %w", err), http.StatusInternalServerError)
+		return nil, fmt.Errorf("error counting products: %w", err)
+	}
+
+	rows, err := r.db.Query(ctx, `
+        SELECT id, type, date_time, sequence, added_by
+        FROM products
+        WHERE reception_id = $1 AND ($2::text IS NULL OR type = $2)
+        ORDER BY sequence
+        LIMIT $3 OFFSET $4`, receptionID, productType, limit, (page-1)*limit)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("error selecting products: %w", err), http.StatusInternalServerError)
+		return nil, fmt.Errorf("error selecting products: %w", err)
+	}
+	defer rows.Close()
+
+	result := &pvz.ProductPage{Items: []*models.Product{}, Total: total}
+	for rows.Next() {
+		product := &models.Product{ReceptionID: &receptionID}
+		var dateTime time.Time
+		if err := rows.Scan(&product.ID, &product.Type, &dateTime, &product.Sequence, &product.AddedBy); err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("error scanning product: %w", err), http.StatusInternalServerError)
+			return nil, fmt.Errorf("error scanning product: %w", err)
+		}
+		product.DateTime = strfmt.DateTime(dateTime)
+		result.Items = append(result.Items, product)
+	}
+	if err := rows.Err(); err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("product iteration error: %w", err), http.StatusInternalServerError)
+		return nil, fmt.Errorf("product iteration error: %w", err)
+	}
+
+	return result, nil
+}
+
+func (r *PVZRepo) GetActiveReception(ctx context.Context, pvzID strfmt.UUID) (bool, *models.Reception, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	var reception models.Reception
+	err := scanReception(r.db.QueryRow(ctx, `
+        SELECT `+receptionColumns+`
         FROM receptions
         WHERE pvz_id = $1 AND status = 'in_progress'
-        LIMIT 1`, pvzID).Scan(&reception.ID, &reception.PvzID, &reception.Status, &reception.DateTime)
+        LIMIT 1`, pvzID), &reception)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil, nil
+	}
 	if err != nil {
 		log.LogHandlerError(logger, fmt.Errorf("failed to get active reception: %w", err), http.StatusInternalServerError)
+		return false, nil, fmt.Errorf("failed to get active reception: %w", err)
+	}
+
+	return true, &reception, nil
+}
+
+// GetActiveReceptionForUpdate блокирует строку открытой приемки до конца транзакции,
+// поэтому должна вызываться внутри WithTx.
+func (r *PVZRepo) GetActiveReceptionForUpdate(ctx context.Context, pvzID strfmt.UUID) (bool, *models.Reception, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	var reception models.Reception
+	err := scanReception(r.db.QueryRow(ctx, `
+        SELECT `+receptionColumns+`
+        FROM receptions
+        WHERE pvz_id = $1 AND status = 'in_progress'
+        FOR UPDATE`, pvzID), &reception)
+	if errors.Is(err, pgx.ErrNoRows) {
 		return false, nil, nil
 	}
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to lock active reception: %w", err), http.StatusInternalServerError)
+		return false, nil, fmt.Errorf("failed to lock active reception: %w", err)
+	}
 
 	return true, &reception, nil
 }
@@ -77,8 +284,19 @@ func (r *PVZRepo) CreateProduct(ctx context.Context, product *models.Product) er
 
 	currentTime := strfmt.DateTime(time.Now())
 	product.DateTime = currentTime
-	_, err := r.db.Exec(ctx, `INSERT INTO products (reception_id, type, date_time) VALUES ($1, $2, $3)`,
-		product.ReceptionID, product.Type, product.DateTime)
+	// Номер выдает счетчик next_sequence приемки, поэтому после удаления последнего
+	// товара его номер не используется снова. UPDATE блокирует строку приемки, так что
+	// параллельные вставки получают разные номера и без внешней транзакции.
+	err := r.db.QueryRow(ctx, `
+        WITH seq AS (
+            UPDATE receptions SET next_sequence = next_sequence + 1
+            WHERE id = $1
+            RETURNING next_sequence - 1 AS sequence
+        )
+        INSERT INTO products (reception_id, type, date_time, sequence, added_by)
+        SELECT $1, $2, $3, seq.sequence, $4 FROM seq
+        RETURNING id, sequence`,
+		product.ReceptionID, product.Type, product.DateTime, product.AddedBy).Scan(&product.ID, &product.Sequence)
 
 	if err != nil {
 		log.LogHandlerError(logger, fmt.Errorf("failed to create product: %w", err), http.StatusInternalServerError)
@@ -96,7 +314,7 @@ func (r *PVZRepo) DeleteLastProduct(ctx context.Context, receptionID strfmt.UUID
         SELECT id
         FROM products
         WHERE reception_id = $1
-        ORDER BY date_time DESC
+        ORDER BY sequence DESC
         LIMIT 1`, receptionID).Scan(&productID)
 	if err != nil {
 		log.LogHandlerError(logger, fmt.Errorf("failed to get the last product: %w", err), http.StatusInternalServerError)
@@ -112,130 +330,308 @@ func (r *PVZRepo) DeleteLastProduct(ctx context.Context, receptionID strfmt.UUID
 	return nil
 }
 
-func (r *PVZRepo) UpdateReceptionStatus(ctx context.Context, reception models.Reception) error {
+// UpdateReceptionStatus меняет статус приемки и возвращает ее строку после обновления.
+// Время открытия не трогается, при закрытии проставляются closed_at и closed_by.
+func (r *PVZRepo) UpdateReceptionStatus(ctx context.Context, reception models.Reception) (*models.Reception, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
 
-	currentTime := strfmt.DateTime(time.Now())
-	reception.DateTime = &currentTime
-	query := `UPDATE receptions SET status = $1, date_time = $2 WHERE id = $3`
-	_, err := r.db.Exec(ctx, query, reception.Status, reception.DateTime, reception.ID)
+	var updated models.Reception
+	err := scanReception(r.db.QueryRow(ctx, `
+        UPDATE receptions
+        SET status = $1,
+            closed_at = CASE WHEN $1 = 'closed' THEN COALESCE(closed_at, $2) ELSE NULL END,
+            closed_by = CASE WHEN $1 = 'closed' THEN COALESCE(closed_by, $3) ELSE NULL END
+        WHERE id = $4
+        RETURNING `+receptionColumns,
+		reception.Status, time.Now(), reception.ClosedBy, reception.ID), &updated)
 	if err != nil {
 		log.LogHandlerError(logger, fmt.Errorf("failed to update reception status: %w", err), http.StatusInternalServerError)
-		return fmt.Errorf("failed to update reception status: %w", err)
+		return nil, fmt.Errorf("failed to update reception status: %w", err)
 	}
-	return nil
+	return &updated, nil
+}
+
+// pvzQueries — запросы выборки ПВЗ, в которых период применяется к одной колонке receptions.
+type pvzQueries struct {
+	count      string
+	page       string
+	after      string
+	receptions string
 }
 
-func (r *PVZRepo) GetCloseReception(ctx context.Context, pvzID strfmt.UUID) (bool, *models.Reception, error) {
+// newPVZQueries строит запросы для column; column подставляется в SQL как есть, поэтому берется только из queriesByTimeField.
+func newPVZQueries(column string) pvzQueries {
+	// filter оставляет ПВЗ, у которых есть приемки в периоде [$1, $2], либо все ПВЗ без периода.
+	filter := `
+		(($1::timestamp IS NULL AND $2::timestamp IS NULL)
+		   OR EXISTS (
+				SELECT 1 FROM receptions r
+				WHERE r.pvz_id = pvz.id
+				  AND ($1::timestamp IS NULL OR r.` + column + ` >= $1)
+				  AND ($2::timestamp IS NULL OR r.` + column + ` <= $2)))`
+
+	return pvzQueries{
+		count: `
+		SELECT COUNT(*)
+		FROM pvz
+		WHERE ` + filter,
+		page: `
+		SELECT pvz.id, pvz.city, pvz.registration_date, pvz.created_by
+		FROM pvz
+		WHERE ` + filter + `
+		ORDER BY pvz.registration_date, pvz.id
+		LIMIT $3 OFFSET $4`,
+		after: `
+		SELECT pvz.id, pvz.city, pvz.registration_date, pvz.created_by
+		FROM pvz
+		WHERE ` + filter + `
+		  AND ($3::timestamp IS NULL OR (pvz.registration_date, pvz.id) > ($3::timestamp, $4::uuid))
+		ORDER BY pvz.registration_date, pvz.id
+		LIMIT $5`,
+		receptions: `
+		SELECT ` + receptionColumns + `
+		FROM receptions
+		WHERE pvz_id = ANY($1::uuid[])
+		  AND ($2::timestamp IS NULL OR ` + column + ` >= $2)
+		  AND ($3::timestamp IS NULL OR ` + column + ` <= $3)
+		ORDER BY opened_at, id`,
+	}
+}
+
+var queriesByTimeField = map[pvz.ReceptionTimeField]pvzQueries{
+	pvz.ReceptionOpenedAt: newPVZQueries("opened_at"),
+	pvz.ReceptionClosedAt: newPVZQueries("closed_at"),
+}
+
+func queriesFor(period pvz.ReceptionPeriod) pvzQueries {
+	if q, ok := queriesByTimeField[period.Field]; ok {
+		return q
+	}
+	return queriesByTimeField[pvz.ReceptionOpenedAt]
+}
+
+const selectProductsByReceptionsQuery = `
+		SELECT id, reception_id, type, date_time, sequence, added_by
+		FROM products
+		WHERE reception_id = ANY($1::uuid[])
+		ORDER BY reception_id, sequence`
+
+// GetPVZsWithReceptions постранично выбирает ПВЗ (а не строки join'а), после чего
+// догружает их приемки за период и товары этих приемок.
+func (r *PVZRepo) GetPVZsWithReceptions(ctx context.Context, period pvz.ReceptionPeriod, page, limit int) (*pvz.PVZPage, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
 
-	var reception models.Reception
-	err := r.db.QueryRow(ctx, `
-        SELECT id, pvz_id, status, date_time
-        FROM receptions
-        WHERE pvz_id = $1 AND status = 'closed'
-        LIMIT 1`, pvzID).Scan(&reception.ID, &reception.PvzID, &reception.Status, &reception.DateTime)
+	queries := queriesFor(period)
+	offset := (page - 1) * limit
+
+	total, err := r.countPVZs(ctx, queries, period)
 	if err != nil {
-		log.LogHandlerError(logger, fmt.Errorf("failed to get closed reception: %w", err), http.StatusInternalServerError)
-		return false, nil, nil
+		log.LogHandlerError(logger, err, http.StatusInternalServerError)
+		return nil, err
 	}
-	return true, &reception, nil
+
+	rows, err := r.db.Query(ctx, queries.page, period.Start, period.End, limit, offset)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("error executing query: %w", err), http.StatusInternalServerError)
+		return nil, fmt.Errorf("error executing query: %w", err)
+	}
+	items, err := scanPVZs(rows)
+	if err != nil {
+		log.LogHandlerError(logger, err, http.StatusInternalServerError)
+		return nil, err
+	}
+
+	if err := r.loadReceptions(ctx, items, queries, period); err != nil {
+		log.LogHandlerError(logger, err, http.StatusInternalServerError)
+		return nil, err
+	}
+
+	result := &pvz.PVZPage{Items: items, Total: total}
+	if len(items) > 0 && int64(offset+len(items)) < total {
+		result.Next = cursorOf(items[len(items)-1])
+	}
+
+	return result, nil
 }
 
-func (r *PVZRepo) GetPVZsWithReceptions(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]map[string]interface{}, error) {
+// GetPVZsWithReceptionsAfter — keyset-вариант GetPVZsWithReceptions: выбирает limit ПВЗ,
+// идущих строго после after в порядке (registration_date, id).
+func (r *PVZRepo) GetPVZsWithReceptionsAfter(ctx context.Context, period pvz.ReceptionPeriod, after *pvz.PVZCursor, limit int) (*pvz.PVZPage, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
 
-	offset := (page - 1) * limit
+	queries := queriesFor(period)
 
-	query := `
-		SELECT pvz.id, pvz.city, pvz.registration_date, 
-		       r.id AS reception_id, r.date_time AS reception_date, r.status AS reception_status,
-		       p.id AS product_id, p.type AS product_type, p.date_time AS product_date_time
-		FROM pvz
-		LEFT JOIN receptions r ON pvz.id = r.pvz_id
-		LEFT JOIN products p ON r.id = p.reception_id
-		WHERE ($1::timestamp IS NULL OR r.date_time >= $1)
-		  AND ($2::timestamp IS NULL OR r.date_time <= $2)
-		ORDER BY r.date_time
-		LIMIT $3 OFFSET $4
-	`
+	total, err := r.countPVZs(ctx, queries, period)
+	if err != nil {
+		log.LogHandlerError(logger, err, http.StatusInternalServerError)
+		return nil, err
+	}
 
-	rows, err := r.db.Query(ctx, query, startDate, endDate, limit, offset)
+	var afterDate *time.Time
+	var afterID *string
+	if after != nil {
+		id := after.ID.String()
+		afterDate = &after.RegistrationDate
+		afterID = &id
+	}
+
+	// Запрашиваем на одну запись больше, чтобы понять, есть ли следующая страница.
+	rows, err := r.db.Query(ctx, queries.after, period.Start, period.End, afterDate, afterID, limit+1)
 	if err != nil {
 		log.LogHandlerError(logger, fmt.Errorf("error executing query: %w", err), http.StatusInternalServerError)
 		return nil, fmt.Errorf("error executing query: %w", err)
 	}
-	defer rows.Close()
+	items, err := scanPVZs(rows)
+	if err != nil {
+		log.LogHandlerError(logger, err, http.StatusInternalServerError)
+		return nil, err
+	}
+
+	hasNext := len(items) > limit
+	if hasNext {
+		items = items[:limit]
+	}
+
+	if err := r.loadReceptions(ctx, items, queries, period); err != nil {
+		log.LogHandlerError(logger, err, http.StatusInternalServerError)
+		return nil, err
+	}
 
-	pvzsMap := make(map[strfmt.UUID]map[string]interface{})
+	result := &pvz.PVZPage{Items: items, Total: total}
+	if hasNext {
+		result.Next = cursorOf(items[len(items)-1])
+	}
+
+	return result, nil
+}
+
+func (r *PVZRepo) countPVZs(ctx context.Context, queries pvzQueries, period pvz.ReceptionPeriod) (int64, error) {
+	var total int64
+	if err := r.db.QueryRow(ctx, queries.count, period.Start, period.End).Scan(&total); err != nil {
+		return 0, fmt.Errorf("error counting pvzs: %w", err)
+	}
+	return total, nil
+}
 
+// receptionDest возвращает адреса полей reception в порядке receptionColumns.
+func receptionDest(reception *models.Reception) []interface{} {
+	return []interface{}{&reception.ID, &reception.PvzID, &reception.Status, &reception.DateTime,
+		&reception.OpenedAt, &reception.ClosedAt, &reception.ClosedBy, &reception.CreatedBy}
+}
+
+func scanReception(row pgx.Row, reception *models.Reception) error {
+	return row.Scan(receptionDest(reception)...)
+}
+
+func cursorOf(item *pvz.PVZWithReceptions) *pvz.PVZCursor {
+	return &pvz.PVZCursor{
+		RegistrationDate: time.Time(item.PVZ.RegistrationDate),
+		ID:               item.PVZ.ID,
+	}
+}
+
+func scanPVZs(rows pgx.Rows) ([]*pvz.PVZWithReceptions, error) {
+	defer rows.Close()
+
+	var items []*pvz.PVZWithReceptions
 	for rows.Next() {
 		var pvzID strfmt.UUID
 		var city string
 		var registrationDate time.Time
-		var receptionID strfmt.UUID
-		var receptionDate time.Time
-		var receptionStatus string
-		var productID strfmt.UUID
-		var productType string
-		var productDateTime time.Time
-
-		if err := rows.Scan(&pvzID, &city, &registrationDate, &receptionID, &receptionDate, &receptionStatus, &productID, &productType, &productDateTime); err != nil {
-			log.LogHandlerError(logger, fmt.Errorf("error scanning row: %w", err), http.StatusInternalServerError)
+		var createdBy *strfmt.UUID
+		if err := rows.Scan(&pvzID, &city, &registrationDate, &createdBy); err != nil {
 			return nil, fmt.Errorf("error scanning row: %w", err)
 		}
+		items = append(items, &pvz.PVZWithReceptions{
+			PVZ: &models.PVZ{
+				ID:               pvzID,
+				City:             &city,
+				RegistrationDate: strfmt.DateTime(registrationDate),
+				CreatedBy:        createdBy,
+			},
+			Receptions: []*pvz.ReceptionWithProducts{},
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("row iteration error: %w", err)
+	}
 
-		if _, exists := pvzsMap[pvzID]; !exists {
-			pvzsMap[pvzID] = map[string]interface{}{
-				"pvz": &models.PVZ{
-					ID:               pvzID,
-					City:             &city,
-					RegistrationDate: strfmt.DateTime(registrationDate),
-				},
-				"receptions": make(map[strfmt.UUID]map[string]interface{}),
-			}
-		}
-		pvzData := pvzsMap[pvzID]
-		receptions := pvzData["receptions"].(map[strfmt.UUID]map[string]interface{})
-
-		if _, exists := receptions[receptionID]; !exists {
-			receptions[receptionID] = map[string]interface{}{
-				"reception": &models.Reception{
-					ID:       receptionID,
-					PvzID:    &pvzID,
-					DateTime: (*strfmt.DateTime)(&receptionDate),
-					Status:   &receptionStatus,
-				},
-				"products": []models.Product{},
-			}
+	return items, nil
+}
+
+// loadReceptions заполняет items приемками за период и товарами, сохраняя порядок по времени.
+func (r *PVZRepo) loadReceptions(ctx context.Context, items []*pvz.PVZWithReceptions, queries pvzQueries, period pvz.ReceptionPeriod) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	byPVZ := make(map[strfmt.UUID]*pvz.PVZWithReceptions, len(items))
+	pvzIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		byPVZ[item.PVZ.ID] = item
+		pvzIDs = append(pvzIDs, item.PVZ.ID.String())
+	}
+
+	rows, err := r.db.Query(ctx, queries.receptions, pvzIDs, period.Start, period.End)
+	if err != nil {
+		return fmt.Errorf("error selecting receptions: %w", err)
+	}
+	defer rows.Close()
+
+	byReception := make(map[strfmt.UUID]*pvz.ReceptionWithProducts)
+	var receptionIDs []string
+	for rows.Next() {
+		var row models.Reception
+		if err := scanReception(rows, &row); err != nil {
+			return fmt.Errorf("error scanning reception: %w", err)
 		}
 
-		if productID != "" {
-			product := models.Product{
-				ID:          productID,
-				ReceptionID: &receptionID,
-				DateTime:    strfmt.DateTime(productDateTime),
-				Type:        &productType,
-			}
-			receptions[receptionID]["products"] = append(receptions[receptionID]["products"].([]models.Product), product)
+		reception := &pvz.ReceptionWithProducts{
+			Reception: &row,
+			Products:  []*models.Product{},
 		}
+		byReception[row.ID] = reception
+		receptionIDs = append(receptionIDs, row.ID.String())
+		byPVZ[*row.PvzID].Receptions = append(byPVZ[*row.PvzID].Receptions, reception)
 	}
-
 	if err := rows.Err(); err != nil {
-		log.LogHandlerError(logger, fmt.Errorf("row iteration error: %w", err), http.StatusInternalServerError)
-		return nil, fmt.Errorf("row iteration error: %w", err)
+		return fmt.Errorf("reception iteration error: %w", err)
+	}
+	rows.Close()
+
+	if len(receptionIDs) == 0 {
+		return nil
+	}
+
+	rows, err = r.db.Query(ctx, selectProductsByReceptionsQuery, receptionIDs)
+	if err != nil {
+		return fmt.Errorf("error selecting products: %w", err)
 	}
+	defer rows.Close()
 
-	var result []map[string]interface{}
-	for _, pvzData := range pvzsMap {
-		receptionMap := pvzData["receptions"].(map[strfmt.UUID]map[string]interface{})
-		var receptionList []map[string]interface{}
-		for _, v := range receptionMap {
-			receptionList = append(receptionList, v)
+	for rows.Next() {
+		var productID, receptionID strfmt.UUID
+		var productType string
+		var dateTime time.Time
+		var sequence int64
+		var addedBy *strfmt.UUID
+		if err := rows.Scan(&productID, &receptionID, &productType, &dateTime, &sequence, &addedBy); err != nil {
+			return fmt.Errorf("error scanning product: %w", err)
 		}
-		pvzData["receptions"] = receptionList
-		result = append(result, pvzData)
+
+		reception := byReception[receptionID]
+		reception.Products = append(reception.Products, &models.Product{
+			ID:          productID,
+			ReceptionID: &reception.Reception.ID,
+			DateTime:    strfmt.DateTime(dateTime),
+			Type:        &productType,
+			Sequence:    sequence,
+			AddedBy:     addedBy,
+		})
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("product iteration error: %w", err)
 	}
 
-	return result, nil
+	return nil
 }
@@ -5,15 +5,14 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"time"
 
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 	"github.com/google/uuid"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
 	"github.com/totorialman/go-task-avito/internal/pkg/pvz"
 	"github.com/totorialman/go-task-avito/internal/pkg/utils/log"
 	"github.com/totorialman/go-task-avito/models"
-	"github.com/totorialman/go-task-avito/restapi/operations"
 	"log/slog"
 )
 
@@ -25,7 +24,16 @@ func NewPVZUsecase(repo pvz.PVZRepository) *PVZUsecase {
 	return &PVZUsecase{repo: repo}
 }
 
-func (u *PVZUsecase) CreatePVZ(ctx context.Context, city string, id strfmt.UUID, date strfmt.DateTime) (*models.PVZ, error) {
+// userRef превращает ID действующего пользователя в значение для колонок *_by;
+// у тестовых токенов пользователя нет, и колонка остается NULL.
+func userRef(userID strfmt.UUID) *strfmt.UUID {
+	if userID == "" {
+		return nil
+	}
+	return &userID
+}
+
+func (u *PVZUsecase) CreatePVZ(ctx context.Context, city string, id strfmt.UUID, date strfmt.DateTime, createdBy strfmt.UUID) (*models.PVZ, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
 
 	cityPtr := new(string)
@@ -35,6 +43,7 @@ func (u *PVZUsecase) CreatePVZ(ctx context.Context, city string, id strfmt.UUID,
 		ID:               id,
 		City:             cityPtr,
 		RegistrationDate: date,
+		CreatedBy:        userRef(createdBy),
 	}
 
 	if err := u.repo.CreatePVZ(ctx, pvz); err != nil {
@@ -45,23 +54,34 @@ func (u *PVZUsecase) CreatePVZ(ctx context.Context, city string, id strfmt.UUID,
 	return pvz, nil
 }
 
-func (u *PVZUsecase) CreateReception(ctx context.Context, pvzID strfmt.UUID) (*models.Reception, error) {
+func (u *PVZUsecase) CreateReception(ctx context.Context, pvzID, createdBy strfmt.UUID) (*models.Reception, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
 
-	activeReception, _, _ := u.repo.GetActiveReception(ctx, pvzID)
+	activeReception, _, err := u.repo.GetActiveReception(ctx, pvzID)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get active reception: %w", err), http.StatusInternalServerError)
+		return nil, fmt.Errorf("failed to get active reception: %w", err)
+	}
 	if activeReception {
 		log.LogHandlerError(logger, errors.New("previous reception not closed"), http.StatusBadRequest)
-		return nil, errors.New("невозможно создать новую приемку, так как предыдущая не закрыта")
+		return nil, pvz.ErrReceptionNotClosed
 	}
 
 	id := uuid.New()
 	reception := &models.Reception{
 		ID:     strfmt.UUID(id.String()),
-		PvzID:  &pvzID,
-		Status: swag.String("in_progress"),
+		PvzID:     &pvzID,
+		Status:    swag.String("in_progress"),
+		CreatedBy: userRef(createdBy),
 	}
 
-	err := u.repo.CreateReception(ctx, reception)
+	// Проверка выше лишь быстрый путь: гонку между параллельными запросами
+	// разрешает уникальный индекс, и репозиторий возвращает ErrReceptionNotClosed.
+	err = u.repo.CreateReception(ctx, reception)
+	if errors.Is(err, pvz.ErrReceptionNotClosed) {
+		log.LogHandlerError(logger, errors.New("previous reception not closed"), http.StatusBadRequest)
+		return nil, pvz.ErrReceptionNotClosed
+	}
 	if err != nil {
 		log.LogHandlerError(logger, fmt.Errorf("failed to create reception: %w", err), http.StatusInternalServerError)
 		return nil, fmt.Errorf("failed to create reception: %w", err)
@@ -81,127 +101,209 @@ func (u *PVZUsecase) GetActiveReception(ctx context.Context, pvzID strfmt.UUID)
 	return active, reception, nil
 }
 
-func (u *PVZUsecase) AddProductToReception(ctx context.Context, pvzID strfmt.UUID, productType string) (*models.Product, error) {
+func (u *PVZUsecase) AddProductToReception(ctx context.Context, pvzID strfmt.UUID, productType string, addedBy strfmt.UUID) (*models.Product, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
 
-	activeReception, activeReceptiont, err := u.repo.GetActiveReception(ctx, pvzID)
-	if err != nil || !activeReception {
-		log.LogHandlerError(logger, errors.New("no active reception for PVZ"), http.StatusBadRequest)
-		return nil, errors.New("нет активной приемки для данного ПВЗ")
-	}
+	var product *models.Product
+	err := u.repo.WithTx(ctx, func(repo pvz.PVZRepository) error {
+		activeReception, activeReceptiont, err := repo.GetActiveReceptionForUpdate(ctx, pvzID)
+		if err != nil || !activeReception {
+			log.LogHandlerError(logger, errors.New("no active reception for PVZ"), http.StatusBadRequest)
+			return errors.New("нет активной приемки для данного ПВЗ")
+		}
 
-	product := &models.Product{
-		Type:        swag.String(productType),
-		ReceptionID: &activeReceptiont.ID,
-	}
+		product = &models.Product{
+			Type:        swag.String(productType),
+			ReceptionID: &activeReceptiont.ID,
+			AddedBy:     userRef(addedBy),
+		}
 
-	err = u.repo.CreateProduct(ctx, product)
+		if err := repo.CreateProduct(ctx, product); err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to add product to reception: %w", err), http.StatusInternalServerError)
+			return fmt.Errorf("failed to add product to reception: %w", err)
+		}
+		return nil
+	})
 	if err != nil {
-		log.LogHandlerError(logger, fmt.Errorf("failed to add product to reception: %w", err), http.StatusInternalServerError)
-		return nil, fmt.Errorf("failed to add product to reception: %w", err)
+		return nil, err
 	}
 
 	return product, nil
 }
 
-func (u *PVZUsecase) DeleteLastProductFromReception(ctx context.Context, pvzID strfmt.UUID) error {
+func (u *PVZUsecase) GetReception(ctx context.Context, receptionID strfmt.UUID) (*models.Reception, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
 
-	activeReception, reception, err := u.repo.GetActiveReception(ctx, pvzID)
-	if err != nil {
-		log.LogHandlerError(logger, fmt.Errorf("failed to get active reception: %w", err), http.StatusInternalServerError)
-		return fmt.Errorf("failed to get active reception: %w", err)
+	reception, err := u.repo.GetReception(ctx, receptionID)
+	if errors.Is(err, pvz.ErrReceptionNotFound) {
+		log.LogHandlerError(logger, err, http.StatusNotFound)
+		return nil, err
 	}
-	if !activeReception || reception == nil {
-		log.LogHandlerError(logger, errors.New("no active reception found"), http.StatusBadRequest)
-		return errors.New("no active reception found")
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get reception: %w", err), http.StatusInternalServerError)
+		return nil, fmt.Errorf("failed to get reception: %w", err)
 	}
 
-	if reception.Status == nil || *reception.Status != "in_progress" {
-		log.LogHandlerError(logger, errors.New("can't delete products after reception is closed"), http.StatusForbidden)
-		return errors.New("can't delete products after reception is closed")
+	return reception, nil
+}
+
+// GetReceptionProducts возвращает страницу товаров приемки. Приемка ПВЗ, к которому
+// у запроса нет доступа, дает auth.ErrPVZForbidden.
+func (u *PVZUsecase) GetReceptionProducts(ctx context.Context, receptionID strfmt.UUID, productType *string, page, limit int) (*pvz.ProductPage, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	reception, err := u.GetReception(ctx, receptionID)
+	if err != nil {
+		return nil, err
+	}
+	if !auth.CanAccessPVZ(ctx, *reception.PvzID) {
+		log.LogHandlerError(logger, auth.ErrPVZForbidden, http.StatusForbidden)
+		return nil, auth.ErrPVZForbidden
 	}
 
-	err = u.repo.DeleteLastProduct(ctx, reception.ID)
+	products, err := u.repo.GetReceptionProducts(ctx, receptionID, productType, page, limit)
 	if err != nil {
-		log.LogHandlerError(logger, fmt.Errorf("failed to delete last product: %w", err), http.StatusInternalServerError)
-		return fmt.Errorf("failed to delete last product: %w", err)
+		log.LogHandlerError(logger, fmt.Errorf("failed to get reception products: %w", err), http.StatusInternalServerError)
+		return nil, fmt.Errorf("failed to get reception products: %w", err)
 	}
 
-	return nil
+	return products, nil
 }
 
-func (u *PVZUsecase) CloseLastReception(ctx context.Context, pvzID strfmt.UUID) (*models.Reception, error) {
+func (u *PVZUsecase) DeleteLastProductFromReception(ctx context.Context, pvzID strfmt.UUID) error {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
 
-	active, reception, err := u.repo.GetActiveReception(ctx, pvzID)
+	return u.repo.WithTx(ctx, func(repo pvz.PVZRepository) error {
+		activeReception, reception, err := repo.GetActiveReceptionForUpdate(ctx, pvzID)
+		if err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to get active reception: %w", err), http.StatusInternalServerError)
+			return fmt.Errorf("failed to get active reception: %w", err)
+		}
+		if !activeReception || reception == nil {
+			log.LogHandlerError(logger, errors.New("no active reception found"), http.StatusBadRequest)
+			return errors.New("no active reception found")
+		}
+
+		if reception.Status == nil || *reception.Status != "in_progress" {
+			log.LogHandlerError(logger, errors.New("can't delete products after reception is closed"), http.StatusForbidden)
+			return errors.New("can't delete products after reception is closed")
+		}
+
+		err = repo.DeleteLastProduct(ctx, reception.ID)
+		if err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to delete last product: %w", err), http.StatusInternalServerError)
+			return fmt.Errorf("failed to delete last product: %w", err)
+		}
+
+		return nil
+	})
+}
+
+func (u *PVZUsecase) CloseLastReception(ctx context.Context, pvzID, closedBy strfmt.UUID) (*models.Reception, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
+
+	var closed *models.Reception
+	err := u.repo.WithTx(ctx, func(repo pvz.PVZRepository) error {
+		active, reception, err := repo.GetActiveReceptionForUpdate(ctx, pvzID)
+		if err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to get active reception: %w", err), http.StatusInternalServerError)
+			return fmt.Errorf("ошибка при получении активной приемки: %w", err)
+		}
+		if !active || reception == nil || reception.Status == nil || *reception.Status != "in_progress" {
+			log.LogHandlerError(logger, errors.New("reception already closed or missing"), http.StatusBadRequest)
+			return errors.New("приемка уже закрыта или отсутствует")
+		}
+
+		reception.Status = swag.String("closed")
+		reception.ClosedBy = userRef(closedBy)
+
+		closed, err = repo.UpdateReceptionStatus(ctx, *reception)
+		if err != nil {
+			log.LogHandlerError(logger, fmt.Errorf("failed to close reception: %w", err), http.StatusInternalServerError)
+			return fmt.Errorf("ошибка при закрытии приемки: %w", err)
+		}
+
+		return nil
+	})
 	if err != nil {
-		log.LogHandlerError(logger, fmt.Errorf("failed to get active reception: %w", err), http.StatusInternalServerError)
-		return nil, fmt.Errorf("ошибка при получении активной приемки: %w", err)
-	}
-	if !active || reception == nil || reception.Status == nil || *reception.Status != "in_progress" {
-		log.LogHandlerError(logger, errors.New("reception already closed or missing"), http.StatusBadRequest)
-		return nil, errors.New("приемка уже закрыта или отсутствует")
+		return nil, err
 	}
 
-	reception.Status = swag.String("closed")
+	return closed, nil
+}
+
+func (u *PVZUsecase) GetPVZ(ctx context.Context, pvzID strfmt.UUID, page, limit int) (*pvz.PVZDetails, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
 
-	err = u.repo.UpdateReceptionStatus(ctx, *reception)
+	result, err := u.repo.GetPVZ(ctx, pvzID)
 	if err != nil {
-		log.LogHandlerError(logger, fmt.Errorf("failed to close reception: %w", err), http.StatusInternalServerError)
-		return nil, fmt.Errorf("ошибка при закрытии приемки: %w", err)
+		return nil, err
 	}
 
-	_, reception, err = u.repo.GetCloseReception(ctx, pvzID)
+	_, active, err := u.repo.GetActiveReception(ctx, pvzID)
 	if err != nil {
-		log.LogHandlerError(logger, fmt.Errorf("failed to get closed reception: %w", err), http.StatusInternalServerError)
-		return nil, fmt.Errorf("ошибка при получении закрытой приемки: %w", err)
+		log.LogHandlerError(logger, fmt.Errorf("failed to get active reception: %w", err), http.StatusInternalServerError)
+		return nil, fmt.Errorf("failed to get active reception: %w", err)
 	}
 
-	return reception, nil
+	past, err := u.repo.GetPastReceptions(ctx, pvzID, page, limit)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get past receptions: %w", err), http.StatusInternalServerError)
+		return nil, fmt.Errorf("failed to get past receptions: %w", err)
+	}
+
+	return &pvz.PVZDetails{
+		PVZ:             result,
+		ActiveReception: active,
+		Receptions:      past.Items,
+		Total:           past.Total,
+	}, nil
 }
 
-func (u *PVZUsecase) GetPVZs(ctx context.Context, startDate, endDate *time.Time, page, limit int) ([]*operations.GetPvzOKBodyItems0, error) {
+func (u *PVZUsecase) GetPVZs(ctx context.Context, period pvz.ReceptionPeriod, page, limit int) (*pvz.PVZList, error) {
 	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
 
-	if startDate != nil && endDate != nil && startDate.After(*endDate) {
+	if period.Start != nil && period.End != nil && period.Start.After(*period.End) {
 		log.LogHandlerError(logger, errors.New("start date after end date"), http.StatusBadRequest)
 		return nil, fmt.Errorf("start date cannot be after end date")
 	}
 
-	pvzs, err := u.repo.GetPVZsWithReceptions(ctx, startDate, endDate, page, limit)
+	pvzPage, err := u.repo.GetPVZsWithReceptions(ctx, period, page, limit)
 	if err != nil {
 		log.LogHandlerError(logger, fmt.Errorf("failed to get PVZs: %w", err), http.StatusInternalServerError)
 		return nil, fmt.Errorf("error retrieving pvzs: %w", err)
 	}
 
-	var result []*operations.GetPvzOKBodyItems0
-	for _, pvzData := range pvzs {
-		pvz := pvzData["pvz"].(*models.PVZ)
-		receptions := pvzData["receptions"].([]map[string]interface{})
+	return toPVZList(pvzPage), nil
+}
 
-		var receptionItems []*operations.GetPvzOKBodyItems0ReceptionsItems0
-		for _, receptionData := range receptions {
-			reception := receptionData["reception"].(*models.Reception)
-			products := receptionData["products"].([]models.Product)
+func (u *PVZUsecase) GetPVZsByCursor(ctx context.Context, period pvz.ReceptionPeriod, cursor string, limit int) (*pvz.PVZList, error) {
+	logger := log.GetLoggerFromContext(ctx).With(slog.String("func", log.GetFuncName()))
 
-			var productPtrs []*models.Product
-			for i := range products {
-				productPtrs = append(productPtrs, &products[i])
-			}
+	if period.Start != nil && period.End != nil && period.Start.After(*period.End) {
+		log.LogHandlerError(logger, errors.New("start date after end date"), http.StatusBadRequest)
+		return nil, fmt.Errorf("start date cannot be after end date")
+	}
 
-			receptionItem := &operations.GetPvzOKBodyItems0ReceptionsItems0{
-				Products:  productPtrs,
-				Reception: reception,
-			}
-			receptionItems = append(receptionItems, receptionItem)
-		}
+	after, err := pvz.DecodeCursor(cursor)
+	if err != nil {
+		log.LogHandlerError(logger, err, http.StatusBadRequest)
+		return nil, err
+	}
 
-		result = append(result, &operations.GetPvzOKBodyItems0{
-			Pvz:        pvz,
-			Receptions: receptionItems,
-		})
+	pvzPage, err := u.repo.GetPVZsWithReceptionsAfter(ctx, period, after, limit)
+	if err != nil {
+		log.LogHandlerError(logger, fmt.Errorf("failed to get PVZs: %w", err), http.StatusInternalServerError)
+		return nil, fmt.Errorf("error retrieving pvzs: %w", err)
 	}
 
-	return result, nil
-}
\ No newline at end of file
+	return toPVZList(pvzPage), nil
+}
+
+func toPVZList(pvzPage *pvz.PVZPage) *pvz.PVZList {
+	return &pvz.PVZList{
+		Items:      pvzPage.Items,
+		Total:      pvzPage.Total,
+		NextCursor: pvz.EncodeCursor(pvzPage.Next),
+	}
+}
@@ -0,0 +1,582 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/totorialman/go-task-avito/internal/pkg/auth"
+	"github.com/totorialman/go-task-avito/internal/pkg/pvz"
+	"github.com/totorialman/go-task-avito/models"
+)
+
+var errConnection = errors.New("connection refused")
+
+type DummyPVZRepo struct {
+	pvz.PVZRepository
+
+	PVZPage    *pvz.PVZPage
+	PageCalls  int
+	LastPeriod pvz.ReceptionPeriod
+	LastPage   int
+	LastLimit  int
+	LastAfter  *pvz.PVZCursor
+}
+
+func (m *DummyPVZRepo) GetPVZsWithReceptions(ctx context.Context, period pvz.ReceptionPeriod, page, limit int) (*pvz.PVZPage, error) {
+	m.PageCalls++
+	m.LastPeriod = period
+	m.LastPage = page
+	m.LastLimit = limit
+	return m.PVZPage, nil
+}
+
+func (m *DummyPVZRepo) GetPVZsWithReceptionsAfter(ctx context.Context, period pvz.ReceptionPeriod, after *pvz.PVZCursor, limit int) (*pvz.PVZPage, error) {
+	m.PageCalls++
+	m.LastPeriod = period
+	m.LastAfter = after
+	m.LastLimit = limit
+	return m.PVZPage, nil
+}
+
+func TestPVZUsecase_GetPVZs(t *testing.T) {
+	first := strfmt.UUID("11111111-1111-1111-1111-111111111111")
+	second := strfmt.UUID("22222222-2222-2222-2222-222222222222")
+	reception := strfmt.UUID("33333333-3333-3333-3333-333333333333")
+
+	repo := &DummyPVZRepo{PVZPage: &pvz.PVZPage{
+		Total: 7,
+		Items: []*pvz.PVZWithReceptions{
+			{
+				PVZ: &models.PVZ{ID: first},
+				Receptions: []*pvz.ReceptionWithProducts{
+					{Reception: &models.Reception{ID: reception}, Products: []*models.Product{{ReceptionID: &reception}}},
+				},
+			},
+			{PVZ: &models.PVZ{ID: second}, Receptions: []*pvz.ReceptionWithProducts{}},
+		},
+	}}
+	uc := NewPVZUsecase(repo)
+
+	list, err := uc.GetPVZs(context.Background(), pvz.ReceptionPeriod{}, 2, 2)
+	require.NoError(t, err)
+	items := list.Items
+
+	assert.Equal(t, int64(7), list.Total)
+	assert.Empty(t, list.NextCursor)
+	assert.Equal(t, 2, repo.LastPage)
+	assert.Equal(t, 2, repo.LastLimit)
+	require.Len(t, items, 2)
+	assert.Equal(t, first, items[0].PVZ.ID)
+	assert.Equal(t, second, items[1].PVZ.ID)
+	require.Len(t, items[0].Receptions, 1)
+	assert.Len(t, items[0].Receptions[0].Products, 1)
+	assert.NotNil(t, items[1].Receptions)
+}
+
+func TestPVZUsecase_GetPVZsByClosedAt(t *testing.T) {
+	repo := &DummyPVZRepo{PVZPage: &pvz.PVZPage{}}
+	uc := NewPVZUsecase(repo)
+
+	start := time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC)
+	end := start.Add(24 * time.Hour)
+	period := pvz.ReceptionPeriod{Start: &start, End: &end, Field: pvz.ReceptionClosedAt}
+
+	_, err := uc.GetPVZs(context.Background(), period, 1, 10)
+	require.NoError(t, err)
+	assert.Equal(t, period, repo.LastPeriod)
+}
+
+func TestPVZUsecase_GetPVZsInvalidRange(t *testing.T) {
+	repo := &DummyPVZRepo{}
+	uc := NewPVZUsecase(repo)
+
+	start := time.Now()
+	end := start.Add(-time.Hour)
+
+	_, err := uc.GetPVZs(context.Background(), pvz.ReceptionPeriod{Start: &start, End: &end}, 1, 10)
+	assert.Error(t, err)
+	assert.Zero(t, repo.PageCalls)
+}
+
+func TestPVZUsecase_GetPVZsByCursor(t *testing.T) {
+	last := &pvz.PVZCursor{
+		RegistrationDate: time.Date(2025, 4, 1, 10, 0, 0, 123456000, time.UTC),
+		ID:               strfmt.UUID("11111111-1111-1111-1111-111111111111"),
+	}
+	next := &pvz.PVZCursor{
+		RegistrationDate: last.RegistrationDate.Add(time.Second),
+		ID:               strfmt.UUID("22222222-2222-2222-2222-222222222222"),
+	}
+
+	tests := []struct {
+		name        string
+		cursor      string
+		expectedErr error
+	}{
+		{"Valid cursor", pvz.EncodeCursor(last), nil},
+		{"Not base64", "!!!", pvz.ErrInvalidCursor},
+		{"Not a cursor", "e30", pvz.ErrInvalidCursor},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &DummyPVZRepo{PVZPage: &pvz.PVZPage{Total: 3, Next: next}}
+			uc := NewPVZUsecase(repo)
+
+			list, err := uc.GetPVZsByCursor(context.Background(), pvz.ReceptionPeriod{}, tt.cursor, 5)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Zero(t, repo.PageCalls)
+				return
+			}
+			require.NoError(t, err)
+
+			require.NotNil(t, repo.LastAfter)
+			assert.True(t, last.RegistrationDate.Equal(repo.LastAfter.RegistrationDate))
+			assert.Equal(t, last.ID, repo.LastAfter.ID)
+			assert.Equal(t, 5, repo.LastLimit)
+			assert.Equal(t, pvz.EncodeCursor(next), list.NextCursor)
+		})
+	}
+}
+
+// uniqueReceptionRepo ведет себя как таблица receptions с частичным уникальным индексом:
+// проверка активной приемки ничего не видит, пока все горутины ее не прошли,
+// а вставка второй открытой приемки для ПВЗ падает с ErrReceptionNotClosed.
+type uniqueReceptionRepo struct {
+	pvz.PVZRepository
+
+	checked sync.WaitGroup
+	mu      sync.Mutex
+	open    map[strfmt.UUID]strfmt.UUID
+}
+
+func (m *uniqueReceptionRepo) GetActiveReception(ctx context.Context, pvzID strfmt.UUID) (bool, *models.Reception, error) {
+	m.checked.Done()
+	m.checked.Wait()
+	return false, nil, nil
+}
+
+func (m *uniqueReceptionRepo) CreateReception(ctx context.Context, reception *models.Reception) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.open[*reception.PvzID]; ok {
+		return pvz.ErrReceptionNotClosed
+	}
+	m.open[*reception.PvzID] = reception.ID
+	return nil
+}
+
+func TestPVZUsecase_CreateReceptionConcurrent(t *testing.T) {
+	const workers = 20
+	pvzID := strfmt.UUID("11111111-1111-1111-1111-111111111111")
+
+	repo := &uniqueReceptionRepo{open: make(map[strfmt.UUID]strfmt.UUID)}
+	repo.checked.Add(workers)
+	uc := NewPVZUsecase(repo)
+
+	var wg sync.WaitGroup
+	errs := make(chan error, workers)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := uc.CreateReception(context.Background(), pvzID, "")
+			errs <- err
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	created, rejected := 0, 0
+	for err := range errs {
+		switch {
+		case err == nil:
+			created++
+		case assert.ErrorIs(t, err, pvz.ErrReceptionNotClosed):
+			rejected++
+		}
+	}
+
+	assert.Equal(t, 1, created)
+	assert.Equal(t, workers-1, rejected)
+	assert.Len(t, repo.open, 1)
+}
+
+// txPVZRepo запоминает, вызывались ли методы внутри WithTx и чем закончилась транзакция.
+type txPVZRepo struct {
+	pvz.PVZRepository
+
+	inTx       bool
+	outsideTx  []string
+	Active     *models.Reception
+	DeleteErr  error
+	Deleted    []strfmt.UUID
+	Products   []*models.Product
+	Committed  int
+	RolledBack int
+}
+
+func (m *txPVZRepo) WithTx(ctx context.Context, fn func(repo pvz.PVZRepository) error) error {
+	m.inTx = true
+	err := fn(m)
+	m.inTx = false
+	if err != nil {
+		m.RolledBack++
+		return err
+	}
+	m.Committed++
+	return nil
+}
+
+func (m *txPVZRepo) track(method string) {
+	if !m.inTx {
+		m.outsideTx = append(m.outsideTx, method)
+	}
+}
+
+func (m *txPVZRepo) GetActiveReceptionForUpdate(ctx context.Context, pvzID strfmt.UUID) (bool, *models.Reception, error) {
+	m.track("GetActiveReceptionForUpdate")
+	return m.Active != nil, m.Active, nil
+}
+
+func (m *txPVZRepo) CreateProduct(ctx context.Context, product *models.Product) error {
+	m.track("CreateProduct")
+	m.Products = append(m.Products, product)
+	product.Sequence = int64(len(m.Products))
+	return nil
+}
+
+func (m *txPVZRepo) DeleteLastProduct(ctx context.Context, receptionID strfmt.UUID) error {
+	m.track("DeleteLastProduct")
+	if m.DeleteErr != nil {
+		return m.DeleteErr
+	}
+	m.Deleted = append(m.Deleted, receptionID)
+	return nil
+}
+
+func (m *txPVZRepo) UpdateReceptionStatus(ctx context.Context, reception models.Reception) (*models.Reception, error) {
+	m.track("UpdateReceptionStatus")
+	closedAt := strfmt.DateTime(time.Now())
+	updated := *m.Active
+	updated.Status = reception.Status
+	updated.ClosedAt = &closedAt
+	updated.ClosedBy = reception.ClosedBy
+	return &updated, nil
+}
+
+func TestPVZUsecase_DeleteLastProductFromReception(t *testing.T) {
+	pvzID := strfmt.UUID("11111111-1111-1111-1111-111111111111")
+	receptionID := strfmt.UUID("22222222-2222-2222-2222-222222222222")
+
+	tests := []struct {
+		name           string
+		active         *models.Reception
+		deleteErr      error
+		expectErr      bool
+		expectedCommit int
+	}{
+		{
+			name:           "Deletes inside transaction",
+			active:         &models.Reception{ID: receptionID, PvzID: &pvzID, Status: swag.String("in_progress")},
+			expectedCommit: 1,
+		},
+		{
+			name:      "No active reception",
+			expectErr: true,
+		},
+		{
+			name:      "Delete failure rolls back",
+			active:    &models.Reception{ID: receptionID, PvzID: &pvzID, Status: swag.String("in_progress")},
+			deleteErr: errors.New("no rows"),
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &txPVZRepo{Active: tt.active, DeleteErr: tt.deleteErr}
+			uc := NewPVZUsecase(repo)
+
+			err := uc.DeleteLastProductFromReception(context.Background(), pvzID)
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Equal(t, 1, repo.RolledBack)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, []strfmt.UUID{receptionID}, repo.Deleted)
+			}
+			assert.Equal(t, tt.expectedCommit, repo.Committed)
+			assert.Empty(t, repo.outsideTx)
+		})
+	}
+}
+
+func TestPVZUsecase_CloseLastReception(t *testing.T) {
+	pvzID := strfmt.UUID("11111111-1111-1111-1111-111111111111")
+	openedAt := strfmt.DateTime(time.Date(2025, 4, 1, 10, 0, 0, 0, time.UTC))
+	repo := &txPVZRepo{Active: &models.Reception{
+		ID:       strfmt.UUID("22222222-2222-2222-2222-222222222222"),
+		PvzID:    &pvzID,
+		Status:   swag.String("in_progress"),
+		DateTime: &openedAt,
+	}}
+	uc := NewPVZUsecase(repo)
+
+	closedBy := strfmt.UUID("44444444-4444-4444-4444-444444444444")
+	reception, err := uc.CloseLastReception(context.Background(), pvzID, closedBy)
+	require.NoError(t, err)
+	require.NotNil(t, reception.ClosedBy)
+	assert.Equal(t, closedBy, *reception.ClosedBy)
+	assert.Equal(t, repo.Active.ID, reception.ID)
+	assert.Equal(t, "closed", *reception.Status)
+	assert.Equal(t, openedAt, *reception.DateTime)
+	assert.NotNil(t, reception.ClosedAt)
+	assert.Equal(t, 1, repo.Committed)
+	assert.Empty(t, repo.outsideTx)
+}
+
+func TestPVZUsecase_AddProductToReception(t *testing.T) {
+	pvzID := strfmt.UUID("11111111-1111-1111-1111-111111111111")
+	receptionID := strfmt.UUID("22222222-2222-2222-2222-222222222222")
+
+	tests := []struct {
+		name             string
+		active           *models.Reception
+		addedBy          strfmt.UUID
+		expectErr        bool
+		expectedSequence int64
+	}{
+		{
+			name:             "Adds product with next sequence",
+			active:           &models.Reception{ID: receptionID, PvzID: &pvzID, Status: swag.String("in_progress")},
+			addedBy:          strfmt.UUID("44444444-4444-4444-4444-444444444444"),
+			expectedSequence: 1,
+		},
+		{
+			name:             "Dummy token user is not recorded",
+			active:           &models.Reception{ID: receptionID, PvzID: &pvzID, Status: swag.String("in_progress")},
+			expectedSequence: 1,
+		},
+		{
+			name:      "No active reception",
+			expectErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &txPVZRepo{Active: tt.active}
+			uc := NewPVZUsecase(repo)
+
+			product, err := uc.AddProductToReception(context.Background(), pvzID, "обувь", tt.addedBy)
+			if tt.expectErr {
+				assert.Error(t, err)
+				assert.Equal(t, 1, repo.RolledBack)
+				assert.Empty(t, repo.Products)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, receptionID, *product.ReceptionID)
+				assert.Equal(t, tt.expectedSequence, product.Sequence)
+				if tt.addedBy == "" {
+					assert.Nil(t, product.AddedBy)
+				} else {
+					require.NotNil(t, product.AddedBy)
+					assert.Equal(t, tt.addedBy, *product.AddedBy)
+				}
+				assert.Equal(t, 1, repo.Committed)
+			}
+			assert.Empty(t, repo.outsideTx)
+		})
+	}
+}
+
+type detailsPVZRepo struct {
+	pvz.PVZRepository
+
+	PVZ       *models.PVZ
+	Active    *models.Reception
+	ActiveErr error
+	Past      *pvz.ReceptionPage
+	PastCalls int
+}
+
+func (m *detailsPVZRepo) GetPVZ(ctx context.Context, pvzID strfmt.UUID) (*models.PVZ, error) {
+	if m.PVZ == nil {
+		return nil, pvz.ErrPVZNotFound
+	}
+	return m.PVZ, nil
+}
+
+func (m *detailsPVZRepo) GetActiveReception(ctx context.Context, pvzID strfmt.UUID) (bool, *models.Reception, error) {
+	if m.ActiveErr != nil {
+		return false, nil, m.ActiveErr
+	}
+	return m.Active != nil, m.Active, nil
+}
+
+func (m *detailsPVZRepo) GetPastReceptions(ctx context.Context, pvzID strfmt.UUID, page, limit int) (*pvz.ReceptionPage, error) {
+	m.PastCalls++
+	return m.Past, nil
+}
+
+func TestPVZUsecase_GetPVZ(t *testing.T) {
+	pvzID := strfmt.UUID("11111111-1111-1111-1111-111111111111")
+	active := &models.Reception{ID: strfmt.UUID("22222222-2222-2222-2222-222222222222"), Status: swag.String("in_progress")}
+	closed := &models.Reception{ID: strfmt.UUID("33333333-3333-3333-3333-333333333333"), Status: swag.String("closed")}
+
+	tests := []struct {
+		name        string
+		repo        *detailsPVZRepo
+		expectedErr error
+	}{
+		{
+			name: "PVZ with active and past receptions",
+			repo: &detailsPVZRepo{
+				PVZ:    &models.PVZ{ID: pvzID},
+				Active: active,
+				Past: &pvz.ReceptionPage{
+					Items: []*pvz.ReceptionSummary{{Reception: closed, ProductCount: 3}},
+					Total: 11,
+				},
+			},
+		},
+		{
+			name:        "PVZ not found",
+			repo:        &detailsPVZRepo{},
+			expectedErr: pvz.ErrPVZNotFound,
+		},
+		{
+			name:        "Active reception lookup fails",
+			repo:        &detailsPVZRepo{PVZ: &models.PVZ{ID: pvzID}, ActiveErr: errConnection},
+			expectedErr: errConnection,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc := NewPVZUsecase(tt.repo)
+
+			details, err := uc.GetPVZ(context.Background(), pvzID, 1, 10)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Zero(t, tt.repo.PastCalls)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, pvzID, details.PVZ.ID)
+			assert.Equal(t, active, details.ActiveReception)
+			require.Len(t, details.Receptions, 1)
+			assert.Equal(t, closed, details.Receptions[0].Reception)
+			assert.Equal(t, int64(3), details.Receptions[0].ProductCount)
+			assert.Equal(t, int64(11), details.Total)
+		})
+	}
+}
+
+func TestPVZUsecase_CreateReceptionLookupFails(t *testing.T) {
+	uc := NewPVZUsecase(&detailsPVZRepo{ActiveErr: errConnection})
+
+	reception, err := uc.CreateReception(context.Background(), strfmt.UUID("11111111-1111-1111-1111-111111111111"), "")
+
+	assert.ErrorIs(t, err, errConnection)
+	assert.NotErrorIs(t, err, pvz.ErrReceptionNotClosed)
+	assert.Nil(t, reception)
+}
+
+type receptionProductsRepo struct {
+	pvz.PVZRepository
+
+	Reception    *models.Reception
+	Products     *pvz.ProductPage
+	LastType     *string
+	ProductCalls int
+}
+
+func (m *receptionProductsRepo) GetReception(ctx context.Context, receptionID strfmt.UUID) (*models.Reception, error) {
+	if m.Reception == nil {
+		return nil, pvz.ErrReceptionNotFound
+	}
+	return m.Reception, nil
+}
+
+func (m *receptionProductsRepo) GetReceptionProducts(ctx context.Context, receptionID strfmt.UUID, productType *string, page, limit int) (*pvz.ProductPage, error) {
+	m.ProductCalls++
+	m.LastType = productType
+	return m.Products, nil
+}
+
+func TestPVZUsecase_GetReceptionProducts(t *testing.T) {
+	receptionID := strfmt.UUID("22222222-2222-2222-2222-222222222222")
+	pvzID := strfmt.UUID("11111111-1111-1111-1111-111111111111")
+	otherPVZ := strfmt.UUID("33333333-3333-3333-3333-333333333333")
+
+	tests := []struct {
+		name        string
+		reception   *models.Reception
+		principal   *auth.Principal
+		productType *string
+		expectedErr error
+	}{
+		{
+			name:        "Filters by product type",
+			reception:   &models.Reception{ID: receptionID, PvzID: &pvzID},
+			productType: swag.String(models.ProductTypeОбувь),
+		},
+		{
+			name:      "Without filter",
+			reception: &models.Reception{ID: receptionID, PvzID: &pvzID},
+		},
+		{
+			name:      "API key scoped to the PVZ",
+			reception: &models.Reception{ID: receptionID, PvzID: &pvzID},
+			principal: &auth.Principal{APIKeyID: "44444444-4444-4444-4444-444444444444", PVZIDs: []strfmt.UUID{pvzID}},
+		},
+		{
+			name:        "API key scoped to another PVZ",
+			reception:   &models.Reception{ID: receptionID, PvzID: &pvzID},
+			principal:   &auth.Principal{APIKeyID: "44444444-4444-4444-4444-444444444444", PVZIDs: []strfmt.UUID{otherPVZ}},
+			expectedErr: auth.ErrPVZForbidden,
+		},
+		{
+			name:        "Reception not found",
+			expectedErr: pvz.ErrReceptionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &receptionProductsRepo{
+				Reception: tt.reception,
+				Products:  &pvz.ProductPage{Items: []*models.Product{{ReceptionID: &receptionID, Sequence: 1}}, Total: 1},
+			}
+			uc := NewPVZUsecase(repo)
+			ctx := context.Background()
+			if tt.principal != nil {
+				ctx = auth.WithPrincipal(ctx, tt.principal)
+			}
+
+			products, err := uc.GetReceptionProducts(ctx, receptionID, tt.productType, 1, 30)
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+				assert.Zero(t, repo.ProductCalls)
+				return
+			}
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.productType, repo.LastType)
+			assert.Equal(t, int64(1), products.Total)
+			assert.Len(t, products.Items, 1)
+		})
+	}
+}
@@ -25,6 +25,11 @@ type PVZ struct {
 	// Enum: ["Москва","Санкт-Петербург","Казань"]
 	City *string `json:"city"`
 
+	// Пользователь, создавший ПВЗ
+	// Read Only: true
+	// Format: uuid
+	CreatedBy *strfmt.UUID `json:"createdBy,omitempty"`
+
 	// id
 	// Format: uuid
 	ID strfmt.UUID `json:"id,omitempty"`
@@ -42,6 +47,10 @@ func (m *PVZ) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateCreatedBy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -102,6 +111,18 @@ func (m *PVZ) validateCity(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PVZ) validateCreatedBy(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedBy) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("createdBy", "body", "uuid", m.CreatedBy.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *PVZ) validateID(formats strfmt.Registry) error {
 	if swag.IsZero(m.ID) { // not required
 		return nil
@@ -126,8 +147,26 @@ func (m *PVZ) validateRegistrationDate(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this p v z based on context it is used
+// ContextValidate validate this p v z based on the context it is used
 func (m *PVZ) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCreatedBy(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PVZ) contextValidateCreatedBy(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "createdBy", "body", m.CreatedBy); err != nil {
+		return err
+	}
+
 	return nil
 }
 
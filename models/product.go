@@ -20,6 +20,11 @@ import (
 // swagger:model Product
 type Product struct {
 
+	// Пользователь, добавивший товар
+	// Read Only: true
+	// Format: uuid
+	AddedBy *strfmt.UUID `json:"addedBy,omitempty"`
+
 	// date time
 	// Format: date-time
 	DateTime strfmt.DateTime `json:"dateTime,omitempty"`
@@ -33,6 +38,10 @@ type Product struct {
 	// Format: uuid
 	ReceptionID *strfmt.UUID `json:"receptionId"`
 
+	// Порядковый номер товара в приемке, начиная с 1. Номер удаленного товара не выдается повторно
+	// Read Only: true
+	Sequence int64 `json:"sequence,omitempty"`
+
 	// type
 	// Required: true
 	// Enum: ["электроника","одежда","обувь"]
@@ -43,6 +52,10 @@ type Product struct {
 func (m *Product) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAddedBy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDateTime(formats); err != nil {
 		res = append(res, err)
 	}
@@ -65,6 +78,18 @@ func (m *Product) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Product) validateAddedBy(formats strfmt.Registry) error {
+	if swag.IsZero(m.AddedBy) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("addedBy", "body", "uuid", m.AddedBy.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Product) validateDateTime(formats strfmt.Registry) error {
 	if swag.IsZero(m.DateTime) { // not required
 		return nil
@@ -148,8 +173,39 @@ func (m *Product) validateType(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this product based on context it is used
+// ContextValidate validate this product based on the context it is used
 func (m *Product) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateAddedBy(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateSequence(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Product) contextValidateAddedBy(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "addedBy", "body", m.AddedBy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Product) contextValidateSequence(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "sequence", "body", int64(m.Sequence)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -20,6 +20,21 @@ import (
 // swagger:model Reception
 type Reception struct {
 
+	// Время закрытия приемки, отсутствует у открытой приемки
+	// Read Only: true
+	// Format: date-time
+	ClosedAt *strfmt.DateTime `json:"closedAt,omitempty"`
+
+	// Пользователь, закрывший приемку
+	// Read Only: true
+	// Format: uuid
+	ClosedBy *strfmt.UUID `json:"closedBy,omitempty"`
+
+	// Пользователь, открывший приемку
+	// Read Only: true
+	// Format: uuid
+	CreatedBy *strfmt.UUID `json:"createdBy,omitempty"`
+
 	// date time
 	// Required: true
 	// Format: date-time
@@ -29,6 +44,11 @@ type Reception struct {
 	// Format: uuid
 	ID strfmt.UUID `json:"id,omitempty"`
 
+	// Время открытия приемки
+	// Read Only: true
+	// Format: date-time
+	OpenedAt strfmt.DateTime `json:"openedAt,omitempty"`
+
 	// pvz Id
 	// Required: true
 	// Format: uuid
@@ -44,6 +64,18 @@ type Reception struct {
 func (m *Reception) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateClosedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateClosedBy(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreatedBy(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDateTime(formats); err != nil {
 		res = append(res, err)
 	}
@@ -52,6 +84,10 @@ func (m *Reception) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateOpenedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePvzID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -66,6 +102,42 @@ func (m *Reception) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Reception) validateClosedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.ClosedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("closedAt", "body", "date-time", m.ClosedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Reception) validateClosedBy(formats strfmt.Registry) error {
+	if swag.IsZero(m.ClosedBy) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("closedBy", "body", "uuid", m.ClosedBy.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Reception) validateCreatedBy(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedBy) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("createdBy", "body", "uuid", m.CreatedBy.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Reception) validateDateTime(formats strfmt.Registry) error {
 
 	if err := validate.Required("dateTime", "body", m.DateTime); err != nil {
@@ -91,6 +163,18 @@ func (m *Reception) validateID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Reception) validateOpenedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.OpenedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("openedAt", "body", "date-time", m.OpenedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Reception) validatePvzID(formats strfmt.Registry) error {
 
 	if err := validate.Required("pvzId", "body", m.PvzID); err != nil {
@@ -147,8 +231,65 @@ func (m *Reception) validateStatus(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this reception based on context it is used
+// ContextValidate validate this reception based on the context it is used
 func (m *Reception) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateClosedAt(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateClosedBy(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateCreatedBy(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateOpenedAt(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *Reception) contextValidateClosedAt(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "closedAt", "body", m.ClosedAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Reception) contextValidateClosedBy(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "closedBy", "body", m.ClosedBy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Reception) contextValidateCreatedBy(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "createdBy", "body", m.CreatedBy); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Reception) contextValidateOpenedAt(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "openedAt", "body", strfmt.DateTime(m.OpenedAt)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -20,6 +21,15 @@ import (
 // swagger:model User
 type User struct {
 
+	// Время регистрации; пусто у пользователей, созданных до его учета
+	// Read Only: true
+	// Format: date-time
+	CreatedAt *strfmt.DateTime `json:"createdAt,omitempty"`
+
+	// Заблокированный пользователь не может войти, его сессии отозваны
+	// Read Only: true
+	Disabled *bool `json:"disabled,omitempty"`
+
 	// email
 	// Required: true
 	// Format: email
@@ -29,6 +39,20 @@ type User struct {
 	// Format: uuid
 	ID strfmt.UUID `json:"id,omitempty"`
 
+	// Время последнего входа, только в ответе GET /me
+	// Read Only: true
+	// Format: date-time
+	LastLoginAt *strfmt.DateTime `json:"lastLoginAt,omitempty"`
+
+	// Новый email, ожидающий подтверждения, только в ответе GET /me
+	// Read Only: true
+	// Format: email
+	PendingEmail strfmt.Email `json:"pendingEmail,omitempty"`
+
+	// ПВЗ, на которые назначен сотрудник, только в ответе GET /me
+	// Read Only: true
+	PvzAssignments []*PVZAssignment `json:"pvzAssignments,omitempty"`
+
 	// role
 	// Required: true
 	// Enum: ["employee","moderator"]
@@ -39,6 +63,10 @@ type User struct {
 func (m *User) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateCreatedAt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateEmail(formats); err != nil {
 		res = append(res, err)
 	}
@@ -47,6 +75,18 @@ func (m *User) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateLastLoginAt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePendingEmail(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePvzAssignments(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateRole(formats); err != nil {
 		res = append(res, err)
 	}
@@ -57,6 +97,18 @@ func (m *User) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *User) validateCreatedAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.CreatedAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("createdAt", "body", "date-time", m.CreatedAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *User) validateEmail(formats strfmt.Registry) error {
 
 	if err := validate.Required("email", "body", m.Email); err != nil {
@@ -82,6 +134,56 @@ func (m *User) validateID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *User) validateLastLoginAt(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastLoginAt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("lastLoginAt", "body", "date-time", m.LastLoginAt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *User) validatePendingEmail(formats strfmt.Registry) error {
+	if swag.IsZero(m.PendingEmail) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("pendingEmail", "body", "email", m.PendingEmail.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *User) validatePvzAssignments(formats strfmt.Registry) error {
+	if swag.IsZero(m.PvzAssignments) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.PvzAssignments); i++ {
+		if swag.IsZero(m.PvzAssignments[i]) { // not required
+			continue
+		}
+
+		if m.PvzAssignments[i] != nil {
+			if err := m.PvzAssignments[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("pvzAssignments" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("pvzAssignments" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 var userTypeRolePropEnum []interface{}
 
 func init() {
@@ -125,8 +227,98 @@ func (m *User) validateRole(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this user based on context it is used
+// ContextValidate validate this user based on the context it is used
 func (m *User) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateCreatedAt(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateDisabled(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateLastLoginAt(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePendingEmail(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePvzAssignments(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *User) contextValidateCreatedAt(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "createdAt", "body", m.CreatedAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *User) contextValidateDisabled(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "disabled", "body", m.Disabled); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *User) contextValidateLastLoginAt(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "lastLoginAt", "body", m.LastLoginAt); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *User) contextValidatePendingEmail(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "pendingEmail", "body", strfmt.Email(m.PendingEmail)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *User) contextValidatePvzAssignments(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "pvzAssignments", "body", []*PVZAssignment(m.PvzAssignments)); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.PvzAssignments); i++ {
+
+		if m.PvzAssignments[i] != nil {
+
+			if swag.IsZero(m.PvzAssignments[i]) { // not required
+				return nil
+			}
+
+			if err := m.PvzAssignments[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("pvzAssignments" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("pvzAssignments" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
 	return nil
 }
 
@@ -37,6 +37,25 @@ func configureAPI(api *operations.BackendServiceAPI) http.Handler {
 
 	api.JSONProducer = runtime.JSONProducer()
 
+	// Applies when the "X-API-Key" header is set
+	if api.APIKeyAuth == nil {
+		api.APIKeyAuth = func(token string) (interface{}, error) {
+			return nil, errors.NotImplemented("api key auth (APIKey) X-API-Key from header param [X-API-Key] has not yet been implemented")
+		}
+	}
+	// Applies when the "Authorization" header is set
+	if api.BearerAuth == nil {
+		api.BearerAuth = func(token string) (interface{}, error) {
+			return nil, errors.NotImplemented("api key auth (Bearer) Authorization from header param [Authorization] has not yet been implemented")
+		}
+	}
+
+	// Set your custom authorizer if needed. Default one is security.Authorized()
+	// Expected interface runtime.Authorizer
+	//
+	// Example:
+	// api.APIAuthorizer = security.Authorized()
+
 	if api.GetPvzHandler == nil {
 		api.GetPvzHandler = operations.GetPvzHandlerFunc(func(params operations.GetPvzParams) middleware.Responder {
 			return middleware.NotImplemented("operation operations.GetPvz has not yet been implemented")
@@ -53,32 +72,32 @@ func configureAPI(api *operations.BackendServiceAPI) http.Handler {
 		})
 	}
 	if api.PostProductsHandler == nil {
-		api.PostProductsHandler = operations.PostProductsHandlerFunc(func(params operations.PostProductsParams) middleware.Responder {
+		api.PostProductsHandler = operations.PostProductsHandlerFunc(func(params operations.PostProductsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operations.PostProducts has not yet been implemented")
 		})
 	}
 	if api.PostPvzHandler == nil {
-		api.PostPvzHandler = operations.PostPvzHandlerFunc(func(params operations.PostPvzParams) middleware.Responder {
+		api.PostPvzHandler = operations.PostPvzHandlerFunc(func(params operations.PostPvzParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operations.PostPvz has not yet been implemented")
 		})
 	}
 	if api.PostPvzPvzIDCloseLastReceptionHandler == nil {
-		api.PostPvzPvzIDCloseLastReceptionHandler = operations.PostPvzPvzIDCloseLastReceptionHandlerFunc(func(params operations.PostPvzPvzIDCloseLastReceptionParams) middleware.Responder {
+		api.PostPvzPvzIDCloseLastReceptionHandler = operations.PostPvzPvzIDCloseLastReceptionHandlerFunc(func(params operations.PostPvzPvzIDCloseLastReceptionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operations.PostPvzPvzIDCloseLastReception has not yet been implemented")
 		})
 	}
 	if api.PostPvzPvzIDDeleteLastProductHandler == nil {
-		api.PostPvzPvzIDDeleteLastProductHandler = operations.PostPvzPvzIDDeleteLastProductHandlerFunc(func(params operations.PostPvzPvzIDDeleteLastProductParams) middleware.Responder {
+		api.PostPvzPvzIDDeleteLastProductHandler = operations.PostPvzPvzIDDeleteLastProductHandlerFunc(func(params operations.PostPvzPvzIDDeleteLastProductParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operations.PostPvzPvzIDDeleteLastProduct has not yet been implemented")
 		})
 	}
 	if api.PostReceptionsHandler == nil {
-		api.PostReceptionsHandler = operations.PostReceptionsHandlerFunc(func(params operations.PostReceptionsParams) middleware.Responder {
+		api.PostReceptionsHandler = operations.PostReceptionsHandlerFunc(func(params operations.PostReceptionsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operations.PostReceptions has not yet been implemented")
 		})
 	}
 	if api.PostRegisterHandler == nil {
-		api.PostRegisterHandler = operations.PostRegisterHandlerFunc(func(params operations.PostRegisterParams) middleware.Responder {
+		api.PostRegisterHandler = operations.PostRegisterHandlerFunc(func(params operations.PostRegisterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation operations.PostRegister has not yet been implemented")
 		})
 	}
@@ -34,9 +34,3087 @@ func init() {
     "version": "1.0.0"
   },
   "paths": {
+    "/.well-known/jwks.json": {
+      "get": {
+        "security": [],
+        "description": "Содержит все ключи, токены которых еще принимаются, в том числе выведенные из подписи при ротации.",
+        "summary": "Публичные ключи для проверки access-токенов",
+        "responses": {
+          "200": {
+            "description": "Набор ключей",
+            "schema": {
+              "$ref": "#/definitions/JWKS"
+            }
+          }
+        }
+      }
+    },
+    "/acl/policies": {
+      "get": {
+        "summary": "Правила доступа (только для модераторов)",
+        "responses": {
+          "200": {
+            "description": "Все правила",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Policy"
+              }
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Правило начинает действовать на всех экземплярах сервиса без перезапуска.",
+        "summary": "Добавление правила доступа (только для модераторов)",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Policy"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Правило добавлено",
+            "schema": {
+              "$ref": "#/definitions/Policy"
+            }
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Такое правило уже есть",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Правила, дающие модераторам доступ к /acl, удалить нельзя.",
+        "summary": "Удаление правила доступа (только для модераторов)",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "role",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "path",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "GET",
+              "POST",
+              "PUT",
+              "PATCH",
+              "DELETE"
+            ],
+            "type": "string",
+            "name": "method",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Правило удалено"
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Правило не найдено",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Правило защищено от удаления",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/acl/roles": {
+      "get": {
+        "summary": "Наследование ролей (только для модераторов)",
+        "responses": {
+          "200": {
+            "description": "Все связи ролей",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/RoleInheritance"
+              }
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "summary": "Добавление наследования ролей (только для модераторов)",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RoleInheritance"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Связь добавлена",
+            "schema": {
+              "$ref": "#/definitions/RoleInheritance"
+            }
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Такая связь уже есть",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "summary": "Удаление наследования ролей (только для модераторов)",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "role",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "parent",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Связь удалена"
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Связь не найдена",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/api-keys": {
+      "get": {
+        "summary": "Список API-ключей интеграций (только для модераторов)",
+        "responses": {
+          "200": {
+            "description": "Ключи, включая отозванные и истекшие",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/APIKey"
+              }
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Ключ передается в заголовке X-API-Key вместо JWT.",
+        "summary": "Создание API-ключа интеграции (только для модераторов)",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "name",
+                "role"
+              ],
+              "properties": {
+                "name": {
+                  "type": "string",
+                  "maxLength": 100,
+                  "minLength": 1
+                },
+                "pvzIds": {
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "format": "uuid"
+                  }
+                },
+                "role": {
+                  "type": "string",
+                  "enum": [
+                    "employee",
+                    "moderator"
+                  ]
+                },
+                "ttlHours": {
+                  "description": "Без ttlHours ключ бессрочный",
+                  "type": "integer",
+                  "minimum": 1
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Ключ создан",
+            "schema": {
+              "$ref": "#/definitions/APIKey"
+            }
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/api-keys/{keyId}": {
+      "delete": {
+        "summary": "Отзыв API-ключа (только для модераторов)",
+        "responses": {
+          "204": {
+            "description": "Ключ отозван"
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Ключ не найден",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "keyId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/dummyLogin": {
+      "post": {
+        "security": [],
+        "summary": "Получение тестового токена",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "role"
+              ],
+              "properties": {
+                "role": {
+                  "type": "string",
+                  "enum": [
+                    "employee",
+                    "moderator"
+                  ]
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Успешная авторизация",
+            "schema": {
+              "$ref": "#/definitions/Token"
+            }
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/email/confirm": {
+      "post": {
+        "security": [],
+        "description": "Ссылка одноразовая; на прежний адрес уходит уведомление о смене.",
+        "summary": "Подтверждение нового email по токену из письма",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "token"
+              ],
+              "properties": {
+                "token": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Email изменен"
+          },
+          "400": {
+            "description": "Недействительный токен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Email успел занять другой пользователь",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/invites": {
+      "post": {
+        "summary": "Создание приглашения на регистрацию (только для модераторов)",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "role"
+              ],
+              "properties": {
+                "email": {
+                  "type": "string",
+                  "format": "email"
+                },
+                "role": {
+                  "type": "string",
+                  "enum": [
+                    "employee",
+                    "moderator"
+                  ]
+                },
+                "ttlHours": {
+                  "type": "integer",
+                  "default": 72,
+                  "maximum": 720,
+                  "minimum": 1
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Приглашение создано",
+            "schema": {
+              "$ref": "#/definitions/Invite"
+            }
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/login": {
+      "post": {
+        "security": [],
+        "summary": "Авторизация пользователя",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "email",
+                "password"
+              ],
+              "properties": {
+                "deviceLabel": {
+                  "description": "Название устройства, например номер терминала; показывается в списке сессий",
+                  "type": "string",
+                  "maxLength": 100
+                },
+                "email": {
+                  "type": "string",
+                  "format": "email"
+                },
+                "password": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Успешная авторизация",
+            "schema": {
+              "$ref": "#/definitions/Token"
+            }
+          },
+          "401": {
+            "description": "Неверные учетные данные",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "429": {
+            "description": "Слишком много неудачных попыток входа, вход временно заблокирован",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Через сколько секунд можно повторить попытку"
+              }
+            }
+          }
+        }
+      }
+    },
+    "/logout": {
+      "post": {
+        "security": [],
+        "description": "Refresh-токен берется из тела запроса или из куки refresh_token.",
+        "summary": "Выход с отзывом всех токенов сессии",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "refreshToken": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Сессия завершена"
+          }
+        }
+      }
+    },
+    "/me": {
+      "get": {
+        "description": "Вместе с назначениями на ПВЗ, временем регистрации и последнего входа.",
+        "summary": "Профиль текущего пользователя",
+        "responses": {
+          "200": {
+            "description": "Профиль",
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
+          },
+          "403": {
+            "description": "Запрос не от имени пользователя (API-ключ или тестовый токен)",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Любое изменение требует текущий пароль; изменения применяются, только если проходят проверку оба. Смена пароля отзывает остальные сессии. Новый email не применяется сразу: на него уходит письмо со ссылкой, а до подтверждения он возвращается в pendingEmail.\n",
+        "summary": "Изменение своего профиля",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MePatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Профиль после изменений",
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
+          },
+          "400": {
+            "description": "Неверный запрос, новый пароль не подходит или смена email не настроена",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Неверный текущий пароль",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Запрос не от имени пользователя (API-ключ или тестовый токен)",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Email занят другим пользователем",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "429": {
+            "description": "Слишком много запросов на смену email",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Через сколько секунд можно повторить запрос"
+              }
+            }
+          }
+        }
+      }
+    },
+    "/me/password": {
+      "post": {
+        "description": "Остальные сессии пользователя отзываются, текущая остается открытой.",
+        "summary": "Смена пароля текущего пользователя",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "oldPassword",
+                "newPassword"
+              ],
+              "properties": {
+                "newPassword": {
+                  "type": "string"
+                },
+                "oldPassword": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Пароль изменен"
+          },
+          "400": {
+            "description": "Новый пароль не подходит",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Неверный текущий пароль",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/me/sessions": {
+      "get": {
+        "summary": "Активные сессии текущего пользователя",
+        "responses": {
+          "200": {
+            "description": "Сессии, от новых к старым",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Session"
+              }
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/me/sessions/{sessionId}": {
+      "delete": {
+        "description": "Токены сессии перестают действовать сразу. Можно завершить и текущую сессию.",
+        "summary": "Завершение сессии текущего пользователя",
+        "responses": {
+          "204": {
+            "description": "Сессия завершена"
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Сессия не найдена",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/oauth/callback": {
+      "get": {
+        "security": [],
+        "summary": "Возврат от провайдера SSO",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "code",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "state",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "error",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Успешная авторизация",
+            "schema": {
+              "$ref": "#/definitions/Token"
+            }
+          },
+          "400": {
+            "description": "Неверный запрос (state не совпадает или вход не начинался)",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Провайдер не подтвердил пользователя",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Группы пользователя не дают доступа",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "SSO не настроен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/oauth/login": {
+      "get": {
+        "security": [],
+        "description": "Перенаправляет на провайдера. Параметры входа (state, nonce, PKCE verifier) сохраняются в куке oauth_flow.",
+        "summary": "Вход через корпоративный SSO (OIDC)",
+        "responses": {
+          "302": {
+            "description": "Переход на страницу входа провайдера",
+            "headers": {
+              "Location": {
+                "type": "string"
+              }
+            }
+          },
+          "404": {
+            "description": "SSO не настроен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "502": {
+            "description": "Провайдер недоступен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/password/forgot": {
+      "post": {
+        "security": [],
+        "description": "Ответ не зависит от того, есть ли пользователь с таким email.",
+        "summary": "Запрос письма со ссылкой для сброса пароля",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "email"
+              ],
+              "properties": {
+                "email": {
+                  "type": "string",
+                  "format": "email"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "202": {
+            "description": "Если пользователь существует, письмо отправлено"
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Восстановление пароля не настроено",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "429": {
+            "description": "Слишком много запросов для этого email",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Через сколько секунд можно повторить запрос"
+              }
+            }
+          }
+        }
+      }
+    },
+    "/password/reset": {
+      "post": {
+        "security": [],
+        "description": "Токен одноразовый; после сброса все сессии пользователя отзываются.",
+        "summary": "Установка нового пароля по токену из письма",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "token",
+                "newPassword"
+              ],
+              "properties": {
+                "newPassword": {
+                  "type": "string"
+                },
+                "token": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Пароль изменен"
+          },
+          "400": {
+            "description": "Недействительный токен или пароль не подходит",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/products": {
+      "post": {
+        "summary": "Добавление товара в текущую приемку (только для сотрудников ПВЗ)",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "type",
+                "pvzId"
+              ],
+              "properties": {
+                "pvzId": {
+                  "type": "string",
+                  "format": "uuid"
+                },
+                "type": {
+                  "type": "string",
+                  "enum": [
+                    "электроника",
+                    "одежда",
+                    "обувь"
+                  ]
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Товар добавлен",
+            "schema": {
+              "$ref": "#/definitions/Product"
+            }
+          },
+          "400": {
+            "description": "Неверный запрос или нет активной приемки",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/pvz": {
+      "get": {
+        "security": [],
+        "summary": "Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "startDate",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "endDate",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "default": 1,
+            "name": "page",
+            "in": "query"
+          },
+          {
+            "maximum": 30,
+            "minimum": 1,
+            "type": "integer",
+            "default": 10,
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Непрозрачный курсор из X-Next-Cursor; если передан, page игнорируется",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "openedAt",
+              "closedAt"
+            ],
+            "type": "string",
+            "default": "openedAt",
+            "description": "По какому времени приемки применять startDate и endDate",
+            "name": "dateField",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Список ПВЗ",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "object",
+                "properties": {
+                  "pvz": {
+                    "$ref": "#/definitions/PVZ"
+                  },
+                  "receptions": {
+                    "type": "array",
+                    "items": {
+                      "type": "object",
+                      "properties": {
+                        "products": {
+                          "type": "array",
+                          "items": {
+                            "$ref": "#/definitions/Product"
+                          }
+                        },
+                        "reception": {
+                          "$ref": "#/definitions/Reception"
+                        }
+                      }
+                    }
+                  }
+                }
+              }
+            },
+            "headers": {
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "Курсор для запроса следующей страницы, отсутствует на последней странице"
+              },
+              "X-Next-Page": {
+                "type": "string",
+                "description": "Номер следующей страницы, отсутствует на последней странице"
+              },
+              "X-Total-Count": {
+                "type": "integer",
+                "description": "Общее количество ПВЗ, подходящих под фильтр"
+              }
+            }
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "summary": "Создание ПВЗ (только для модераторов)",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/PVZ"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "ПВЗ создан",
+            "schema": {
+              "$ref": "#/definitions/PVZ"
+            }
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/pvz/{pvzId}": {
+      "get": {
+        "summary": "Получение ПВЗ с текущей приемкой и страницей прошлых приемок",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "pvzId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "default": 1,
+            "name": "page",
+            "in": "query"
+          },
+          {
+            "maximum": 30,
+            "minimum": 1,
+            "type": "integer",
+            "default": 10,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ПВЗ",
+            "schema": {
+              "type": "object",
+              "required": [
+                "pvz",
+                "receptions"
+              ],
+              "properties": {
+                "activeReception": {
+                  "$ref": "#/definitions/Reception"
+                },
+                "pvz": {
+                  "$ref": "#/definitions/PVZ"
+                },
+                "receptions": {
+                  "type": "array",
+                  "items": {
+                    "type": "object",
+                    "properties": {
+                      "productCount": {
+                        "type": "integer",
+                        "format": "int64"
+                      },
+                      "reception": {
+                        "$ref": "#/definitions/Reception"
+                      }
+                    }
+                  }
+                }
+              }
+            },
+            "headers": {
+              "X-Next-Page": {
+                "type": "string",
+                "description": "Номер следующей страницы прошлых приемок, отсутствует на последней странице"
+              },
+              "X-Total-Count": {
+                "type": "integer",
+                "description": "Общее количество прошлых приемок ПВЗ"
+              }
+            }
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "ПВЗ не найден",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/pvz/{pvzId}/close_last_reception": {
+      "post": {
+        "summary": "Закрытие последней открытой приемки товаров в рамках ПВЗ",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "pvzId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Приемка закрыта",
+            "schema": {
+              "$ref": "#/definitions/Reception"
+            }
+          },
+          "400": {
+            "description": "Неверный запрос или приемка уже закрыта",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/pvz/{pvzId}/delete_last_product": {
+      "post": {
+        "summary": "Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "pvzId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Товар удален"
+          },
+          "400": {
+            "description": "Неверный запрос, нет активной приемки или нет товаров для удаления",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/receptions": {
+      "post": {
+        "summary": "Создание новой приемки товаров (только для сотрудников ПВЗ)",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "pvzId"
+              ],
+              "properties": {
+                "pvzId": {
+                  "type": "string",
+                  "format": "uuid"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Приемка создана",
+            "schema": {
+              "$ref": "#/definitions/Reception"
+            }
+          },
+          "400": {
+            "description": "Неверный запрос или есть незакрытая приемка",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/receptions/{receptionId}": {
+      "get": {
+        "summary": "Получение приемки",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "receptionId",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Приемка",
+            "schema": {
+              "$ref": "#/definitions/Reception"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Приемка не найдена",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/receptions/{receptionId}/products": {
+      "get": {
+        "summary": "Список товаров приемки в порядке сканирования",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "receptionId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "электроника",
+              "одежда",
+              "обувь"
+            ],
+            "type": "string",
+            "name": "type",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "default": 1,
+            "name": "page",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "default": 30,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Товары приемки",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Product"
+              }
+            },
+            "headers": {
+              "X-Next-Page": {
+                "type": "string",
+                "description": "Номер следующей страницы, отсутствует на последней странице"
+              },
+              "X-Total-Count": {
+                "type": "integer",
+                "description": "Общее количество товаров, подходящих под фильтр"
+              }
+            }
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Приемка не найдена",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/refresh": {
+      "post": {
+        "security": [],
+        "description": "Refresh-токен берется из тела запроса или из куки refresh_token.",
+        "summary": "Обновление access-токена по refresh-токену (ротация refresh-токена)",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "refreshToken": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Новый access-токен, новый refresh-токен выставлен в куку",
+            "schema": {
+              "$ref": "#/definitions/Token"
+            }
+          },
+          "401": {
+            "description": "Refresh-токен недействителен, истек или отозван",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/register": {
+      "post": {
+        "security": [
+          {},
+          {
+            "Bearer": []
+          },
+          {
+            "APIKey": []
+          }
+        ],
+        "summary": "Регистрация пользователя",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "email",
+                "password"
+              ],
+              "properties": {
+                "email": {
+                  "type": "string",
+                  "format": "email"
+                },
+                "inviteCode": {
+                  "description": "Код приглашения из POST /invites",
+                  "type": "string"
+                },
+                "password": {
+                  "type": "string"
+                },
+                "role": {
+                  "description": "Обязательна без inviteCode, с приглашением должна совпадать с его ролью",
+                  "type": "string",
+                  "enum": [
+                    "employee",
+                    "moderator"
+                  ]
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Пользователь создан",
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Регистрация с этой ролью или с этим email не разрешена",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/users": {
+      "get": {
+        "summary": "Список пользователей (только для модераторов)",
+        "parameters": [
+          {
+            "enum": [
+              "employee",
+              "moderator"
+            ],
+            "type": "string",
+            "name": "role",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "name": "disabled",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "default": 1,
+            "name": "page",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "default": 30,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Пользователи в порядке email",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/User"
+              }
+            },
+            "headers": {
+              "X-Next-Page": {
+                "type": "string",
+                "description": "Номер следующей страницы, отсутствует на последней странице"
+              },
+              "X-Total-Count": {
+                "type": "integer",
+                "description": "Общее количество пользователей, подходящих под фильтр"
+              }
+            }
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/users/{userId}": {
+      "get": {
+        "summary": "Пользователь по id (только для модераторов)",
+        "responses": {
+          "200": {
+            "description": "Пользователь",
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Пользователь не найден",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Созданные пользователем ПВЗ, приемки и товары остаются, ссылка на автора обнуляется.",
+        "summary": "Удаление пользователя (только для модераторов)",
+        "responses": {
+          "204": {
+            "description": "Пользователь удален"
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Пользователь не найден",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Модератор не может удалить себя",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Смена роли и блокировка отзывают все сессии пользователя.",
+        "summary": "Смена роли или блокировка пользователя (только для модераторов)",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/UserPatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Пользователь обновлен",
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Пользователь не найден",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Модератор не может заблокировать или понизить себя",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "userId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/users/{userId}/pvz": {
+      "get": {
+        "summary": "ПВЗ, на которые назначен сотрудник (только для модераторов)",
+        "responses": {
+          "200": {
+            "description": "Назначения сотрудника",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/PVZAssignment"
+              }
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Пользователь не найден",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "userId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/users/{userId}/pvz/{pvzId}": {
+      "put": {
+        "description": "Сотрудник может создавать приемки и добавлять товары только в назначенных ПВЗ. Повторное назначение ничего не меняет.",
+        "summary": "Назначение сотрудника на ПВЗ (только для модераторов)",
+        "responses": {
+          "204": {
+            "description": "Сотрудник назначен"
+          },
+          "400": {
+            "description": "Пользователь не сотрудник",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Пользователь или ПВЗ не найден",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "summary": "Снятие сотрудника с ПВЗ (только для модераторов)",
+        "responses": {
+          "204": {
+            "description": "Назначение снято"
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Назначение не найдено",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "userId",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "pvzId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/users/{userId}/sessions": {
+      "delete": {
+        "summary": "Завершение всех сессий пользователя (только для модераторов)",
+        "responses": {
+          "204": {
+            "description": "Сессии завершены"
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Пользователь не найден",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "userId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/users/{userId}/unlock": {
+      "post": {
+        "description": "Сбрасывает счетчик неудачных попыток входа пользователя. Блокировки по IP не снимаются.",
+        "summary": "Снятие блокировки входа после неудачных попыток (только для модераторов)",
+        "responses": {
+          "204": {
+            "description": "Блокировка снята"
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Пользователь не найден",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "userId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    }
+  },
+  "definitions": {
+    "APIKey": {
+      "description": "API-ключ интеграции. Сам ключ (key) возвращается только при создании, в базе хранится его хеш",
+      "type": "object",
+      "required": [
+        "name",
+        "prefix",
+        "role"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "expiresAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "key": {
+          "description": "Полный ключ для заголовка X-API-Key",
+          "type": "string"
+        },
+        "lastUsedAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "name": {
+          "type": "string"
+        },
+        "prefix": {
+          "description": "Открытая часть ключа, по ней ключ можно узнать в списке",
+          "type": "string"
+        },
+        "pvzIds": {
+          "description": "ПВЗ, с которыми можно работать по ключу; пустой список — любые",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "revokedAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "role": {
+          "type": "string",
+          "enum": [
+            "employee",
+            "moderator"
+          ]
+        }
+      }
+    },
+    "Error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "message": {
+          "type": "string"
+        }
+      }
+    },
+    "Invite": {
+      "description": "Одноразовое приглашение на регистрацию, код возвращается только при создании",
+      "type": "object",
+      "required": [
+        "role",
+        "expiresAt"
+      ],
+      "properties": {
+        "code": {
+          "type": "string"
+        },
+        "email": {
+          "description": "Если задан, зарегистрироваться по приглашению можно только с этим email",
+          "type": "string",
+          "format": "email"
+        },
+        "expiresAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "role": {
+          "type": "string",
+          "enum": [
+            "employee",
+            "moderator"
+          ]
+        }
+      }
+    },
+    "JWK": {
+      "description": "Публичный ключ проверки access-токенов (RFC 7517)",
+      "type": "object",
+      "required": [
+        "kty",
+        "kid",
+        "use",
+        "alg"
+      ],
+      "properties": {
+        "alg": {
+          "type": "string",
+          "enum": [
+            "RS256",
+            "EdDSA"
+          ]
+        },
+        "crv": {
+          "description": "Кривая OKP-ключа",
+          "type": "string"
+        },
+        "e": {
+          "description": "Экспонента RSA-ключа, base64url",
+          "type": "string"
+        },
+        "kid": {
+          "type": "string"
+        },
+        "kty": {
+          "type": "string",
+          "enum": [
+            "RSA",
+            "OKP"
+          ]
+        },
+        "n": {
+          "description": "Модуль RSA-ключа, base64url",
+          "type": "string"
+        },
+        "use": {
+          "type": "string"
+        },
+        "x": {
+          "description": "Публичный OKP-ключ, base64url",
+          "type": "string"
+        }
+      }
+    },
+    "JWKS": {
+      "type": "object",
+      "required": [
+        "keys"
+      ],
+      "properties": {
+        "keys": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/JWK"
+          }
+        }
+      }
+    },
+    "MePatch": {
+      "description": "Изменения своего профиля, отсутствующие поля не меняются",
+      "type": "object",
+      "properties": {
+        "email": {
+          "description": "Новый email; меняется только после перехода по ссылке из письма на него",
+          "type": "string",
+          "format": "email",
+          "x-nullable": true
+        },
+        "newPassword": {
+          "type": "string"
+        },
+        "oldPassword": {
+          "description": "Текущий пароль, обязателен для любых изменений",
+          "type": "string"
+        }
+      }
+    },
+    "PVZ": {
+      "type": "object",
+      "required": [
+        "city"
+      ],
+      "properties": {
+        "city": {
+          "type": "string",
+          "enum": [
+            "Москва",
+            "Санкт-Петербург",
+            "Казань"
+          ]
+        },
+        "createdBy": {
+          "description": "Пользователь, создавший ПВЗ",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true,
+          "readOnly": true
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "registrationDate": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "PVZAssignment": {
+      "description": "Назначение сотрудника на ПВЗ",
+      "type": "object",
+      "required": [
+        "pvzId"
+      ],
+      "properties": {
+        "assignedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "assignedBy": {
+          "description": "Модератор, назначивший сотрудника",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "pvzId": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "Policy": {
+      "description": "Правило доступа casbin (p) — роль может вызывать метод по пути",
+      "type": "object",
+      "required": [
+        "role",
+        "path",
+        "method"
+      ],
+      "properties": {
+        "method": {
+          "type": "string",
+          "enum": [
+            "GET",
+            "POST",
+            "PUT",
+            "PATCH",
+            "DELETE"
+          ]
+        },
+        "path": {
+          "description": "Путь в формате keyMatch2, параметры пишутся как :name",
+          "type": "string",
+          "maxLength": 256,
+          "pattern": "^/"
+        },
+        "role": {
+          "type": "string",
+          "maxLength": 100,
+          "minLength": 1
+        }
+      }
+    },
+    "Product": {
+      "type": "object",
+      "required": [
+        "type",
+        "receptionId"
+      ],
+      "properties": {
+        "addedBy": {
+          "description": "Пользователь, добавивший товар",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true,
+          "readOnly": true
+        },
+        "dateTime": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "receptionId": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "sequence": {
+          "description": "Порядковый номер товара в приемке, начиная с 1. Номер удаленного товара не выдается повторно",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "электроника",
+            "одежда",
+            "обувь"
+          ]
+        }
+      }
+    },
+    "Reception": {
+      "type": "object",
+      "required": [
+        "dateTime",
+        "pvzId",
+        "status"
+      ],
+      "properties": {
+        "closedAt": {
+          "description": "Время закрытия приемки, отсутствует у открытой приемки",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
+        "closedBy": {
+          "description": "Пользователь, закрывший приемку",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true,
+          "readOnly": true
+        },
+        "createdBy": {
+          "description": "Пользователь, открывший приемку",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true,
+          "readOnly": true
+        },
+        "dateTime": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "openedAt": {
+          "description": "Время открытия приемки",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "pvzId": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "in_progress",
+            "close"
+          ]
+        }
+      }
+    },
+    "RoleInheritance": {
+      "description": "Наследование ролей casbin (g) — роль получает все права родительской роли",
+      "type": "object",
+      "required": [
+        "role",
+        "parent"
+      ],
+      "properties": {
+        "parent": {
+          "type": "string",
+          "maxLength": 100,
+          "minLength": 1
+        },
+        "role": {
+          "type": "string",
+          "maxLength": 100,
+          "minLength": 1
+        }
+      }
+    },
+    "Session": {
+      "description": "Вход пользователя с одного устройства",
+      "type": "object",
+      "required": [
+        "id",
+        "createdAt",
+        "lastSeenAt",
+        "current"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "current": {
+          "description": "Сессия, от имени которой сделан запрос",
+          "type": "boolean"
+        },
+        "deviceLabel": {
+          "description": "Название устройства, указанное при входе",
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "ip": {
+          "type": "string"
+        },
+        "lastSeenAt": {
+          "description": "Время входа или последнего обновления токенов",
+          "type": "string",
+          "format": "date-time"
+        },
+        "userAgent": {
+          "type": "string"
+        }
+      }
+    },
+    "Token": {
+      "type": "string"
+    },
+    "User": {
+      "type": "object",
+      "required": [
+        "email",
+        "role"
+      ],
+      "properties": {
+        "createdAt": {
+          "description": "Время регистрации; пусто у пользователей, созданных до его учета",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
+        "disabled": {
+          "description": "Заблокированный пользователь не может войти, его сессии отозваны",
+          "type": "boolean",
+          "readOnly": true
+        },
+        "email": {
+          "type": "string",
+          "format": "email"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "lastLoginAt": {
+          "description": "Время последнего входа, только в ответе GET /me",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
+        "pendingEmail": {
+          "description": "Новый email, ожидающий подтверждения, только в ответе GET /me",
+          "type": "string",
+          "format": "email",
+          "readOnly": true
+        },
+        "pvzAssignments": {
+          "description": "ПВЗ, на которые назначен сотрудник, только в ответе GET /me",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/PVZAssignment"
+          },
+          "x-omitempty": true,
+          "readOnly": true
+        },
+        "role": {
+          "type": "string",
+          "enum": [
+            "employee",
+            "moderator"
+          ]
+        }
+      }
+    },
+    "UserPatch": {
+      "description": "Изменяемые модератором поля пользователя, отсутствующие поля не меняются",
+      "type": "object",
+      "properties": {
+        "disabled": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "role": {
+          "type": "string",
+          "enum": [
+            "employee",
+            "moderator"
+          ],
+          "x-nullable": true
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "APIKey": {
+      "type": "apiKey",
+      "name": "X-API-Key",
+      "in": "header"
+    },
+    "Bearer": {
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "Bearer": []
+    },
+    {
+      "APIKey": []
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Сервис для управления ПВЗ и приемкой товаров",
+    "title": "backend service",
+    "version": "1.0.0"
+  },
+  "paths": {
+    "/.well-known/jwks.json": {
+      "get": {
+        "security": [],
+        "description": "Содержит все ключи, токены которых еще принимаются, в том числе выведенные из подписи при ротации.",
+        "summary": "Публичные ключи для проверки access-токенов",
+        "responses": {
+          "200": {
+            "description": "Набор ключей",
+            "schema": {
+              "$ref": "#/definitions/JWKS"
+            }
+          }
+        }
+      }
+    },
+    "/acl/policies": {
+      "get": {
+        "summary": "Правила доступа (только для модераторов)",
+        "responses": {
+          "200": {
+            "description": "Все правила",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Policy"
+              }
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Правило начинает действовать на всех экземплярах сервиса без перезапуска.",
+        "summary": "Добавление правила доступа (только для модераторов)",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/Policy"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Правило добавлено",
+            "schema": {
+              "$ref": "#/definitions/Policy"
+            }
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Такое правило уже есть",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Правила, дающие модераторам доступ к /acl, удалить нельзя.",
+        "summary": "Удаление правила доступа (только для модераторов)",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "role",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "path",
+            "in": "query",
+            "required": true
+          },
+          {
+            "enum": [
+              "GET",
+              "POST",
+              "PUT",
+              "PATCH",
+              "DELETE"
+            ],
+            "type": "string",
+            "name": "method",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Правило удалено"
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Правило не найдено",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Правило защищено от удаления",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/acl/roles": {
+      "get": {
+        "summary": "Наследование ролей (только для модераторов)",
+        "responses": {
+          "200": {
+            "description": "Все связи ролей",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/RoleInheritance"
+              }
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "summary": "Добавление наследования ролей (только для модераторов)",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/RoleInheritance"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Связь добавлена",
+            "schema": {
+              "$ref": "#/definitions/RoleInheritance"
+            }
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Такая связь уже есть",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "summary": "Удаление наследования ролей (только для модераторов)",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "role",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "name": "parent",
+            "in": "query",
+            "required": true
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Связь удалена"
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Связь не найдена",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/api-keys": {
+      "get": {
+        "summary": "Список API-ключей интеграций (только для модераторов)",
+        "responses": {
+          "200": {
+            "description": "Ключи, включая отозванные и истекшие",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/APIKey"
+              }
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Ключ передается в заголовке X-API-Key вместо JWT.",
+        "summary": "Создание API-ключа интеграции (только для модераторов)",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "name",
+                "role"
+              ],
+              "properties": {
+                "name": {
+                  "type": "string",
+                  "maxLength": 100,
+                  "minLength": 1
+                },
+                "pvzIds": {
+                  "type": "array",
+                  "items": {
+                    "type": "string",
+                    "format": "uuid"
+                  }
+                },
+                "role": {
+                  "type": "string",
+                  "enum": [
+                    "employee",
+                    "moderator"
+                  ]
+                },
+                "ttlHours": {
+                  "description": "Без ttlHours ключ бессрочный",
+                  "type": "integer",
+                  "minimum": 1
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Ключ создан",
+            "schema": {
+              "$ref": "#/definitions/APIKey"
+            }
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/api-keys/{keyId}": {
+      "delete": {
+        "summary": "Отзыв API-ключа (только для модераторов)",
+        "responses": {
+          "204": {
+            "description": "Ключ отозван"
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Ключ не найден",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "keyId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
     "/dummyLogin": {
       "post": {
-        "summary": "Получение тестового токена",
+        "security": [],
+        "summary": "Получение тестового токена",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "role"
+              ],
+              "properties": {
+                "role": {
+                  "type": "string",
+                  "enum": [
+                    "employee",
+                    "moderator"
+                  ]
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Успешная авторизация",
+            "schema": {
+              "$ref": "#/definitions/Token"
+            }
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/email/confirm": {
+      "post": {
+        "security": [],
+        "description": "Ссылка одноразовая; на прежний адрес уходит уведомление о смене.",
+        "summary": "Подтверждение нового email по токену из письма",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "token"
+              ],
+              "properties": {
+                "token": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Email изменен"
+          },
+          "400": {
+            "description": "Недействительный токен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Email успел занять другой пользователь",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/invites": {
+      "post": {
+        "summary": "Создание приглашения на регистрацию (только для модераторов)",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "role"
+              ],
+              "properties": {
+                "email": {
+                  "type": "string",
+                  "format": "email"
+                },
+                "role": {
+                  "type": "string",
+                  "enum": [
+                    "employee",
+                    "moderator"
+                  ]
+                },
+                "ttlHours": {
+                  "type": "integer",
+                  "default": 72,
+                  "maximum": 720,
+                  "minimum": 1
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Приглашение создано",
+            "schema": {
+              "$ref": "#/definitions/Invite"
+            }
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/login": {
+      "post": {
+        "security": [],
+        "summary": "Авторизация пользователя",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "email",
+                "password"
+              ],
+              "properties": {
+                "deviceLabel": {
+                  "description": "Название устройства, например номер терминала; показывается в списке сессий",
+                  "type": "string",
+                  "maxLength": 100
+                },
+                "email": {
+                  "type": "string",
+                  "format": "email"
+                },
+                "password": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Успешная авторизация",
+            "schema": {
+              "$ref": "#/definitions/Token"
+            }
+          },
+          "401": {
+            "description": "Неверные учетные данные",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "429": {
+            "description": "Слишком много неудачных попыток входа, вход временно заблокирован",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Через сколько секунд можно повторить попытку"
+              }
+            }
+          }
+        }
+      }
+    },
+    "/logout": {
+      "post": {
+        "security": [],
+        "description": "Refresh-токен берется из тела запроса или из куки refresh_token.",
+        "summary": "Выход с отзывом всех токенов сессии",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "refreshToken": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Сессия завершена"
+          }
+        }
+      }
+    },
+    "/me": {
+      "get": {
+        "description": "Вместе с назначениями на ПВЗ, временем регистрации и последнего входа.",
+        "summary": "Профиль текущего пользователя",
+        "responses": {
+          "200": {
+            "description": "Профиль",
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
+          },
+          "403": {
+            "description": "Запрос не от имени пользователя (API-ключ или тестовый токен)",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "patch": {
+        "description": "Любое изменение требует текущий пароль; изменения применяются, только если проходят проверку оба. Смена пароля отзывает остальные сессии. Новый email не применяется сразу: на него уходит письмо со ссылкой, а до подтверждения он возвращается в pendingEmail.\n",
+        "summary": "Изменение своего профиля",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/MePatch"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "Профиль после изменений",
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
+          },
+          "400": {
+            "description": "Неверный запрос, новый пароль не подходит или смена email не настроена",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Неверный текущий пароль",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Запрос не от имени пользователя (API-ключ или тестовый токен)",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Email занят другим пользователем",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "429": {
+            "description": "Слишком много запросов на смену email",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Через сколько секунд можно повторить запрос"
+              }
+            }
+          }
+        }
+      }
+    },
+    "/me/password": {
+      "post": {
+        "description": "Остальные сессии пользователя отзываются, текущая остается открытой.",
+        "summary": "Смена пароля текущего пользователя",
         "parameters": [
           {
             "name": "body",
@@ -45,20 +3123,120 @@ func init() {
             "schema": {
               "type": "object",
               "required": [
-                "role"
+                "oldPassword",
+                "newPassword"
               ],
               "properties": {
-                "role": {
-                  "type": "string",
-                  "enum": [
-                    "employee",
-                    "moderator"
-                  ]
+                "newPassword": {
+                  "type": "string"
+                },
+                "oldPassword": {
+                  "type": "string"
                 }
               }
             }
           }
         ],
+        "responses": {
+          "204": {
+            "description": "Пароль изменен"
+          },
+          "400": {
+            "description": "Новый пароль не подходит",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Неверный текущий пароль",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/me/sessions": {
+      "get": {
+        "summary": "Активные сессии текущего пользователя",
+        "responses": {
+          "200": {
+            "description": "Сессии, от новых к старым",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Session"
+              }
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/me/sessions/{sessionId}": {
+      "delete": {
+        "description": "Токены сессии перестают действовать сразу. Можно завершить и текущую сессию.",
+        "summary": "Завершение сессии текущего пользователя",
+        "responses": {
+          "204": {
+            "description": "Сессия завершена"
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Сессия не найдена",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "sessionId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/oauth/callback": {
+      "get": {
+        "security": [],
+        "summary": "Возврат от провайдера SSO",
+        "parameters": [
+          {
+            "type": "string",
+            "name": "code",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "state",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "error",
+            "in": "query"
+          }
+        ],
         "responses": {
           "200": {
             "description": "Успешная авторизация",
@@ -67,7 +3245,25 @@ func init() {
             }
           },
           "400": {
-            "description": "Неверный запрос",
+            "description": "Неверный запрос (state не совпадает или вход не начинался)",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "401": {
+            "description": "Провайдер не подтвердил пользователя",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Группы пользователя не дают доступа",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "SSO не настроен",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -75,9 +3271,40 @@ func init() {
         }
       }
     },
-    "/login": {
+    "/oauth/login": {
+      "get": {
+        "security": [],
+        "description": "Перенаправляет на провайдера. Параметры входа (state, nonce, PKCE verifier) сохраняются в куке oauth_flow.",
+        "summary": "Вход через корпоративный SSO (OIDC)",
+        "responses": {
+          "302": {
+            "description": "Переход на страницу входа провайдера",
+            "headers": {
+              "Location": {
+                "type": "string"
+              }
+            }
+          },
+          "404": {
+            "description": "SSO не настроен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "502": {
+            "description": "Провайдер недоступен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/password/forgot": {
       "post": {
-        "summary": "Авторизация пользователя",
+        "security": [],
+        "description": "Ответ не зависит от того, есть ли пользователь с таким email.",
+        "summary": "Запрос письма со ссылкой для сброса пароля",
         "parameters": [
           {
             "name": "body",
@@ -86,30 +3313,81 @@ func init() {
             "schema": {
               "type": "object",
               "required": [
-                "email",
-                "password"
+                "email"
               ],
               "properties": {
                 "email": {
                   "type": "string",
                   "format": "email"
-                },
-                "password": {
-                  "type": "string"
                 }
               }
             }
           }
         ],
         "responses": {
-          "200": {
-            "description": "Успешная авторизация",
+          "202": {
+            "description": "Если пользователь существует, письмо отправлено"
+          },
+          "400": {
+            "description": "Неверный запрос",
             "schema": {
-              "$ref": "#/definitions/Token"
+              "$ref": "#/definitions/Error"
             }
           },
-          "401": {
-            "description": "Неверные учетные данные",
+          "404": {
+            "description": "Восстановление пароля не настроено",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "429": {
+            "description": "Слишком много запросов для этого email",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            },
+            "headers": {
+              "Retry-After": {
+                "type": "integer",
+                "description": "Через сколько секунд можно повторить запрос"
+              }
+            }
+          }
+        }
+      }
+    },
+    "/password/reset": {
+      "post": {
+        "security": [],
+        "description": "Токен одноразовый; после сброса все сессии пользователя отзываются.",
+        "summary": "Установка нового пароля по токену из письма",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "token",
+                "newPassword"
+              ],
+              "properties": {
+                "newPassword": {
+                  "type": "string"
+                },
+                "token": {
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "204": {
+            "description": "Пароль изменен"
+          },
+          "400": {
+            "description": "Недействительный токен или пароль не подходит",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -172,6 +3450,7 @@ func init() {
     },
     "/pvz": {
       "get": {
+        "security": [],
         "summary": "Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией",
         "parameters": [
           {
@@ -200,6 +3479,23 @@ func init() {
             "default": 10,
             "name": "limit",
             "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Непрозрачный курсор из X-Next-Cursor; если передан, page игнорируется",
+            "name": "cursor",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "openedAt",
+              "closedAt"
+            ],
+            "type": "string",
+            "default": "openedAt",
+            "description": "По какому времени приемки применять startDate и endDate",
+            "name": "dateField",
+            "in": "query"
           }
         ],
         "responses": {
@@ -208,31 +3504,29 @@ func init() {
             "schema": {
               "type": "array",
               "items": {
-                "type": "object",
-                "properties": {
-                  "pvz": {
-                    "$ref": "#/definitions/PVZ"
-                  },
-                  "receptions": {
-                    "type": "array",
-                    "items": {
-                      "type": "object",
-                      "properties": {
-                        "products": {
-                          "type": "array",
-                          "items": {
-                            "$ref": "#/definitions/Product"
-                          }
-                        },
-                        "reception": {
-                          "$ref": "#/definitions/Reception"
-                        }
-                      }
-                    }
-                  }
-                }
+                "$ref": "#/definitions/GetPvzOKBodyItems0"
+              }
+            },
+            "headers": {
+              "X-Next-Cursor": {
+                "type": "string",
+                "description": "Курсор для запроса следующей страницы, отсутствует на последней странице"
+              },
+              "X-Next-Page": {
+                "type": "string",
+                "description": "Номер следующей страницы, отсутствует на последней странице"
+              },
+              "X-Total-Count": {
+                "type": "integer",
+                "description": "Общее количество ПВЗ, подходящих под фильтр"
               }
             }
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
       },
@@ -270,6 +3564,89 @@ func init() {
         }
       }
     },
+    "/pvz/{pvzId}": {
+      "get": {
+        "summary": "Получение ПВЗ с текущей приемкой и страницей прошлых приемок",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "uuid",
+            "name": "pvzId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "default": 1,
+            "name": "page",
+            "in": "query"
+          },
+          {
+            "maximum": 30,
+            "minimum": 1,
+            "type": "integer",
+            "default": 10,
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "ПВЗ",
+            "schema": {
+              "type": "object",
+              "required": [
+                "pvz",
+                "receptions"
+              ],
+              "properties": {
+                "activeReception": {
+                  "$ref": "#/definitions/Reception"
+                },
+                "pvz": {
+                  "$ref": "#/definitions/PVZ"
+                },
+                "receptions": {
+                  "type": "array",
+                  "items": {
+                    "$ref": "#/definitions/ReceptionsItems0"
+                  }
+                }
+              }
+            },
+            "headers": {
+              "X-Next-Page": {
+                "type": "string",
+                "description": "Номер следующей страницы прошлых приемок, отсутствует на последней странице"
+              },
+              "X-Total-Count": {
+                "type": "integer",
+                "description": "Общее количество прошлых приемок ПВЗ"
+              }
+            }
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "ПВЗ не найден",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
     "/pvz/{pvzId}/close_last_reception": {
       "post": {
         "summary": "Закрытие последней открытой приемки товаров в рамках ПВЗ",
@@ -379,231 +3756,111 @@ func init() {
         }
       }
     },
-    "/register": {
-      "post": {
-        "summary": "Регистрация пользователя",
+    "/receptions/{receptionId}": {
+      "get": {
+        "summary": "Получение приемки",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "required": [
-                "email",
-                "password",
-                "role"
-              ],
-              "properties": {
-                "email": {
-                  "type": "string",
-                  "format": "email"
-                },
-                "password": {
-                  "type": "string"
-                },
-                "role": {
-                  "type": "string",
-                  "enum": [
-                    "employee",
-                    "moderator"
-                  ]
-                }
-              }
-            }
+            "type": "string",
+            "format": "uuid",
+            "name": "receptionId",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
-          "201": {
-            "description": "Пользователь создан",
+          "200": {
+            "description": "Приемка",
             "schema": {
-              "$ref": "#/definitions/User"
+              "$ref": "#/definitions/Reception"
             }
           },
-          "400": {
-            "description": "Неверный запрос",
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Приемка не найдена",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "Error": {
-      "type": "object",
-      "required": [
-        "message"
-      ],
-      "properties": {
-        "message": {
-          "type": "string"
-        }
-      }
-    },
-    "PVZ": {
-      "type": "object",
-      "required": [
-        "city"
-      ],
-      "properties": {
-        "city": {
-          "type": "string",
-          "enum": [
-            "Москва",
-            "Санкт-Петербург",
-            "Казань"
-          ]
-        },
-        "id": {
-          "type": "string",
-          "format": "uuid"
-        },
-        "registrationDate": {
-          "type": "string",
-          "format": "date-time"
-        }
-      }
-    },
-    "Product": {
-      "type": "object",
-      "required": [
-        "type",
-        "receptionId"
-      ],
-      "properties": {
-        "dateTime": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "id": {
-          "type": "string",
-          "format": "uuid"
-        },
-        "receptionId": {
-          "type": "string",
-          "format": "uuid"
-        },
-        "type": {
-          "type": "string",
-          "enum": [
-            "электроника",
-            "одежда",
-            "обувь"
-          ]
-        }
-      }
-    },
-    "Reception": {
-      "type": "object",
-      "required": [
-        "dateTime",
-        "pvzId",
-        "status"
-      ],
-      "properties": {
-        "dateTime": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "id": {
-          "type": "string",
-          "format": "uuid"
-        },
-        "pvzId": {
-          "type": "string",
-          "format": "uuid"
-        },
-        "status": {
-          "type": "string",
-          "enum": [
-            "in_progress",
-            "close"
-          ]
-        }
-      }
-    },
-    "Token": {
-      "type": "string"
     },
-    "User": {
-      "type": "object",
-      "required": [
-        "email",
-        "role"
-      ],
-      "properties": {
-        "email": {
-          "type": "string",
-          "format": "email"
-        },
-        "id": {
-          "type": "string",
-          "format": "uuid"
-        },
-        "role": {
-          "type": "string",
-          "enum": [
-            "employee",
-            "moderator"
-          ]
-        }
-      }
-    }
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Сервис для управления ПВЗ и приемкой товаров",
-    "title": "backend service",
-    "version": "1.0.0"
-  },
-  "paths": {
-    "/dummyLogin": {
-      "post": {
-        "summary": "Получение тестового токена",
+    "/receptions/{receptionId}/products": {
+      "get": {
+        "summary": "Список товаров приемки в порядке сканирования",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "type": "object",
-              "required": [
-                "role"
-              ],
-              "properties": {
-                "role": {
-                  "type": "string",
-                  "enum": [
-                    "employee",
-                    "moderator"
-                  ]
-                }
-              }
-            }
+            "type": "string",
+            "format": "uuid",
+            "name": "receptionId",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "электроника",
+              "одежда",
+              "обувь"
+            ],
+            "type": "string",
+            "name": "type",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "default": 1,
+            "name": "page",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "default": 30,
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Успешная авторизация",
+            "description": "Товары приемки",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/Product"
+              }
+            },
+            "headers": {
+              "X-Next-Page": {
+                "type": "string",
+                "description": "Номер следующей страницы, отсутствует на последней странице"
+              },
+              "X-Total-Count": {
+                "type": "integer",
+                "description": "Общее количество товаров, подходящих под фильтр"
+              }
+            }
+          },
+          "400": {
+            "description": "Неверный запрос",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "403": {
+            "description": "Доступ запрещен",
             "schema": {
-              "$ref": "#/definitions/Token"
+              "$ref": "#/definitions/Error"
             }
           },
-          "400": {
-            "description": "Неверный запрос",
+          "404": {
+            "description": "Приемка не найдена",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -611,26 +3868,19 @@ func init() {
         }
       }
     },
-    "/login": {
+    "/refresh": {
       "post": {
-        "summary": "Авторизация пользователя",
+        "security": [],
+        "description": "Refresh-токен берется из тела запроса или из куки refresh_token.",
+        "summary": "Обновление access-токена по refresh-токену (ротация refresh-токена)",
         "parameters": [
           {
             "name": "body",
             "in": "body",
-            "required": true,
             "schema": {
               "type": "object",
-              "required": [
-                "email",
-                "password"
-              ],
               "properties": {
-                "email": {
-                  "type": "string",
-                  "format": "email"
-                },
-                "password": {
+                "refreshToken": {
                   "type": "string"
                 }
               }
@@ -639,13 +3889,13 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "Успешная авторизация",
+            "description": "Новый access-токен, новый refresh-токен выставлен в куку",
             "schema": {
               "$ref": "#/definitions/Token"
             }
           },
           "401": {
-            "description": "Неверные учетные данные",
+            "description": "Refresh-токен недействителен, истек или отозван",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -653,9 +3903,18 @@ func init() {
         }
       }
     },
-    "/products": {
+    "/register": {
       "post": {
-        "summary": "Добавление товара в текущую приемку (только для сотрудников ПВЗ)",
+        "security": [
+          {},
+          {
+            "Bearer": []
+          },
+          {
+            "APIKey": []
+          }
+        ],
+        "summary": "Регистрация пользователя",
         "parameters": [
           {
             "name": "body",
@@ -664,20 +3923,27 @@ func init() {
             "schema": {
               "type": "object",
               "required": [
-                "type",
-                "pvzId"
+                "email",
+                "password"
               ],
               "properties": {
-                "pvzId": {
+                "email": {
                   "type": "string",
-                  "format": "uuid"
+                  "format": "email"
                 },
-                "type": {
+                "inviteCode": {
+                  "description": "Код приглашения из POST /invites",
+                  "type": "string"
+                },
+                "password": {
+                  "type": "string"
+                },
+                "role": {
+                  "description": "Обязательна без inviteCode, с приглашением должна совпадать с его ролью",
                   "type": "string",
                   "enum": [
-                    "электроника",
-                    "одежда",
-                    "обувь"
+                    "employee",
+                    "moderator"
                   ]
                 }
               }
@@ -686,19 +3952,19 @@ func init() {
         ],
         "responses": {
           "201": {
-            "description": "Товар добавлен",
+            "description": "Пользователь создан",
             "schema": {
-              "$ref": "#/definitions/Product"
+              "$ref": "#/definitions/User"
             }
           },
           "400": {
-            "description": "Неверный запрос или нет активной приемки",
+            "description": "Неверный запрос",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           },
           "403": {
-            "description": "Доступ запрещен",
+            "description": "Регистрация с этой ролью или с этим email не разрешена",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -706,20 +3972,22 @@ func init() {
         }
       }
     },
-    "/pvz": {
+    "/users": {
       "get": {
-        "summary": "Получение списка ПВЗ с фильтрацией по дате приемки и пагинацией",
+        "summary": "Список пользователей (только для модераторов)",
         "parameters": [
           {
+            "enum": [
+              "employee",
+              "moderator"
+            ],
             "type": "string",
-            "format": "date-time",
-            "name": "startDate",
+            "name": "role",
             "in": "query"
           },
           {
-            "type": "string",
-            "format": "date-time",
-            "name": "endDate",
+            "type": "boolean",
+            "name": "disabled",
             "in": "query"
           },
           {
@@ -730,43 +3998,32 @@ func init() {
             "in": "query"
           },
           {
-            "maximum": 30,
+            "maximum": 100,
             "minimum": 1,
             "type": "integer",
-            "default": 10,
+            "default": 30,
             "name": "limit",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "Список ПВЗ",
+            "description": "Пользователи в порядке email",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/GetPvzOKBodyItems0"
+                "$ref": "#/definitions/User"
+              }
+            },
+            "headers": {
+              "X-Next-Page": {
+                "type": "string",
+                "description": "Номер следующей страницы, отсутствует на последней странице"
+              },
+              "X-Total-Count": {
+                "type": "integer",
+                "description": "Общее количество пользователей, подходящих под фильтр"
               }
-            }
-          }
-        }
-      },
-      "post": {
-        "summary": "Создание ПВЗ (только для модераторов)",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/PVZ"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "ПВЗ создан",
-            "schema": {
-              "$ref": "#/definitions/PVZ"
             }
           },
           "400": {
@@ -784,58 +4041,79 @@ func init() {
         }
       }
     },
-    "/pvz/{pvzId}/close_last_reception": {
-      "post": {
-        "summary": "Закрытие последней открытой приемки товаров в рамках ПВЗ",
-        "parameters": [
-          {
-            "type": "string",
-            "format": "uuid",
-            "name": "pvzId",
-            "in": "path",
-            "required": true
-          }
-        ],
+    "/users/{userId}": {
+      "get": {
+        "summary": "Пользователь по id (только для модераторов)",
         "responses": {
           "200": {
-            "description": "Приемка закрыта",
+            "description": "Пользователь",
             "schema": {
-              "$ref": "#/definitions/Reception"
+              "$ref": "#/definitions/User"
             }
           },
-          "400": {
-            "description": "Неверный запрос или приемка уже закрыта",
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Пользователь не найден",
             "schema": {
               "$ref": "#/definitions/Error"
             }
+          }
+        }
+      },
+      "delete": {
+        "description": "Созданные пользователем ПВЗ, приемки и товары остаются, ссылка на автора обнуляется.",
+        "summary": "Удаление пользователя (только для модераторов)",
+        "responses": {
+          "204": {
+            "description": "Пользователь удален"
           },
           "403": {
             "description": "Доступ запрещен",
             "schema": {
               "$ref": "#/definitions/Error"
             }
+          },
+          "404": {
+            "description": "Пользователь не найден",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Модератор не может удалить себя",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
-      }
-    },
-    "/pvz/{pvzId}/delete_last_product": {
-      "post": {
-        "summary": "Удаление последнего добавленного товара из текущей приемки (LIFO, только для сотрудников ПВЗ)",
+      },
+      "patch": {
+        "description": "Смена роли и блокировка отзывают все сессии пользователя.",
+        "summary": "Смена роли или блокировка пользователя (только для модераторов)",
         "parameters": [
           {
-            "type": "string",
-            "format": "uuid",
-            "name": "pvzId",
-            "in": "path",
-            "required": true
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/UserPatch"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "Товар удален"
+            "description": "Пользователь обновлен",
+            "schema": {
+              "$ref": "#/definitions/User"
+            }
           },
           "400": {
-            "description": "Неверный запрос, нет активной приемки или нет товаров для удаления",
+            "description": "Неверный запрос",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -845,41 +4123,78 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Error"
             }
+          },
+          "404": {
+            "description": "Пользователь не найден",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "409": {
+            "description": "Модератор не может заблокировать или понизить себя",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "userId",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "/receptions": {
-      "post": {
-        "summary": "Создание новой приемки товаров (только для сотрудников ПВЗ)",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+    "/users/{userId}/pvz": {
+      "get": {
+        "summary": "ПВЗ, на которые назначен сотрудник (только для модераторов)",
+        "responses": {
+          "200": {
+            "description": "Назначения сотрудника",
             "schema": {
-              "type": "object",
-              "required": [
-                "pvzId"
-              ],
-              "properties": {
-                "pvzId": {
-                  "type": "string",
-                  "format": "uuid"
-                }
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/PVZAssignment"
               }
             }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Приемка создана",
+          },
+          "403": {
+            "description": "Доступ запрещен",
             "schema": {
-              "$ref": "#/definitions/Reception"
+              "$ref": "#/definitions/Error"
             }
           },
+          "404": {
+            "description": "Пользователь не найден",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "userId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/users/{userId}/pvz/{pvzId}": {
+      "put": {
+        "description": "Сотрудник может создавать приемки и добавлять товары только в назначенных ПВЗ. Повторное назначение ничего не меняет.",
+        "summary": "Назначение сотрудника на ПВЗ (только для модераторов)",
+        "responses": {
+          "204": {
+            "description": "Сотрудник назначен"
+          },
           "400": {
-            "description": "Неверный запрос или есть незакрытая приемка",
+            "description": "Пользователь не сотрудник",
             "schema": {
               "$ref": "#/definitions/Error"
             }
@@ -889,62 +4204,177 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Error"
             }
+          },
+          "404": {
+            "description": "Пользователь или ПВЗ не найден",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
-      }
-    },
-    "/register": {
-      "post": {
-        "summary": "Регистрация пользователя",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "required": true,
+      },
+      "delete": {
+        "summary": "Снятие сотрудника с ПВЗ (только для модераторов)",
+        "responses": {
+          "204": {
+            "description": "Назначение снято"
+          },
+          "403": {
+            "description": "Доступ запрещен",
             "schema": {
-              "type": "object",
-              "required": [
-                "email",
-                "password",
-                "role"
-              ],
-              "properties": {
-                "email": {
-                  "type": "string",
-                  "format": "email"
-                },
-                "password": {
-                  "type": "string"
-                },
-                "role": {
-                  "type": "string",
-                  "enum": [
-                    "employee",
-                    "moderator"
-                  ]
-                }
-              }
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Назначение не найдено",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "userId",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "pvzId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/users/{userId}/sessions": {
+      "delete": {
+        "summary": "Завершение всех сессий пользователя (только для модераторов)",
+        "responses": {
+          "204": {
+            "description": "Сессии завершены"
+          },
+          "403": {
+            "description": "Доступ запрещен",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "Пользователь не найден",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           }
-        ],
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "userId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/users/{userId}/unlock": {
+      "post": {
+        "description": "Сбрасывает счетчик неудачных попыток входа пользователя. Блокировки по IP не снимаются.",
+        "summary": "Снятие блокировки входа после неудачных попыток (только для модераторов)",
         "responses": {
-          "201": {
-            "description": "Пользователь создан",
+          "204": {
+            "description": "Блокировка снята"
+          },
+          "403": {
+            "description": "Доступ запрещен",
             "schema": {
-              "$ref": "#/definitions/User"
+              "$ref": "#/definitions/Error"
             }
           },
-          "400": {
-            "description": "Неверный запрос",
+          "404": {
+            "description": "Пользователь не найден",
             "schema": {
               "$ref": "#/definitions/Error"
             }
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "format": "uuid",
+          "name": "userId",
+          "in": "path",
+          "required": true
+        }
+      ]
     }
   },
   "definitions": {
+    "APIKey": {
+      "description": "API-ключ интеграции. Сам ключ (key) возвращается только при создании, в базе хранится его хеш",
+      "type": "object",
+      "required": [
+        "name",
+        "prefix",
+        "role"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "expiresAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "key": {
+          "description": "Полный ключ для заголовка X-API-Key",
+          "type": "string"
+        },
+        "lastUsedAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "name": {
+          "type": "string"
+        },
+        "prefix": {
+          "description": "Открытая часть ключа, по ней ключ можно узнать в списке",
+          "type": "string"
+        },
+        "pvzIds": {
+          "description": "ПВЗ, с которыми можно работать по ключу; пустой список — любые",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "format": "uuid"
+          }
+        },
+        "revokedAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "role": {
+          "type": "string",
+          "enum": [
+            "employee",
+            "moderator"
+          ]
+        }
+      }
+    },
     "Error": {
       "type": "object",
       "required": [
@@ -984,6 +4414,120 @@ func init() {
         }
       }
     },
+    "Invite": {
+      "description": "Одноразовое приглашение на регистрацию, код возвращается только при создании",
+      "type": "object",
+      "required": [
+        "role",
+        "expiresAt"
+      ],
+      "properties": {
+        "code": {
+          "type": "string"
+        },
+        "email": {
+          "description": "Если задан, зарегистрироваться по приглашению можно только с этим email",
+          "type": "string",
+          "format": "email"
+        },
+        "expiresAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "role": {
+          "type": "string",
+          "enum": [
+            "employee",
+            "moderator"
+          ]
+        }
+      }
+    },
+    "JWK": {
+      "description": "Публичный ключ проверки access-токенов (RFC 7517)",
+      "type": "object",
+      "required": [
+        "kty",
+        "kid",
+        "use",
+        "alg"
+      ],
+      "properties": {
+        "alg": {
+          "type": "string",
+          "enum": [
+            "RS256",
+            "EdDSA"
+          ]
+        },
+        "crv": {
+          "description": "Кривая OKP-ключа",
+          "type": "string"
+        },
+        "e": {
+          "description": "Экспонента RSA-ключа, base64url",
+          "type": "string"
+        },
+        "kid": {
+          "type": "string"
+        },
+        "kty": {
+          "type": "string",
+          "enum": [
+            "RSA",
+            "OKP"
+          ]
+        },
+        "n": {
+          "description": "Модуль RSA-ключа, base64url",
+          "type": "string"
+        },
+        "use": {
+          "type": "string"
+        },
+        "x": {
+          "description": "Публичный OKP-ключ, base64url",
+          "type": "string"
+        }
+      }
+    },
+    "JWKS": {
+      "type": "object",
+      "required": [
+        "keys"
+      ],
+      "properties": {
+        "keys": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/JWK"
+          }
+        }
+      }
+    },
+    "MePatch": {
+      "description": "Изменения своего профиля, отсутствующие поля не меняются",
+      "type": "object",
+      "properties": {
+        "email": {
+          "description": "Новый email; меняется только после перехода по ссылке из письма на него",
+          "type": "string",
+          "format": "email",
+          "x-nullable": true
+        },
+        "newPassword": {
+          "type": "string"
+        },
+        "oldPassword": {
+          "description": "Текущий пароль, обязателен для любых изменений",
+          "type": "string"
+        }
+      }
+    },
     "PVZ": {
       "type": "object",
       "required": [
@@ -998,6 +4542,13 @@ func init() {
             "Казань"
           ]
         },
+        "createdBy": {
+          "description": "Пользователь, создавший ПВЗ",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "id": {
           "type": "string",
           "format": "uuid"
@@ -1008,6 +4559,61 @@ func init() {
         }
       }
     },
+    "PVZAssignment": {
+      "description": "Назначение сотрудника на ПВЗ",
+      "type": "object",
+      "required": [
+        "pvzId"
+      ],
+      "properties": {
+        "assignedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "assignedBy": {
+          "description": "Модератор, назначивший сотрудника",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true
+        },
+        "pvzId": {
+          "type": "string",
+          "format": "uuid"
+        }
+      }
+    },
+    "Policy": {
+      "description": "Правило доступа casbin (p) — роль может вызывать метод по пути",
+      "type": "object",
+      "required": [
+        "role",
+        "path",
+        "method"
+      ],
+      "properties": {
+        "method": {
+          "type": "string",
+          "enum": [
+            "GET",
+            "POST",
+            "PUT",
+            "PATCH",
+            "DELETE"
+          ]
+        },
+        "path": {
+          "description": "Путь в формате keyMatch2, параметры пишутся как :name",
+          "type": "string",
+          "maxLength": 256,
+          "pattern": "^/"
+        },
+        "role": {
+          "type": "string",
+          "maxLength": 100,
+          "minLength": 1
+        }
+      }
+    },
     "Product": {
       "type": "object",
       "required": [
@@ -1015,6 +4621,13 @@ func init() {
         "receptionId"
       ],
       "properties": {
+        "addedBy": {
+          "description": "Пользователь, добавивший товар",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "dateTime": {
           "type": "string",
           "format": "date-time"
@@ -1027,6 +4640,12 @@ func init() {
           "type": "string",
           "format": "uuid"
         },
+        "sequence": {
+          "description": "Порядковый номер товара в приемке, начиная с 1. Номер удаленного товара не выдается повторно",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
         "type": {
           "type": "string",
           "enum": [
@@ -1045,6 +4664,27 @@ func init() {
         "status"
       ],
       "properties": {
+        "closedAt": {
+          "description": "Время закрытия приемки, отсутствует у открытой приемки",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
+        "closedBy": {
+          "description": "Пользователь, закрывший приемку",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true,
+          "readOnly": true
+        },
+        "createdBy": {
+          "description": "Пользователь, открывший приемку",
+          "type": "string",
+          "format": "uuid",
+          "x-nullable": true,
+          "readOnly": true
+        },
         "dateTime": {
           "type": "string",
           "format": "date-time"
@@ -1053,6 +4693,12 @@ func init() {
           "type": "string",
           "format": "uuid"
         },
+        "openedAt": {
+          "description": "Время открытия приемки",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
         "pvzId": {
           "type": "string",
           "format": "uuid"
@@ -1066,6 +4712,77 @@ func init() {
         }
       }
     },
+    "ReceptionsItems0": {
+      "type": "object",
+      "properties": {
+        "productCount": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "reception": {
+          "$ref": "#/definitions/Reception"
+        }
+      }
+    },
+    "RoleInheritance": {
+      "description": "Наследование ролей casbin (g) — роль получает все права родительской роли",
+      "type": "object",
+      "required": [
+        "role",
+        "parent"
+      ],
+      "properties": {
+        "parent": {
+          "type": "string",
+          "maxLength": 100,
+          "minLength": 1
+        },
+        "role": {
+          "type": "string",
+          "maxLength": 100,
+          "minLength": 1
+        }
+      }
+    },
+    "Session": {
+      "description": "Вход пользователя с одного устройства",
+      "type": "object",
+      "required": [
+        "id",
+        "createdAt",
+        "lastSeenAt",
+        "current"
+      ],
+      "properties": {
+        "createdAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "current": {
+          "description": "Сессия, от имени которой сделан запрос",
+          "type": "boolean"
+        },
+        "deviceLabel": {
+          "description": "Название устройства, указанное при входе",
+          "type": "string"
+        },
+        "id": {
+          "type": "string",
+          "format": "uuid"
+        },
+        "ip": {
+          "type": "string"
+        },
+        "lastSeenAt": {
+          "description": "Время входа или последнего обновления токенов",
+          "type": "string",
+          "format": "date-time"
+        },
+        "userAgent": {
+          "type": "string"
+        }
+      }
+    },
     "Token": {
       "type": "string"
     },
@@ -1076,6 +4793,18 @@ func init() {
         "role"
       ],
       "properties": {
+        "createdAt": {
+          "description": "Время регистрации; пусто у пользователей, созданных до его учета",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
+        "disabled": {
+          "description": "Заблокированный пользователь не может войти, его сессии отозваны",
+          "type": "boolean",
+          "readOnly": true
+        },
         "email": {
           "type": "string",
           "format": "email"
@@ -1084,6 +4813,28 @@ func init() {
           "type": "string",
           "format": "uuid"
         },
+        "lastLoginAt": {
+          "description": "Время последнего входа, только в ответе GET /me",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true,
+          "readOnly": true
+        },
+        "pendingEmail": {
+          "description": "Новый email, ожидающий подтверждения, только в ответе GET /me",
+          "type": "string",
+          "format": "email",
+          "readOnly": true
+        },
+        "pvzAssignments": {
+          "description": "ПВЗ, на которые назначен сотрудник, только в ответе GET /me",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/PVZAssignment"
+          },
+          "x-omitempty": true,
+          "readOnly": true
+        },
         "role": {
           "type": "string",
           "enum": [
@@ -1092,7 +4843,45 @@ func init() {
           ]
         }
       }
+    },
+    "UserPatch": {
+      "description": "Изменяемые модератором поля пользователя, отсутствующие поля не меняются",
+      "type": "object",
+      "properties": {
+        "disabled": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "role": {
+          "type": "string",
+          "enum": [
+            "employee",
+            "moderator"
+          ],
+          "x-nullable": true
+        }
+      }
+    }
+  },
+  "securityDefinitions": {
+    "APIKey": {
+      "type": "apiKey",
+      "name": "X-API-Key",
+      "in": "header"
+    },
+    "Bearer": {
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "Bearer": []
+    },
+    {
+      "APIKey": []
     }
-  }
+  ]
 }`))
 }
@@ -42,33 +42,149 @@ func NewBackendServiceAPI(spec *loads.Document) *BackendServiceAPI {
 
 		JSONProducer: runtime.JSONProducer(),
 
+		DeleteACLPoliciesHandler: DeleteACLPoliciesHandlerFunc(func(params DeleteACLPoliciesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteACLPolicies has not yet been implemented")
+		}),
+		DeleteACLRolesHandler: DeleteACLRolesHandlerFunc(func(params DeleteACLRolesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteACLRoles has not yet been implemented")
+		}),
+		DeleteAPIKeysKeyIDHandler: DeleteAPIKeysKeyIDHandlerFunc(func(params DeleteAPIKeysKeyIDParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteAPIKeysKeyID has not yet been implemented")
+		}),
+		DeleteMeSessionsSessionIDHandler: DeleteMeSessionsSessionIDHandlerFunc(func(params DeleteMeSessionsSessionIDParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteMeSessionsSessionID has not yet been implemented")
+		}),
+		DeleteUsersUserIDHandler: DeleteUsersUserIDHandlerFunc(func(params DeleteUsersUserIDParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteUsersUserID has not yet been implemented")
+		}),
+		DeleteUsersUserIDPvzPvzIDHandler: DeleteUsersUserIDPvzPvzIDHandlerFunc(func(params DeleteUsersUserIDPvzPvzIDParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteUsersUserIDPvzPvzID has not yet been implemented")
+		}),
+		DeleteUsersUserIDSessionsHandler: DeleteUsersUserIDSessionsHandlerFunc(func(params DeleteUsersUserIDSessionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteUsersUserIDSessions has not yet been implemented")
+		}),
+		GetACLPoliciesHandler: GetACLPoliciesHandlerFunc(func(params GetACLPoliciesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation GetACLPolicies has not yet been implemented")
+		}),
+		GetACLRolesHandler: GetACLRolesHandlerFunc(func(params GetACLRolesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation GetACLRoles has not yet been implemented")
+		}),
+		GetAPIKeysHandler: GetAPIKeysHandlerFunc(func(params GetAPIKeysParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation GetAPIKeys has not yet been implemented")
+		}),
+		GetMeHandler: GetMeHandlerFunc(func(params GetMeParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation GetMe has not yet been implemented")
+		}),
+		GetMeSessionsHandler: GetMeSessionsHandlerFunc(func(params GetMeSessionsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation GetMeSessions has not yet been implemented")
+		}),
+		GetOauthCallbackHandler: GetOauthCallbackHandlerFunc(func(params GetOauthCallbackParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetOauthCallback has not yet been implemented")
+		}),
+		GetOauthLoginHandler: GetOauthLoginHandlerFunc(func(params GetOauthLoginParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetOauthLogin has not yet been implemented")
+		}),
 		GetPvzHandler: GetPvzHandlerFunc(func(params GetPvzParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetPvz has not yet been implemented")
 		}),
+		GetPvzPvzIDHandler: GetPvzPvzIDHandlerFunc(func(params GetPvzPvzIDParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation GetPvzPvzID has not yet been implemented")
+		}),
+		GetReceptionsReceptionIDHandler: GetReceptionsReceptionIDHandlerFunc(func(params GetReceptionsReceptionIDParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation GetReceptionsReceptionID has not yet been implemented")
+		}),
+		GetReceptionsReceptionIDProductsHandler: GetReceptionsReceptionIDProductsHandlerFunc(func(params GetReceptionsReceptionIDProductsParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation GetReceptionsReceptionIDProducts has not yet been implemented")
+		}),
+		GetUsersHandler: GetUsersHandlerFunc(func(params GetUsersParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation GetUsers has not yet been implemented")
+		}),
+		GetUsersUserIDHandler: GetUsersUserIDHandlerFunc(func(params GetUsersUserIDParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation GetUsersUserID has not yet been implemented")
+		}),
+		GetUsersUserIDPvzHandler: GetUsersUserIDPvzHandlerFunc(func(params GetUsersUserIDPvzParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation GetUsersUserIDPvz has not yet been implemented")
+		}),
+		GetWellKnownJwksJSONHandler: GetWellKnownJwksJSONHandlerFunc(func(params GetWellKnownJwksJSONParams) middleware.Responder {
+			return middleware.NotImplemented("operation GetWellKnownJwksJSON has not yet been implemented")
+		}),
+		PatchMeHandler: PatchMeHandlerFunc(func(params PatchMeParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation PatchMe has not yet been implemented")
+		}),
+		PatchUsersUserIDHandler: PatchUsersUserIDHandlerFunc(func(params PatchUsersUserIDParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation PatchUsersUserID has not yet been implemented")
+		}),
+		PostACLPoliciesHandler: PostACLPoliciesHandlerFunc(func(params PostACLPoliciesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation PostACLPolicies has not yet been implemented")
+		}),
+		PostACLRolesHandler: PostACLRolesHandlerFunc(func(params PostACLRolesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation PostACLRoles has not yet been implemented")
+		}),
+		PostAPIKeysHandler: PostAPIKeysHandlerFunc(func(params PostAPIKeysParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation PostAPIKeys has not yet been implemented")
+		}),
 		PostDummyLoginHandler: PostDummyLoginHandlerFunc(func(params PostDummyLoginParams) middleware.Responder {
 			return middleware.NotImplemented("operation PostDummyLogin has not yet been implemented")
 		}),
+		PostEmailConfirmHandler: PostEmailConfirmHandlerFunc(func(params PostEmailConfirmParams) middleware.Responder {
+			return middleware.NotImplemented("operation PostEmailConfirm has not yet been implemented")
+		}),
+		PostInvitesHandler: PostInvitesHandlerFunc(func(params PostInvitesParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation PostInvites has not yet been implemented")
+		}),
 		PostLoginHandler: PostLoginHandlerFunc(func(params PostLoginParams) middleware.Responder {
 			return middleware.NotImplemented("operation PostLogin has not yet been implemented")
 		}),
-		PostProductsHandler: PostProductsHandlerFunc(func(params PostProductsParams) middleware.Responder {
+		PostLogoutHandler: PostLogoutHandlerFunc(func(params PostLogoutParams) middleware.Responder {
+			return middleware.NotImplemented("operation PostLogout has not yet been implemented")
+		}),
+		PostMePasswordHandler: PostMePasswordHandlerFunc(func(params PostMePasswordParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation PostMePassword has not yet been implemented")
+		}),
+		PostPasswordForgotHandler: PostPasswordForgotHandlerFunc(func(params PostPasswordForgotParams) middleware.Responder {
+			return middleware.NotImplemented("operation PostPasswordForgot has not yet been implemented")
+		}),
+		PostPasswordResetHandler: PostPasswordResetHandlerFunc(func(params PostPasswordResetParams) middleware.Responder {
+			return middleware.NotImplemented("operation PostPasswordReset has not yet been implemented")
+		}),
+		PostProductsHandler: PostProductsHandlerFunc(func(params PostProductsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation PostProducts has not yet been implemented")
 		}),
-		PostPvzHandler: PostPvzHandlerFunc(func(params PostPvzParams) middleware.Responder {
+		PostPvzHandler: PostPvzHandlerFunc(func(params PostPvzParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation PostPvz has not yet been implemented")
 		}),
-		PostPvzPvzIDCloseLastReceptionHandler: PostPvzPvzIDCloseLastReceptionHandlerFunc(func(params PostPvzPvzIDCloseLastReceptionParams) middleware.Responder {
+		PostPvzPvzIDCloseLastReceptionHandler: PostPvzPvzIDCloseLastReceptionHandlerFunc(func(params PostPvzPvzIDCloseLastReceptionParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation PostPvzPvzIDCloseLastReception has not yet been implemented")
 		}),
-		PostPvzPvzIDDeleteLastProductHandler: PostPvzPvzIDDeleteLastProductHandlerFunc(func(params PostPvzPvzIDDeleteLastProductParams) middleware.Responder {
+		PostPvzPvzIDDeleteLastProductHandler: PostPvzPvzIDDeleteLastProductHandlerFunc(func(params PostPvzPvzIDDeleteLastProductParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation PostPvzPvzIDDeleteLastProduct has not yet been implemented")
 		}),
-		PostReceptionsHandler: PostReceptionsHandlerFunc(func(params PostReceptionsParams) middleware.Responder {
+		PostReceptionsHandler: PostReceptionsHandlerFunc(func(params PostReceptionsParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation PostReceptions has not yet been implemented")
 		}),
-		PostRegisterHandler: PostRegisterHandlerFunc(func(params PostRegisterParams) middleware.Responder {
+		PostRefreshHandler: PostRefreshHandlerFunc(func(params PostRefreshParams) middleware.Responder {
+			return middleware.NotImplemented("operation PostRefresh has not yet been implemented")
+		}),
+		PostRegisterHandler: PostRegisterHandlerFunc(func(params PostRegisterParams, principal interface{}) middleware.Responder {
 			return middleware.NotImplemented("operation PostRegister has not yet been implemented")
 		}),
+		PostUsersUserIDUnlockHandler: PostUsersUserIDUnlockHandlerFunc(func(params PostUsersUserIDUnlockParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation PostUsersUserIDUnlock has not yet been implemented")
+		}),
+		PutUsersUserIDPvzPvzIDHandler: PutUsersUserIDPvzPvzIDHandlerFunc(func(params PutUsersUserIDPvzPvzIDParams, principal interface{}) middleware.Responder {
+			return middleware.NotImplemented("operation PutUsersUserIDPvzPvzID has not yet been implemented")
+		}),
+
+		// Applies when the "X-API-Key" header is set
+		APIKeyAuth: func(token string) (interface{}, error) {
+			return nil, errors.NotImplemented("api key auth (APIKey) X-API-Key from header param [X-API-Key] has not yet been implemented")
+		},
+		// Applies when the "Authorization" header is set
+		BearerAuth: func(token string) (interface{}, error) {
+			return nil, errors.NotImplemented("api key auth (Bearer) Authorization from header param [Authorization] has not yet been implemented")
+		},
+		// default authorizer is authorized meaning no requests are blocked
+		APIAuthorizer: security.Authorized(),
 	}
 }
 
@@ -105,12 +221,87 @@ type BackendServiceAPI struct {
 	//   - application/json
 	JSONProducer runtime.Producer
 
+	// APIKeyAuth registers a function that takes a token and returns a principal
+	// it performs authentication based on an api key X-API-Key provided in the header
+	APIKeyAuth func(string) (interface{}, error)
+
+	// BearerAuth registers a function that takes a token and returns a principal
+	// it performs authentication based on an api key Authorization provided in the header
+	BearerAuth func(string) (interface{}, error)
+
+	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
+	APIAuthorizer runtime.Authorizer
+
+	// DeleteACLPoliciesHandler sets the operation handler for the delete ACL policies operation
+	DeleteACLPoliciesHandler DeleteACLPoliciesHandler
+	// DeleteACLRolesHandler sets the operation handler for the delete ACL roles operation
+	DeleteACLRolesHandler DeleteACLRolesHandler
+	// DeleteAPIKeysKeyIDHandler sets the operation handler for the delete API keys key ID operation
+	DeleteAPIKeysKeyIDHandler DeleteAPIKeysKeyIDHandler
+	// DeleteMeSessionsSessionIDHandler sets the operation handler for the delete me sessions session ID operation
+	DeleteMeSessionsSessionIDHandler DeleteMeSessionsSessionIDHandler
+	// DeleteUsersUserIDHandler sets the operation handler for the delete users user ID operation
+	DeleteUsersUserIDHandler DeleteUsersUserIDHandler
+	// DeleteUsersUserIDPvzPvzIDHandler sets the operation handler for the delete users user ID pvz pvz ID operation
+	DeleteUsersUserIDPvzPvzIDHandler DeleteUsersUserIDPvzPvzIDHandler
+	// DeleteUsersUserIDSessionsHandler sets the operation handler for the delete users user ID sessions operation
+	DeleteUsersUserIDSessionsHandler DeleteUsersUserIDSessionsHandler
+	// GetACLPoliciesHandler sets the operation handler for the get ACL policies operation
+	GetACLPoliciesHandler GetACLPoliciesHandler
+	// GetACLRolesHandler sets the operation handler for the get ACL roles operation
+	GetACLRolesHandler GetACLRolesHandler
+	// GetAPIKeysHandler sets the operation handler for the get API keys operation
+	GetAPIKeysHandler GetAPIKeysHandler
+	// GetMeHandler sets the operation handler for the get me operation
+	GetMeHandler GetMeHandler
+	// GetMeSessionsHandler sets the operation handler for the get me sessions operation
+	GetMeSessionsHandler GetMeSessionsHandler
+	// GetOauthCallbackHandler sets the operation handler for the get oauth callback operation
+	GetOauthCallbackHandler GetOauthCallbackHandler
+	// GetOauthLoginHandler sets the operation handler for the get oauth login operation
+	GetOauthLoginHandler GetOauthLoginHandler
 	// GetPvzHandler sets the operation handler for the get pvz operation
 	GetPvzHandler GetPvzHandler
+	// GetPvzPvzIDHandler sets the operation handler for the get pvz pvz ID operation
+	GetPvzPvzIDHandler GetPvzPvzIDHandler
+	// GetReceptionsReceptionIDHandler sets the operation handler for the get receptions reception ID operation
+	GetReceptionsReceptionIDHandler GetReceptionsReceptionIDHandler
+	// GetReceptionsReceptionIDProductsHandler sets the operation handler for the get receptions reception ID products operation
+	GetReceptionsReceptionIDProductsHandler GetReceptionsReceptionIDProductsHandler
+	// GetUsersHandler sets the operation handler for the get users operation
+	GetUsersHandler GetUsersHandler
+	// GetUsersUserIDHandler sets the operation handler for the get users user ID operation
+	GetUsersUserIDHandler GetUsersUserIDHandler
+	// GetUsersUserIDPvzHandler sets the operation handler for the get users user ID pvz operation
+	GetUsersUserIDPvzHandler GetUsersUserIDPvzHandler
+	// GetWellKnownJwksJSONHandler sets the operation handler for the get well known jwks JSON operation
+	GetWellKnownJwksJSONHandler GetWellKnownJwksJSONHandler
+	// PatchMeHandler sets the operation handler for the patch me operation
+	PatchMeHandler PatchMeHandler
+	// PatchUsersUserIDHandler sets the operation handler for the patch users user ID operation
+	PatchUsersUserIDHandler PatchUsersUserIDHandler
+	// PostACLPoliciesHandler sets the operation handler for the post ACL policies operation
+	PostACLPoliciesHandler PostACLPoliciesHandler
+	// PostACLRolesHandler sets the operation handler for the post ACL roles operation
+	PostACLRolesHandler PostACLRolesHandler
+	// PostAPIKeysHandler sets the operation handler for the post API keys operation
+	PostAPIKeysHandler PostAPIKeysHandler
 	// PostDummyLoginHandler sets the operation handler for the post dummy login operation
 	PostDummyLoginHandler PostDummyLoginHandler
+	// PostEmailConfirmHandler sets the operation handler for the post email confirm operation
+	PostEmailConfirmHandler PostEmailConfirmHandler
+	// PostInvitesHandler sets the operation handler for the post invites operation
+	PostInvitesHandler PostInvitesHandler
 	// PostLoginHandler sets the operation handler for the post login operation
 	PostLoginHandler PostLoginHandler
+	// PostLogoutHandler sets the operation handler for the post logout operation
+	PostLogoutHandler PostLogoutHandler
+	// PostMePasswordHandler sets the operation handler for the post me password operation
+	PostMePasswordHandler PostMePasswordHandler
+	// PostPasswordForgotHandler sets the operation handler for the post password forgot operation
+	PostPasswordForgotHandler PostPasswordForgotHandler
+	// PostPasswordResetHandler sets the operation handler for the post password reset operation
+	PostPasswordResetHandler PostPasswordResetHandler
 	// PostProductsHandler sets the operation handler for the post products operation
 	PostProductsHandler PostProductsHandler
 	// PostPvzHandler sets the operation handler for the post pvz operation
@@ -121,8 +312,14 @@ type BackendServiceAPI struct {
 	PostPvzPvzIDDeleteLastProductHandler PostPvzPvzIDDeleteLastProductHandler
 	// PostReceptionsHandler sets the operation handler for the post receptions operation
 	PostReceptionsHandler PostReceptionsHandler
+	// PostRefreshHandler sets the operation handler for the post refresh operation
+	PostRefreshHandler PostRefreshHandler
 	// PostRegisterHandler sets the operation handler for the post register operation
 	PostRegisterHandler PostRegisterHandler
+	// PostUsersUserIDUnlockHandler sets the operation handler for the post users user ID unlock operation
+	PostUsersUserIDUnlockHandler PostUsersUserIDUnlockHandler
+	// PutUsersUserIDPvzPvzIDHandler sets the operation handler for the put users user ID pvz pvz ID operation
+	PutUsersUserIDPvzPvzIDHandler PutUsersUserIDPvzPvzIDHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -200,15 +397,118 @@ func (o *BackendServiceAPI) Validate() error {
 		unregistered = append(unregistered, "JSONProducer")
 	}
 
+	if o.APIKeyAuth == nil {
+		unregistered = append(unregistered, "XAPIKeyAuth")
+	}
+	if o.BearerAuth == nil {
+		unregistered = append(unregistered, "AuthorizationAuth")
+	}
+
+	if o.DeleteACLPoliciesHandler == nil {
+		unregistered = append(unregistered, "DeleteACLPoliciesHandler")
+	}
+	if o.DeleteACLRolesHandler == nil {
+		unregistered = append(unregistered, "DeleteACLRolesHandler")
+	}
+	if o.DeleteAPIKeysKeyIDHandler == nil {
+		unregistered = append(unregistered, "DeleteAPIKeysKeyIDHandler")
+	}
+	if o.DeleteMeSessionsSessionIDHandler == nil {
+		unregistered = append(unregistered, "DeleteMeSessionsSessionIDHandler")
+	}
+	if o.DeleteUsersUserIDHandler == nil {
+		unregistered = append(unregistered, "DeleteUsersUserIDHandler")
+	}
+	if o.DeleteUsersUserIDPvzPvzIDHandler == nil {
+		unregistered = append(unregistered, "DeleteUsersUserIDPvzPvzIDHandler")
+	}
+	if o.DeleteUsersUserIDSessionsHandler == nil {
+		unregistered = append(unregistered, "DeleteUsersUserIDSessionsHandler")
+	}
+	if o.GetACLPoliciesHandler == nil {
+		unregistered = append(unregistered, "GetACLPoliciesHandler")
+	}
+	if o.GetACLRolesHandler == nil {
+		unregistered = append(unregistered, "GetACLRolesHandler")
+	}
+	if o.GetAPIKeysHandler == nil {
+		unregistered = append(unregistered, "GetAPIKeysHandler")
+	}
+	if o.GetMeHandler == nil {
+		unregistered = append(unregistered, "GetMeHandler")
+	}
+	if o.GetMeSessionsHandler == nil {
+		unregistered = append(unregistered, "GetMeSessionsHandler")
+	}
+	if o.GetOauthCallbackHandler == nil {
+		unregistered = append(unregistered, "GetOauthCallbackHandler")
+	}
+	if o.GetOauthLoginHandler == nil {
+		unregistered = append(unregistered, "GetOauthLoginHandler")
+	}
 	if o.GetPvzHandler == nil {
 		unregistered = append(unregistered, "GetPvzHandler")
 	}
+	if o.GetPvzPvzIDHandler == nil {
+		unregistered = append(unregistered, "GetPvzPvzIDHandler")
+	}
+	if o.GetReceptionsReceptionIDHandler == nil {
+		unregistered = append(unregistered, "GetReceptionsReceptionIDHandler")
+	}
+	if o.GetReceptionsReceptionIDProductsHandler == nil {
+		unregistered = append(unregistered, "GetReceptionsReceptionIDProductsHandler")
+	}
+	if o.GetUsersHandler == nil {
+		unregistered = append(unregistered, "GetUsersHandler")
+	}
+	if o.GetUsersUserIDHandler == nil {
+		unregistered = append(unregistered, "GetUsersUserIDHandler")
+	}
+	if o.GetUsersUserIDPvzHandler == nil {
+		unregistered = append(unregistered, "GetUsersUserIDPvzHandler")
+	}
+	if o.GetWellKnownJwksJSONHandler == nil {
+		unregistered = append(unregistered, "GetWellKnownJwksJSONHandler")
+	}
+	if o.PatchMeHandler == nil {
+		unregistered = append(unregistered, "PatchMeHandler")
+	}
+	if o.PatchUsersUserIDHandler == nil {
+		unregistered = append(unregistered, "PatchUsersUserIDHandler")
+	}
+	if o.PostACLPoliciesHandler == nil {
+		unregistered = append(unregistered, "PostACLPoliciesHandler")
+	}
+	if o.PostACLRolesHandler == nil {
+		unregistered = append(unregistered, "PostACLRolesHandler")
+	}
+	if o.PostAPIKeysHandler == nil {
+		unregistered = append(unregistered, "PostAPIKeysHandler")
+	}
 	if o.PostDummyLoginHandler == nil {
 		unregistered = append(unregistered, "PostDummyLoginHandler")
 	}
+	if o.PostEmailConfirmHandler == nil {
+		unregistered = append(unregistered, "PostEmailConfirmHandler")
+	}
+	if o.PostInvitesHandler == nil {
+		unregistered = append(unregistered, "PostInvitesHandler")
+	}
 	if o.PostLoginHandler == nil {
 		unregistered = append(unregistered, "PostLoginHandler")
 	}
+	if o.PostLogoutHandler == nil {
+		unregistered = append(unregistered, "PostLogoutHandler")
+	}
+	if o.PostMePasswordHandler == nil {
+		unregistered = append(unregistered, "PostMePasswordHandler")
+	}
+	if o.PostPasswordForgotHandler == nil {
+		unregistered = append(unregistered, "PostPasswordForgotHandler")
+	}
+	if o.PostPasswordResetHandler == nil {
+		unregistered = append(unregistered, "PostPasswordResetHandler")
+	}
 	if o.PostProductsHandler == nil {
 		unregistered = append(unregistered, "PostProductsHandler")
 	}
@@ -224,9 +524,18 @@ func (o *BackendServiceAPI) Validate() error {
 	if o.PostReceptionsHandler == nil {
 		unregistered = append(unregistered, "PostReceptionsHandler")
 	}
+	if o.PostRefreshHandler == nil {
+		unregistered = append(unregistered, "PostRefreshHandler")
+	}
 	if o.PostRegisterHandler == nil {
 		unregistered = append(unregistered, "PostRegisterHandler")
 	}
+	if o.PostUsersUserIDUnlockHandler == nil {
+		unregistered = append(unregistered, "PostUsersUserIDUnlockHandler")
+	}
+	if o.PutUsersUserIDPvzPvzIDHandler == nil {
+		unregistered = append(unregistered, "PutUsersUserIDPvzPvzIDHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -242,12 +551,25 @@ func (o *BackendServiceAPI) ServeErrorFor(operationID string) func(http.Response
 
 // AuthenticatorsFor gets the authenticators for the specified security schemes
 func (o *BackendServiceAPI) AuthenticatorsFor(schemes map[string]spec.SecurityScheme) map[string]runtime.Authenticator {
-	return nil
+	result := make(map[string]runtime.Authenticator)
+	for name := range schemes {
+		switch name {
+		case "APIKey":
+			scheme := schemes[name]
+			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, o.APIKeyAuth)
+
+		case "Bearer":
+			scheme := schemes[name]
+			result[name] = o.APIKeyAuthenticator(scheme.Name, scheme.In, o.BearerAuth)
+
+		}
+	}
+	return result
 }
 
 // Authorizer returns the registered authorizer
 func (o *BackendServiceAPI) Authorizer() runtime.Authorizer {
-	return nil
+	return o.APIAuthorizer
 }
 
 // ConsumersFor gets the consumers for the specified media types.
@@ -315,10 +637,114 @@ func (o *BackendServiceAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/acl/policies"] = NewDeleteACLPolicies(o.context, o.DeleteACLPoliciesHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/acl/roles"] = NewDeleteACLRoles(o.context, o.DeleteACLRolesHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/api-keys/{keyId}"] = NewDeleteAPIKeysKeyID(o.context, o.DeleteAPIKeysKeyIDHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/me/sessions/{sessionId}"] = NewDeleteMeSessionsSessionID(o.context, o.DeleteMeSessionsSessionIDHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/users/{userId}"] = NewDeleteUsersUserID(o.context, o.DeleteUsersUserIDHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/users/{userId}/pvz/{pvzId}"] = NewDeleteUsersUserIDPvzPvzID(o.context, o.DeleteUsersUserIDPvzPvzIDHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/users/{userId}/sessions"] = NewDeleteUsersUserIDSessions(o.context, o.DeleteUsersUserIDSessionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/acl/policies"] = NewGetACLPolicies(o.context, o.GetACLPoliciesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/acl/roles"] = NewGetACLRoles(o.context, o.GetACLRolesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/api-keys"] = NewGetAPIKeys(o.context, o.GetAPIKeysHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/me"] = NewGetMe(o.context, o.GetMeHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/me/sessions"] = NewGetMeSessions(o.context, o.GetMeSessionsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/oauth/callback"] = NewGetOauthCallback(o.context, o.GetOauthCallbackHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/oauth/login"] = NewGetOauthLogin(o.context, o.GetOauthLoginHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/pvz"] = NewGetPvz(o.context, o.GetPvzHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/pvz/{pvzId}"] = NewGetPvzPvzID(o.context, o.GetPvzPvzIDHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/receptions/{receptionId}"] = NewGetReceptionsReceptionID(o.context, o.GetReceptionsReceptionIDHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/receptions/{receptionId}/products"] = NewGetReceptionsReceptionIDProducts(o.context, o.GetReceptionsReceptionIDProductsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/users"] = NewGetUsers(o.context, o.GetUsersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/users/{userId}"] = NewGetUsersUserID(o.context, o.GetUsersUserIDHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/users/{userId}/pvz"] = NewGetUsersUserIDPvz(o.context, o.GetUsersUserIDPvzHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/.well-known/jwks.json"] = NewGetWellKnownJwksJSON(o.context, o.GetWellKnownJwksJSONHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/me"] = NewPatchMe(o.context, o.PatchMeHandler)
+	if o.handlers["PATCH"] == nil {
+		o.handlers["PATCH"] = make(map[string]http.Handler)
+	}
+	o.handlers["PATCH"]["/users/{userId}"] = NewPatchUsersUserID(o.context, o.PatchUsersUserIDHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/acl/policies"] = NewPostACLPolicies(o.context, o.PostACLPoliciesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/acl/roles"] = NewPostACLRoles(o.context, o.PostACLRolesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/api-keys"] = NewPostAPIKeys(o.context, o.PostAPIKeysHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -326,10 +752,34 @@ func (o *BackendServiceAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/email/confirm"] = NewPostEmailConfirm(o.context, o.PostEmailConfirmHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/invites"] = NewPostInvites(o.context, o.PostInvitesHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/login"] = NewPostLogin(o.context, o.PostLoginHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/logout"] = NewPostLogout(o.context, o.PostLogoutHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/me/password"] = NewPostMePassword(o.context, o.PostMePasswordHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/password/forgot"] = NewPostPasswordForgot(o.context, o.PostPasswordForgotHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/password/reset"] = NewPostPasswordReset(o.context, o.PostPasswordResetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/products"] = NewPostProducts(o.context, o.PostProductsHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -350,7 +800,19 @@ func (o *BackendServiceAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/refresh"] = NewPostRefresh(o.context, o.PostRefreshHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/register"] = NewPostRegister(o.context, o.PostRegisterHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/users/{userId}/unlock"] = NewPostUsersUserIDUnlock(o.context, o.PostUsersUserIDUnlockHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/users/{userId}/pvz/{pvzId}"] = NewPutUsersUserIDPvzPvzID(o.context, o.PutUsersUserIDPvzPvzIDHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -23,11 +23,15 @@ func NewGetPvzParams() GetPvzParams {
 	var (
 		// initialize parameters with default values
 
+		dateFieldDefault = string("openedAt")
+
 		limitDefault = int64(10)
 		pageDefault  = int64(1)
 	)
 
 	return GetPvzParams{
+		DateField: &dateFieldDefault,
+
 		Limit: &limitDefault,
 
 		Page: &pageDefault,
@@ -43,6 +47,15 @@ type GetPvzParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
+	/*Непрозрачный курсор из X-Next-Cursor; если передан, page игнорируется
+	  In: query
+	*/
+	Cursor *string
+	/*По какому времени приемки применять startDate и endDate
+	  In: query
+	  Default: "openedAt"
+	*/
+	DateField *string
 	/*
 	  In: query
 	*/
@@ -77,6 +90,16 @@ func (o *GetPvzParams) BindRequest(r *http.Request, route *middleware.MatchedRou
 
 	qs := runtime.Values(r.URL.Query())
 
+	qCursor, qhkCursor, _ := qs.GetOK("cursor")
+	if err := o.bindCursor(qCursor, qhkCursor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qDateField, qhkDateField, _ := qs.GetOK("dateField")
+	if err := o.bindDateField(qDateField, qhkDateField, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	qEndDate, qhkEndDate, _ := qs.GetOK("endDate")
 	if err := o.bindEndDate(qEndDate, qhkEndDate, route.Formats); err != nil {
 		res = append(res, err)
@@ -102,6 +125,57 @@ func (o *GetPvzParams) BindRequest(r *http.Request, route *middleware.MatchedRou
 	return nil
 }
 
+// bindCursor binds and validates parameter Cursor from query.
+func (o *GetPvzParams) bindCursor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Cursor = &raw
+
+	return nil
+}
+
+// bindDateField binds and validates parameter DateField from query.
+func (o *GetPvzParams) bindDateField(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewGetPvzParams()
+		return nil
+	}
+	o.DateField = &raw
+
+	if err := o.validateDateField(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateDateField carries on validations for parameter DateField
+func (o *GetPvzParams) validateDateField(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("dateField", "query", *o.DateField, []interface{}{"openedAt", "closedAt"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // bindEndDate binds and validates parameter EndDate from query.
 func (o *GetPvzParams) bindEndDate(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
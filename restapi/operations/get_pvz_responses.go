@@ -9,6 +9,9 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
+
+	"github.com/totorialman/go-task-avito/models"
 )
 
 // GetPvzOKCode is the HTTP code returned for type GetPvzOK
@@ -20,6 +23,18 @@ GetPvzOK Список ПВЗ
 swagger:response getPvzOK
 */
 type GetPvzOK struct {
+	/*Курсор для запроса следующей страницы, отсутствует на последней странице
+
+	 */
+	XNextCursor string `json:"X-Next-Cursor"`
+	/*Номер следующей страницы, отсутствует на последней странице
+
+	 */
+	XNextPage string `json:"X-Next-Page"`
+	/*Общее количество ПВЗ, подходящих под фильтр
+
+	 */
+	XTotalCount int64 `json:"X-Total-Count"`
 
 	/*
 	  In: Body
@@ -33,6 +48,39 @@ func NewGetPvzOK() *GetPvzOK {
 	return &GetPvzOK{}
 }
 
+// WithXNextCursor adds the xNextCursor to the get pvz o k response
+func (o *GetPvzOK) WithXNextCursor(xNextCursor string) *GetPvzOK {
+	o.XNextCursor = xNextCursor
+	return o
+}
+
+// SetXNextCursor sets the xNextCursor to the get pvz o k response
+func (o *GetPvzOK) SetXNextCursor(xNextCursor string) {
+	o.XNextCursor = xNextCursor
+}
+
+// WithXNextPage adds the xNextPage to the get pvz o k response
+func (o *GetPvzOK) WithXNextPage(xNextPage string) *GetPvzOK {
+	o.XNextPage = xNextPage
+	return o
+}
+
+// SetXNextPage sets the xNextPage to the get pvz o k response
+func (o *GetPvzOK) SetXNextPage(xNextPage string) {
+	o.XNextPage = xNextPage
+}
+
+// WithXTotalCount adds the xTotalCount to the get pvz o k response
+func (o *GetPvzOK) WithXTotalCount(xTotalCount int64) *GetPvzOK {
+	o.XTotalCount = xTotalCount
+	return o
+}
+
+// SetXTotalCount sets the xTotalCount to the get pvz o k response
+func (o *GetPvzOK) SetXTotalCount(xTotalCount int64) {
+	o.XTotalCount = xTotalCount
+}
+
 // WithPayload adds the payload to the get pvz o k response
 func (o *GetPvzOK) WithPayload(payload []*GetPvzOKBodyItems0) *GetPvzOK {
 	o.Payload = payload
@@ -47,6 +95,27 @@ func (o *GetPvzOK) SetPayload(payload []*GetPvzOKBodyItems0) {
 // WriteResponse to the client
 func (o *GetPvzOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
 
+	// response header X-Next-Cursor
+
+	xNextCursor := o.XNextCursor
+	if xNextCursor != "" {
+		rw.Header().Set("X-Next-Cursor", xNextCursor)
+	}
+
+	// response header X-Next-Page
+
+	xNextPage := o.XNextPage
+	if xNextPage != "" {
+		rw.Header().Set("X-Next-Page", xNextPage)
+	}
+
+	// response header X-Total-Count
+
+	xTotalCount := swag.FormatInt64(o.XTotalCount)
+	if xTotalCount != "" {
+		rw.Header().Set("X-Total-Count", xTotalCount)
+	}
+
 	rw.WriteHeader(200)
 	payload := o.Payload
 	if payload == nil {
@@ -58,3 +127,48 @@ func (o *GetPvzOK) WriteResponse(rw http.ResponseWriter, producer runtime.Produc
 		panic(err) // let the recovery middleware deal with this
 	}
 }
+
+// GetPvzBadRequestCode is the HTTP code returned for type GetPvzBadRequest
+const GetPvzBadRequestCode int = 400
+
+/*
+GetPvzBadRequest Неверный запрос
+
+swagger:response getPvzBadRequest
+*/
+type GetPvzBadRequest struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetPvzBadRequest creates GetPvzBadRequest with default headers values
+func NewGetPvzBadRequest() *GetPvzBadRequest {
+
+	return &GetPvzBadRequest{}
+}
+
+// WithPayload adds the payload to the get pvz bad request response
+func (o *GetPvzBadRequest) WithPayload(payload *models.Error) *GetPvzBadRequest {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get pvz bad request response
+func (o *GetPvzBadRequest) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPvzBadRequest) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(400)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
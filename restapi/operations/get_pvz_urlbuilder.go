@@ -16,6 +16,8 @@ import (
 
 // GetPvzURL generates an URL for the get pvz operation
 type GetPvzURL struct {
+	Cursor    *string
+	DateField *string
 	EndDate   *strfmt.DateTime
 	Limit     *int64
 	Page      *int64
@@ -52,6 +54,22 @@ func (o *GetPvzURL) Build() (*url.URL, error) {
 
 	qs := make(url.Values)
 
+	var cursorQ string
+	if o.Cursor != nil {
+		cursorQ = *o.Cursor
+	}
+	if cursorQ != "" {
+		qs.Set("cursor", cursorQ)
+	}
+
+	var dateFieldQ string
+	if o.DateField != nil {
+		dateFieldQ = *o.DateField
+	}
+	if dateFieldQ != "" {
+		qs.Set("dateField", dateFieldQ)
+	}
+
 	var endDateQ string
 	if o.EndDate != nil {
 		endDateQ = o.EndDate.String()
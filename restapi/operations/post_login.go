@@ -65,6 +65,10 @@ func (o *PostLogin) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 // swagger:model PostLoginBody
 type PostLoginBody struct {
 
+	// Название устройства, например номер терминала; показывается в списке сессий
+	// Max Length: 100
+	DeviceLabel string `json:"deviceLabel,omitempty"`
+
 	// email
 	// Required: true
 	// Format: email
@@ -79,6 +83,10 @@ type PostLoginBody struct {
 func (o *PostLoginBody) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := o.validateDeviceLabel(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateEmail(formats); err != nil {
 		res = append(res, err)
 	}
@@ -93,6 +101,18 @@ func (o *PostLoginBody) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (o *PostLoginBody) validateDeviceLabel(formats strfmt.Registry) error {
+	if swag.IsZero(o.DeviceLabel) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("body"+"."+"deviceLabel", "body", o.DeviceLabel, 100); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (o *PostLoginBody) validateEmail(formats strfmt.Registry) error {
 
 	if err := validate.Required("body"+"."+"email", "body", o.Email); err != nil {
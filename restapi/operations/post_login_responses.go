@@ -9,6 +9,7 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/swag"
 
 	"github.com/totorialman/go-task-avito/models"
 )
@@ -100,3 +101,70 @@ func (o *PostLoginUnauthorized) WriteResponse(rw http.ResponseWriter, producer r
 		}
 	}
 }
+
+// PostLoginTooManyRequestsCode is the HTTP code returned for type PostLoginTooManyRequests
+const PostLoginTooManyRequestsCode int = 429
+
+/*
+PostLoginTooManyRequests Слишком много неудачных попыток входа, вход временно заблокирован
+
+swagger:response postLoginTooManyRequests
+*/
+type PostLoginTooManyRequests struct {
+	/*Через сколько секунд можно повторить попытку
+
+	 */
+	RetryAfter int64 `json:"Retry-After"`
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostLoginTooManyRequests creates PostLoginTooManyRequests with default headers values
+func NewPostLoginTooManyRequests() *PostLoginTooManyRequests {
+
+	return &PostLoginTooManyRequests{}
+}
+
+// WithRetryAfter adds the retryAfter to the post login too many requests response
+func (o *PostLoginTooManyRequests) WithRetryAfter(retryAfter int64) *PostLoginTooManyRequests {
+	o.RetryAfter = retryAfter
+	return o
+}
+
+// SetRetryAfter sets the retryAfter to the post login too many requests response
+func (o *PostLoginTooManyRequests) SetRetryAfter(retryAfter int64) {
+	o.RetryAfter = retryAfter
+}
+
+// WithPayload adds the payload to the post login too many requests response
+func (o *PostLoginTooManyRequests) WithPayload(payload *models.Error) *PostLoginTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post login too many requests response
+func (o *PostLoginTooManyRequests) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostLoginTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	// response header Retry-After
+
+	retryAfter := swag.FormatInt64(o.RetryAfter)
+	if retryAfter != "" {
+		rw.Header().Set("Retry-After", retryAfter)
+	}
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
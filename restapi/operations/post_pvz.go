@@ -12,16 +12,16 @@ import (
 )
 
 // PostPvzHandlerFunc turns a function with the right signature into a post pvz handler
-type PostPvzHandlerFunc func(PostPvzParams) middleware.Responder
+type PostPvzHandlerFunc func(PostPvzParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn PostPvzHandlerFunc) Handle(params PostPvzParams) middleware.Responder {
-	return fn(params)
+func (fn PostPvzHandlerFunc) Handle(params PostPvzParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // PostPvzHandler interface for that can handle valid post pvz params
 type PostPvzHandler interface {
-	Handle(PostPvzParams) middleware.Responder
+	Handle(PostPvzParams, interface{}) middleware.Responder
 }
 
 // NewPostPvz creates a new http.Handler for the post pvz operation
@@ -45,12 +45,25 @@ func (o *PostPvz) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		*r = *rCtx
 	}
 	var Params = NewPostPvzParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
@@ -12,16 +12,16 @@ import (
 )
 
 // PostPvzPvzIDDeleteLastProductHandlerFunc turns a function with the right signature into a post pvz pvz ID delete last product handler
-type PostPvzPvzIDDeleteLastProductHandlerFunc func(PostPvzPvzIDDeleteLastProductParams) middleware.Responder
+type PostPvzPvzIDDeleteLastProductHandlerFunc func(PostPvzPvzIDDeleteLastProductParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn PostPvzPvzIDDeleteLastProductHandlerFunc) Handle(params PostPvzPvzIDDeleteLastProductParams) middleware.Responder {
-	return fn(params)
+func (fn PostPvzPvzIDDeleteLastProductHandlerFunc) Handle(params PostPvzPvzIDDeleteLastProductParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // PostPvzPvzIDDeleteLastProductHandler interface for that can handle valid post pvz pvz ID delete last product params
 type PostPvzPvzIDDeleteLastProductHandler interface {
-	Handle(PostPvzPvzIDDeleteLastProductParams) middleware.Responder
+	Handle(PostPvzPvzIDDeleteLastProductParams, interface{}) middleware.Responder
 }
 
 // NewPostPvzPvzIDDeleteLastProduct creates a new http.Handler for the post pvz pvz ID delete last product operation
@@ -45,12 +45,25 @@ func (o *PostPvzPvzIDDeleteLastProduct) ServeHTTP(rw http.ResponseWriter, r *htt
 		*r = *rCtx
 	}
 	var Params = NewPostPvzPvzIDDeleteLastProductParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
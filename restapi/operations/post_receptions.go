@@ -17,16 +17,16 @@ import (
 )
 
 // PostReceptionsHandlerFunc turns a function with the right signature into a post receptions handler
-type PostReceptionsHandlerFunc func(PostReceptionsParams) middleware.Responder
+type PostReceptionsHandlerFunc func(PostReceptionsParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn PostReceptionsHandlerFunc) Handle(params PostReceptionsParams) middleware.Responder {
-	return fn(params)
+func (fn PostReceptionsHandlerFunc) Handle(params PostReceptionsParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // PostReceptionsHandler interface for that can handle valid post receptions params
 type PostReceptionsHandler interface {
-	Handle(PostReceptionsParams) middleware.Responder
+	Handle(PostReceptionsParams, interface{}) middleware.Responder
 }
 
 // NewPostReceptions creates a new http.Handler for the post receptions operation
@@ -50,12 +50,25 @@ func (o *PostReceptions) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		*r = *rCtx
 	}
 	var Params = NewPostReceptionsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
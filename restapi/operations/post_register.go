@@ -18,16 +18,16 @@ import (
 )
 
 // PostRegisterHandlerFunc turns a function with the right signature into a post register handler
-type PostRegisterHandlerFunc func(PostRegisterParams) middleware.Responder
+type PostRegisterHandlerFunc func(PostRegisterParams, interface{}) middleware.Responder
 
 // Handle executing the request and returning a response
-func (fn PostRegisterHandlerFunc) Handle(params PostRegisterParams) middleware.Responder {
-	return fn(params)
+func (fn PostRegisterHandlerFunc) Handle(params PostRegisterParams, principal interface{}) middleware.Responder {
+	return fn(params, principal)
 }
 
 // PostRegisterHandler interface for that can handle valid post register params
 type PostRegisterHandler interface {
-	Handle(PostRegisterParams) middleware.Responder
+	Handle(PostRegisterParams, interface{}) middleware.Responder
 }
 
 // NewPostRegister creates a new http.Handler for the post register operation
@@ -51,12 +51,25 @@ func (o *PostRegister) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 		*r = *rCtx
 	}
 	var Params = NewPostRegisterParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal interface{}
+	if uprinc != nil {
+		principal = uprinc.(interface{}) // this is really a interface{}, I promise
+	}
+
 	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
 		o.Context.Respond(rw, r, route.Produces, route, err)
 		return
 	}
 
-	res := o.Handler.Handle(Params) // actually handle the request
+	res := o.Handler.Handle(Params, principal) // actually handle the request
 	o.Context.Respond(rw, r, route.Produces, route, res)
 
 }
@@ -71,14 +84,16 @@ type PostRegisterBody struct {
 	// Format: email
 	Email *strfmt.Email `json:"email"`
 
+	// Код приглашения из POST /invites
+	InviteCode string `json:"inviteCode,omitempty"`
+
 	// password
 	// Required: true
 	Password *string `json:"password"`
 
-	// role
-	// Required: true
+	// Обязательна без inviteCode, с приглашением должна совпадать с его ролью
 	// Enum: ["employee","moderator"]
-	Role *string `json:"role"`
+	Role string `json:"role,omitempty"`
 }
 
 // Validate validates this post register body
@@ -155,13 +170,12 @@ func (o *PostRegisterBody) validateRoleEnum(path, location string, value string)
 }
 
 func (o *PostRegisterBody) validateRole(formats strfmt.Registry) error {
-
-	if err := validate.Required("body"+"."+"role", "body", o.Role); err != nil {
-		return err
+	if swag.IsZero(o.Role) { // not required
+		return nil
 	}
 
 	// value enum
-	if err := o.validateRoleEnum("body"+"."+"role", "body", *o.Role); err != nil {
+	if err := o.validateRoleEnum("body"+"."+"role", "body", o.Role); err != nil {
 		return err
 	}
 
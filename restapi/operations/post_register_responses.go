@@ -102,3 +102,48 @@ func (o *PostRegisterBadRequest) WriteResponse(rw http.ResponseWriter, producer
 		}
 	}
 }
+
+// PostRegisterForbiddenCode is the HTTP code returned for type PostRegisterForbidden
+const PostRegisterForbiddenCode int = 403
+
+/*
+PostRegisterForbidden Регистрация с этой ролью или с этим email не разрешена
+
+swagger:response postRegisterForbidden
+*/
+type PostRegisterForbidden struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewPostRegisterForbidden creates PostRegisterForbidden with default headers values
+func NewPostRegisterForbidden() *PostRegisterForbidden {
+
+	return &PostRegisterForbidden{}
+}
+
+// WithPayload adds the payload to the post register forbidden response
+func (o *PostRegisterForbidden) WithPayload(payload *models.Error) *PostRegisterForbidden {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the post register forbidden response
+func (o *PostRegisterForbidden) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *PostRegisterForbidden) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(403)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}